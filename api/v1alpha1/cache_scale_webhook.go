@@ -0,0 +1,95 @@
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+//+kubebuilder:webhook:path=/validate-gingersnap-project-io-v1alpha1-cache-scale,mutating=false,failurePolicy=fail,sideEffects=None,groups=gingersnap-project.io,resources=caches/scale,verbs=update,versions=v1alpha1;v1beta1,name=vcachescale.kb.io,admissionReviewVersions=v1
+
+// RegisterCacheScaleValidatingWebhook explicitly adds the validating webhook of the Cache scale subresource to the
+// Webhook Server. Requests to the subresource contain an autoscaling/v1 Scale, so the Cache must be loaded in order to
+// determine whether it can be scaled
+func RegisterCacheScaleValidatingWebhook(mgr ctrl.Manager) {
+	hookServer := mgr.GetWebhookServer()
+	hookServer.Register("/validate-gingersnap-project-io-v1alpha1-cache-scale", &webhook.Admission{
+		Handler: &cacheScaleValidator{},
+	})
+}
+
+type cacheScaleValidator struct {
+	client  runtimeClient.Client
+	decoder *admission.Decoder
+}
+
+var _ inject.Client = &cacheScaleValidator{}
+var _ admission.Handler = &cacheScaleValidator{}
+
+// InjectClient injects the client.
+func (sv *cacheScaleValidator) InjectClient(c runtimeClient.Client) error {
+	sv.client = c
+	return nil
+}
+
+// InjectDecoder injects the decoder.
+func (sv *cacheScaleValidator) InjectDecoder(d *admission.Decoder) error {
+	sv.decoder = d
+	return nil
+}
+
+func (sv *cacheScaleValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Update {
+		return admission.Allowed("")
+	}
+
+	scale := &autoscalingv1.Scale{}
+	if err := sv.decoder.DecodeRaw(req.Object, scale); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	cache := &Cache{}
+	if err := sv.client.Get(ctx, types.NamespacedName{Namespace: req.Namespace, Name: req.Name}, cache); err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	var current int32
+	if cache.Spec.Deployment != nil {
+		current = cache.Spec.Deployment.Replicas
+	}
+	if err := validateScale(cache, current, scale.Spec.Replicas); err != nil {
+		var apiStatus apierrors.APIStatus
+		if errors.As(err, &apiStatus) {
+			return validationResponseFromStatus(false, apiStatus.Status())
+		}
+		return admission.Denied(err.Error())
+	}
+	return admission.Allowed("")
+}
+
+// validateScale ensures that only CLUSTER caches are scaled, and that they retain at least one replica
+func validateScale(c *Cache, current, replicas int32) error {
+	var allErrs field.ErrorList
+	p := field.NewPath("spec").Child("deployment").Child("replicas")
+	if current == replicas {
+		return nil
+	}
+
+	if c.Spec.Deployment == nil || c.Local() {
+		allErrs = append(allErrs, field.Forbidden(p, "LOCAL caches run a pod on every node and cannot be scaled"))
+	} else if replicas < 1 {
+		allErrs = append(allErrs, field.Invalid(p, replicas, "CLUSTER caches must have at least one replica"))
+	}
+	return StatusError(allErrs, c.Name, KindCache)
+}
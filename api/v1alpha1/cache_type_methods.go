@@ -2,6 +2,8 @@ package v1alpha1
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/gingersnap-project/operator/pkg/images"
 	v1 "k8s.io/api/core/v1"
@@ -12,11 +14,11 @@ import (
 func (c *Cache) CacheManagerImage() string {
 	switch *c.Spec.DataSource.DbType {
 	case DBType_MYSQL_8:
-		return images.CacheManagerMySQL
+		return images.CacheManagerMySQL()
 	case DBType_POSTGRES_14:
-		return images.CacheManagerPostgres
+		return images.CacheManagerPostgres()
 	case DBType_SQL_SERVER_2019:
-		return images.CacheManagerMSSQL
+		return images.CacheManagerMSSQL()
 	}
 	return ""
 }
@@ -32,6 +34,20 @@ func (c *Cache) DBSyncerName() string {
 	return fmt.Sprintf("%s-db-syncer", c.Name)
 }
 
+// DataSourceSecret returns the name of the Secret containing the data source binding. This is either the Secret
+// provided by the user or, when credentials are retrieved from an external secret store, the Secret materialised by
+// the operator
+func (c *Cache) DataSourceSecret() string {
+	ds := c.Spec.DataSource
+	if ds.Vault != nil {
+		return c.CacheService().DataSourceCredentialsSecret()
+	}
+	if ds.SecretRef != nil {
+		return ds.SecretRef.Name
+	}
+	return ""
+}
+
 func (c *Cache) DeploymentLimits() v1.ResourceList {
 	if c.Spec.Deployment != nil && c.Spec.Deployment.Resources != nil && c.Spec.Deployment.Resources.Limits != nil {
 		return resourceList(c.Spec.Deployment.Resources.Limits)
@@ -60,6 +76,78 @@ func (c *Cache) DBSyncerRequests() v1.ResourceList {
 	return nil
 }
 
+// DBSyncerReplicas returns the number of db-syncer replicas, at most one of which is active at any time
+func (c *Cache) DBSyncerReplicas() int32 {
+	if c.Spec.DbSyncer != nil && c.Spec.DbSyncer.Replicas > 0 {
+		return c.Spec.DbSyncer.Replicas
+	}
+	return 1
+}
+
+// DefaultDBSyncerTargetLag is the average replication lag per db-syncer replica above which db-syncer is scaled out if
+// spec.dbSyncer.autoscaling.targetLag is not set
+const DefaultDBSyncerTargetLag = 30 * time.Second
+
+// DBSyncerAutoscaling returns the autoscaling configuration of the db-syncer, or nil if the number of db-syncer replicas
+// is fixed
+func (c *Cache) DBSyncerAutoscaling() *DBSyncerAutoscalingSpec {
+	if c.Spec.DbSyncer != nil {
+		return c.Spec.DbSyncer.Autoscaling
+	}
+	return nil
+}
+
+// DBSyncerMinReplicas returns the minimum number of replicas of an autoscaled db-syncer
+func (c *Cache) DBSyncerMinReplicas() int32 {
+	if a := c.DBSyncerAutoscaling(); a != nil && a.MinReplicas > 0 {
+		return a.MinReplicas
+	}
+	return 1
+}
+
+// DBSyncerTargetLag returns the average replication lag per replica above which an autoscaled db-syncer is scaled out
+func (c *Cache) DBSyncerTargetLag() time.Duration {
+	if a := c.DBSyncerAutoscaling(); a != nil {
+		if d, err := time.ParseDuration(a.TargetLag); err == nil && d > 0 {
+			return d
+		}
+	}
+	return DefaultDBSyncerTargetLag
+}
+
+// ReservedEnvPrefixes are the prefixes of environment variables owned by the operator, which cannot be set via
+// extraEnv or envFrom
+var ReservedEnvPrefixes = []string{"GINGERSNAP_K8S_", "GINGERSNAP_LEADER_ELECTION_", "GINGERSNAP_PREFLIGHT_"}
+
+// ReservedEnvNames are the environment variables set by the operator on the db-syncer, which cannot be set via extraEnv
+var ReservedEnvNames = []string{"GINGERSNAP_DYNAMIC_MEMBERSHIP", "GINGERSNAP_PARTITIONING_ENABLED", "GINGERSNAP_PREFLIGHT", "SERVICE_BINDING_ROOT"}
+
+// ReservedEnvPrefix returns the reserved prefix of the supplied environment variable name, if any
+func ReservedEnvPrefix(name string) (string, bool) {
+	for _, prefix := range ReservedEnvPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return prefix, true
+		}
+	}
+	return "", false
+}
+
+// ReservedEnv returns true if the environment variable with the supplied name can only be set by the operator
+func ReservedEnv(name string) bool {
+	if _, reserved := ReservedEnvPrefix(name); reserved {
+		return true
+	}
+	for _, reserved := range ReservedEnvNames {
+		if name == reserved {
+			return true
+		}
+	}
+	return false
+}
+
+// LogLevels are the log levels supported by the cache-manager and db-syncer
+var LogLevels = []string{"OFF", "FATAL", "ERROR", "WARN", "INFO", "DEBUG", "TRACE", "ALL"}
+
 func resourceList(rq *ResourceQuantity) v1.ResourceList {
 	// MustParse should never throw a panic as the webhook has already verified that the quantity is valid
 	return map[v1.ResourceName]resource.Quantity{
@@ -76,6 +164,67 @@ func (c *Cache) Cluster() bool {
 	return c.Spec.Deployment.Type == CacheDeploymentType_CLUSTER
 }
 
+// UpdateStrategy returns the configured cache workload UpdateStrategy or nil if the Kubernetes defaults should be used
+func (c *Cache) UpdateStrategy() *UpdateStrategy {
+	if c.Spec.Deployment == nil {
+		return nil
+	}
+	return c.Spec.Deployment.UpdateStrategy
+}
+
+// PartitionedUpdate returns true if the operator is responsible for replacing outdated pods of a LOCAL cache, as
+// a partition of pods must retain the previous revision
+func (c *Cache) PartitionedUpdate() bool {
+	s := c.UpdateStrategy()
+	return c.Local() && s != nil && s.Type == UpdateStrategyType_ROLLING_UPDATE && s.Partition > 0
+}
+
+// ActiveDeploymentType returns the CacheDeploymentType of the workload currently serving the Cache
+func (c *Cache) ActiveDeploymentType() CacheDeploymentType {
+	if c.Status.DeploymentType != nil {
+		return *c.Status.DeploymentType
+	}
+	return c.Spec.Deployment.Type
+}
+
+// Persistence returns the configuration of the cache-manager volumes, or nil if the cache is not persisted
+func (c *Cache) Persistence() *CachePersistenceSpec {
+	if c.Spec.Deployment == nil {
+		return nil
+	}
+	return c.Spec.Deployment.Persistence
+}
+
+// PersistenceSize returns the size of the volume of each cache pod
+func (c *Cache) PersistenceSize() resource.Quantity {
+	// MustParse should never throw a panic as the webhook has already verified that the quantity is valid
+	return resource.MustParse(c.Persistence().Size)
+}
+
+// WorkloadKind returns the kind of the workload required to deploy the cache pods. CLUSTER caches with persistence
+// are deployed by a StatefulSet, so that each pod is bound to its own PersistentVolumeClaim
+func (c *Cache) WorkloadKind() string {
+	if c.Cluster() && c.Persistence() != nil {
+		return WorkloadStatefulSet
+	}
+	return c.Spec.Deployment.Type.WorkloadKind()
+}
+
+// ActiveWorkloadKind returns the kind of the workload currently serving the Cache
+func (c *Cache) ActiveWorkloadKind() string {
+	if c.Status.Workload != "" {
+		return c.Status.Workload
+	}
+	// Caches whose workload has not been recorded are served by the workload of their deployment type
+	return c.ActiveDeploymentType().WorkloadKind()
+}
+
+// Transitioning returns true if the Cache is being migrated between LOCAL and CLUSTER workloads, or between the
+// Deployment and StatefulSet of a CLUSTER cache
+func (c *Cache) Transitioning() bool {
+	return c.ActiveDeploymentType() != c.Spec.Deployment.Type || c.ActiveWorkloadKind() != c.WorkloadKind()
+}
+
 func (c *Cache) Condition(condition CacheConditionType) CacheCondition {
 	for _, existing := range c.Status.Conditions {
 		if existing.Type == condition {
@@ -109,6 +258,58 @@ func (c *Cache) SetCondition(condition CacheCondition) (updated bool) {
 	return true
 }
 
+// RetryAttempts the number of consecutive reconciliations requeued with backoff
+func (c *Cache) RetryAttempts() int32 {
+	return c.Status.RetryAttempts
+}
+
+func (c *Cache) SetRetryAttempts(attempts int32) {
+	c.Status.RetryAttempts = attempts
+}
+
+// AllowsRuleNamespace returns true if rules in the provided namespace are permitted to reference the Cache. Rules in
+// the Cache's own namespace are always permitted, rules in other namespaces must be granted access via
+// spec.allowedRuleNamespaces
+func (c *Cache) AllowsRuleNamespace(namespace *v1.Namespace) bool {
+	if namespace.Name == c.Namespace {
+		return true
+	}
+
+	selector := c.Spec.AllowedRuleNamespaces
+	if selector == nil {
+		return false
+	}
+
+	for _, name := range selector.MatchNames {
+		if name == AllNamespaces || name == namespace.Name {
+			return true
+		}
+	}
+
+	if len(selector.MatchLabels) == 0 {
+		return false
+	}
+	for k, v := range selector.MatchLabels {
+		if namespace.Labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// RuleNamespaceForbiddenMsg returns a human-readable explanation of why rules in the namespace cannot reference the Cache
+func (c *Cache) RuleNamespaceForbiddenMsg(namespace string) string {
+	return fmt.Sprintf("rules in namespace '%s' are not permitted to reference Cache '%s'. The namespace must be selected by the Cache's spec.allowedRuleNamespaces", namespace, c.CacheService())
+}
+
+// WorkloadKind returns the kind of the workload used to deploy cache pods of the CacheDeploymentType
+func (x CacheDeploymentType) WorkloadKind() string {
+	if x == CacheDeploymentType_LOCAL {
+		return WorkloadDaemonSet
+	}
+	return WorkloadDeployment
+}
+
 func (x CacheDeploymentType) MarshalJSON() ([]byte, error) {
 	return []byte(fmt.Sprintf("\"%s\"", CacheDeploymentType_name[int32(x)])), nil
 }
@@ -118,6 +319,24 @@ func (x *CacheDeploymentType) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+func (x VaultSecretEngine) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("\"%s\"", VaultSecretEngine_name[int32(x)])), nil
+}
+
+func (x *VaultSecretEngine) UnmarshalJSON(b []byte) error {
+	*x = VaultSecretEngine(VaultSecretEngine_value[string(b[1:len(b)-1])])
+	return nil
+}
+
+func (x UpdateStrategyType) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("\"%s\"", UpdateStrategyType_name[int32(x)])), nil
+}
+
+func (x *UpdateStrategyType) UnmarshalJSON(b []byte) error {
+	*x = UpdateStrategyType(UpdateStrategyType_value[string(b[1:len(b)-1])])
+	return nil
+}
+
 func (dbType *DBType) ServiceBinding() string {
 	switch *dbType {
 	case DBType_MYSQL_8:
@@ -129,3 +348,16 @@ func (dbType *DBType) ServiceBinding() string {
 	}
 	return ""
 }
+
+// CDCRemediation returns instructions for configuring the database so that db-syncer can read its change log
+func (dbType *DBType) CDCRemediation() string {
+	switch *dbType {
+	case DBType_MYSQL_8:
+		return "Enable the binary log with binlog_format=ROW and binlog_row_image=FULL, and grant the user the REPLICATION SLAVE and REPLICATION CLIENT privileges"
+	case DBType_POSTGRES_14:
+		return "Set wal_level=logical with max_replication_slots and max_wal_senders greater than 0, and grant the user the REPLICATION attribute"
+	case DBType_SQL_SERVER_2019:
+		return "Enable CDC on the database with sys.sp_cdc_enable_db and on the table with sys.sp_cdc_enable_table, and ensure that the SQL Server Agent is running"
+	}
+	return ""
+}
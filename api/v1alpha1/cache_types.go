@@ -6,6 +6,16 @@ import (
 
 const KindCache = "Cache"
 
+// AllNamespaces can be used in NamespaceSelector.MatchNames to select every namespace
+const AllNamespaces = "*"
+
+// The kinds of the workloads that deploy cache-manager pods
+const (
+	WorkloadDaemonSet   = "DaemonSet"
+	WorkloadDeployment  = "Deployment"
+	WorkloadStatefulSet = "StatefulSet"
+)
+
 // +kubebuilder:validation:Enum=Ready
 type CacheConditionType string
 
@@ -31,16 +41,114 @@ type CacheStatus struct {
 	Conditions []CacheCondition `json:"conditions,omitempty"`
 	// +optional
 	ServiceBinding *ServiceBinding `json:"binding,omitempty"`
+	// Credentials the data source credentials currently used by the cache-manager pods
+	// +optional
+	Credentials *CredentialsStatus `json:"credentials,omitempty"`
+	// Rollout the progress of the most recent cache-manager workload update
+	// +optional
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
+	// DeploymentType of the workload currently serving the Cache. Differs from spec.deployment.type whilst the Cache
+	// is transitioning between LOCAL and CLUSTER
+	// +kubebuilder:validation:Enum=LOCAL;CLUSTER
+	// +optional
+	DeploymentType *CacheDeploymentType `json:"deploymentType,omitempty"`
+	// Workload the kind of the workload currently serving the Cache, one of DaemonSet, Deployment or StatefulSet.
+	// Differs from the kind required by the spec whilst the Cache is transitioning between workloads
+	// +kubebuilder:validation:Enum=DaemonSet;Deployment;StatefulSet
+	// +optional
+	Workload string `json:"workload,omitempty"`
+	// RetryAttempts the number of consecutive reconciliations that have been requeued with backoff without the Cache
+	// making progress. Reset to zero once reconciliation progresses
+	// +optional
+	RetryAttempts int32 `json:"retryAttempts,omitempty"`
+	// Replicas the number of cache-manager pods observed by the workload, reported as the current size of the scale
+	// subresource. The desired number of pods is reported by rollout.desired
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+	// ReadyReplicas the number of cache-manager pods that are Ready
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+	// Selector the label selector of the cache-manager pods, used by the scale subresource
+	// +optional
+	Selector string `json:"selector,omitempty"`
+	// Image the cache-manager image of the workload currently serving the Cache
+	// +optional
+	Image string `json:"image,omitempty"`
+	// Endpoints the addresses that clients connect to
+	// +optional
+	Endpoints *EndpointsStatus `json:"endpoints,omitempty"`
+	// Rules the number of EagerCacheRules and LazyCacheRules attached to the Cache
+	// +optional
+	Rules int32 `json:"rules,omitempty"`
+	// ReadyRules the number of attached rules that are Ready
+	// +optional
+	ReadyRules int32 `json:"readyRules,omitempty"`
+	// AttachedRules the rules attached to the Cache, ordered by kind, namespace and name
+	// +optional
+	AttachedRules []AttachedRuleStatus `json:"attachedRules,omitempty"`
+}
+
+// EndpointsStatus describes the addresses of the cache-manager Service
+type EndpointsStatus struct {
+	// HotRod the host and port of the Hot Rod endpoint
+	HotRod string `json:"hotrod,omitempty"`
+	// Rest the URL of the REST endpoint
+	Rest string `json:"rest,omitempty"`
+}
+
+// AttachedRuleStatus describes a rule that references the Cache
+type AttachedRuleStatus struct {
+	// Kind of the rule, EagerCacheRule or LazyCacheRule
+	Kind string `json:"kind"`
+	// Namespace of the rule
+	Namespace string `json:"namespace"`
+	// Name of the rule
+	Name string `json:"name"`
+	// Ready is true if the rule's Ready condition is True
+	Ready bool `json:"ready"`
 }
 
 type ServiceBinding struct {
 	Name string `json:"name,omitempty"`
 }
 
+// CredentialsStatus describes the data source credentials that a workload has been rolled out with
+type CredentialsStatus struct {
+	// SecretName the name of the Secret containing the data source credentials
+	SecretName string `json:"secretName,omitempty"`
+	// Version of the credentials. Either the version reported by the external secret store or a hash of the Secret data
+	Version string `json:"version,omitempty"`
+}
+
+// RolloutStatus describes the progress of a cache-manager workload update
+type RolloutStatus struct {
+	// Desired number of cache pods
+	Desired int32 `json:"desired"`
+	// Updated number of cache pods running the current revision
+	Updated int32 `json:"updated"`
+	// Ready number of cache pods
+	Ready int32 `json:"ready"`
+	// Available number of cache pods
+	Available int32 `json:"available"`
+	// Complete is true once all cache pods, excluding those retained by a partition, run the current revision and are available
+	Complete bool `json:"complete"`
+}
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
+//+kubebuilder:subresource:scale:specpath=.spec.deployment.replicas,statuspath=.status.replicas,selectorpath=.status.selector
+//+kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.status.deploymentType`
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Pods",type=integer,JSONPath=`.status.readyReplicas`,description="Ready cache-manager pods"
+//+kubebuilder:printcolumn:name="Desired",type=integer,JSONPath=`.status.rollout.desired`,description="Desired cache-manager pods"
+//+kubebuilder:printcolumn:name="Rules",type=integer,JSONPath=`.status.rules`,description="Attached rules"
+//+kubebuilder:printcolumn:name="Ready Rules",type=integer,JSONPath=`.status.readyRules`,description="Attached rules that are Ready"
+//+kubebuilder:printcolumn:name="Hot Rod",type=string,JSONPath=`.status.endpoints.hotrod`,priority=1
+//+kubebuilder:printcolumn:name="Workload",type=string,JSONPath=`.status.workload`,priority=1
+//+kubebuilder:printcolumn:name="Image",type=string,JSONPath=`.status.image`,priority=1
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Cache is the Schema for the caches API
 type Cache struct {
@@ -1,8 +1,17 @@
 package v1alpha1
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -43,17 +52,56 @@ func (c *Cache) ValidateCreate() error {
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
-func (c *Cache) ValidateUpdate(_ runtime.Object) error {
-	return c.validate()
+func (c *Cache) ValidateUpdate(old runtime.Object) error {
+	if err := c.validate(); err != nil {
+		return err
+	}
+	if oldCache, ok := old.(*Cache); ok && oldCache.Spec.Deployment != nil {
+		if err := validatePersistenceUpdate(c, oldCache); err != nil {
+			return err
+		}
+		return validateScale(c, oldCache.Spec.Deployment.Replicas, c.Spec.Deployment.Replicas)
+	}
+	return nil
+}
+
+// validatePersistenceUpdate forbids changes to the volumes of a persisted CLUSTER cache, as the volumeClaimTemplates of
+// a StatefulSet are immutable
+func validatePersistenceUpdate(c, old *Cache) error {
+	if !c.Cluster() || !old.Cluster() || c.Persistence() == nil || old.Persistence() == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	p := field.NewPath("spec").Child("deployment").Child("persistence")
+	if c.Persistence().Size != old.Persistence().Size {
+		allErrs = append(allErrs, field.Forbidden(p.Child("size"), "size cannot be changed for CLUSTER caches"))
+	}
+	if c.Persistence().StorageClassName != old.Persistence().StorageClassName {
+		allErrs = append(allErrs, field.Forbidden(p.Child("storageClassName"), "storageClassName cannot be changed for CLUSTER caches"))
+	}
+	return StatusError(allErrs, c.Name, KindCache)
 }
 
 func (c *Cache) validate() error {
 	var allErrs field.ErrorList
 
 	validateResources(&allErrs, field.NewPath("spec").Child("deployment").Child("resources"), c.Spec.Deployment.Resources)
+	validateUpdateStrategy(&allErrs, field.NewPath("spec").Child("deployment").Child("updateStrategy"), c)
+	validateLogging(&allErrs, field.NewPath("spec").Child("deployment").Child("logging"), c.Spec.Deployment.Logging)
+	validateExtraEnv(&allErrs, field.NewPath("spec").Child("deployment").Child("extraEnv"), c.Spec.Deployment.ExtraEnv)
+	validateEnvFrom(&allErrs, field.NewPath("spec").Child("deployment").Child("envFrom"), c.Spec.Deployment.EnvFrom)
+	validatePersistence(&allErrs, field.NewPath("spec").Child("deployment").Child("persistence"), c.Spec.Deployment.Persistence)
 
 	if c.Spec.DbSyncer != nil {
 		validateResources(&allErrs, field.NewPath("spec").Child("dbSyncer").Child("resources"), c.Spec.DbSyncer.Resources)
+		if c.Spec.DbSyncer.Replicas < 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec").Child("dbSyncer").Child("replicas"), c.Spec.DbSyncer.Replicas, "replicas must not be negative"))
+		}
+		validateLogging(&allErrs, field.NewPath("spec").Child("dbSyncer").Child("logging"), c.Spec.DbSyncer.Logging)
+		validateExtraEnv(&allErrs, field.NewPath("spec").Child("dbSyncer").Child("extraEnv"), c.Spec.DbSyncer.ExtraEnv)
+		validateEnvFrom(&allErrs, field.NewPath("spec").Child("dbSyncer").Child("envFrom"), c.Spec.DbSyncer.EnvFrom)
+		validateDBSyncerAutoscaling(&allErrs, field.NewPath("spec").Child("dbSyncer").Child("autoscaling"), c.Spec.DbSyncer.Autoscaling)
 	}
 
 	ds := c.Spec.DataSource
@@ -64,10 +112,17 @@ func (c *Cache) validate() error {
 			allErrs = append(allErrs, field.Required(field.NewPath("spec").Child("dataSource").Child("dbType"), "A dataSource dbType must be defined"))
 		}
 
-		if ds.SecretRef != nil && ds.ServiceProviderRef != nil {
-			allErrs = append(allErrs, field.Duplicate(field.NewPath("spec").Child("dataSource"), "At most one of ['secretRef', 'serviceProviderRef'] must be configured"))
-		} else if ds.SecretRef == nil && ds.ServiceProviderRef == nil {
-			allErrs = append(allErrs, field.Required(field.NewPath("spec").Child("dataSource"), "'secretRef' OR 'serviceProviderRef' must be supplied"))
+		configured := 0
+		for _, set := range []bool{ds.SecretRef != nil, ds.ServiceProviderRef != nil, ds.Vault != nil} {
+			if set {
+				configured++
+			}
+		}
+
+		if configured > 1 {
+			allErrs = append(allErrs, field.Duplicate(field.NewPath("spec").Child("dataSource"), "At most one of ['secretRef', 'serviceProviderRef', 'vault'] must be configured"))
+		} else if configured == 0 {
+			allErrs = append(allErrs, field.Required(field.NewPath("spec").Child("dataSource"), "'secretRef', 'serviceProviderRef' OR 'vault' must be supplied"))
 		} else {
 			if ds.SecretRef != nil {
 				RequireField(&allErrs, "name", ds.SecretRef.Name, field.NewPath("spec").Child("dataSource").Child("secretRef"))
@@ -76,9 +131,13 @@ func (c *Cache) validate() error {
 				RequireField(&allErrs, "apiVersion", ds.ServiceProviderRef.ApiVersion, root)
 				RequireField(&allErrs, "kind", ds.ServiceProviderRef.Kind, root)
 				RequireField(&allErrs, "name", ds.ServiceProviderRef.Name, root)
+			} else {
+				validateVaultSource(&allErrs, field.NewPath("spec").Child("dataSource").Child("vault"), ds.Vault)
 			}
 		}
 	}
+
+	validateNamespaceSelector(&allErrs, field.NewPath("spec").Child("allowedRuleNamespaces"), c.Spec.AllowedRuleNamespaces)
 	return StatusError(allErrs, c.Name, KindCache)
 }
 
@@ -87,6 +146,242 @@ func (c *Cache) ValidateDelete() error {
 	return nil
 }
 
+func validateDBSyncerAutoscaling(allErrs *field.ErrorList, p *field.Path, a *DBSyncerAutoscalingSpec) {
+	if a == nil {
+		return
+	}
+
+	if a.MinReplicas < 0 {
+		*allErrs = append(*allErrs, field.Invalid(p.Child("minReplicas"), a.MinReplicas, "minReplicas must not be negative"))
+	}
+	if a.MaxReplicas < 1 {
+		*allErrs = append(*allErrs, field.Invalid(p.Child("maxReplicas"), a.MaxReplicas, "maxReplicas must be at least 1"))
+	} else if a.MaxReplicas < a.MinReplicas {
+		*allErrs = append(*allErrs, field.Invalid(p.Child("maxReplicas"), a.MaxReplicas, "maxReplicas must not be less than minReplicas"))
+	}
+	if a.TargetLag != "" {
+		if d, err := time.ParseDuration(a.TargetLag); err != nil {
+			*allErrs = append(*allErrs, field.Invalid(p.Child("targetLag"), a.TargetLag, err.Error()))
+		} else if d < time.Second {
+			*allErrs = append(*allErrs, field.Invalid(p.Child("targetLag"), a.TargetLag, "targetLag must be at least 1s"))
+		}
+	}
+}
+
+func validateUpdateStrategy(allErrs *field.ErrorList, p *field.Path, c *Cache) {
+	s := c.UpdateStrategy()
+	if s == nil {
+		return
+	}
+
+	if s.Type == UpdateStrategyType_ON_DELETE {
+		if c.Cluster() {
+			*allErrs = append(*allErrs, field.Invalid(p.Child("type"), s.Type.String(), "ON_DELETE is only supported for LOCAL caches"))
+		}
+		if s.MaxUnavailable != "" || s.MaxSurge != "" || s.Partition != 0 {
+			*allErrs = append(*allErrs, field.Forbidden(p, "maxUnavailable, maxSurge and partition are only supported for ROLLING_UPDATE"))
+		}
+		return
+	}
+
+	if s.Partition < 0 {
+		*allErrs = append(*allErrs, field.Invalid(p.Child("partition"), s.Partition, "partition must not be negative"))
+	} else if s.Partition > 0 {
+		if c.Cluster() {
+			*allErrs = append(*allErrs, field.Invalid(p.Child("partition"), s.Partition, "partition is only supported for LOCAL caches"))
+		}
+		if s.MaxSurge != "" {
+			*allErrs = append(*allErrs, field.Forbidden(p.Child("maxSurge"), "maxSurge is not supported for partitioned updates"))
+		}
+	}
+
+	if s.MaxSurge != "" && c.WorkloadKind() == WorkloadStatefulSet {
+		*allErrs = append(*allErrs, field.Forbidden(p.Child("maxSurge"), "maxSurge is not supported for CLUSTER caches with persistence"))
+	}
+
+	maxUnavailable := validateIntOrPercent(allErrs, p.Child("maxUnavailable"), s.MaxUnavailable)
+	maxSurge := validateIntOrPercent(allErrs, p.Child("maxSurge"), s.MaxSurge)
+	if s.MaxUnavailable != "" && s.MaxSurge != "" && maxUnavailable == 0 && maxSurge == 0 {
+		*allErrs = append(*allErrs, field.Invalid(p.Child("maxUnavailable"), s.MaxUnavailable, "maxUnavailable may not be 0 when maxSurge is 0"))
+	}
+}
+
+// CachePersistenceEnabled allows spec.deployment.persistence to be configured. Persistence is experimental and disabled
+// by default, as cache-manager does not store its caches in the provisioned volumes yet
+var CachePersistenceEnabled = false
+
+func validatePersistence(allErrs *field.ErrorList, p *field.Path, ps *CachePersistenceSpec) {
+	if ps == nil {
+		return
+	}
+
+	if !CachePersistenceEnabled {
+		*allErrs = append(*allErrs, field.Forbidden(p, "persistence is experimental and must be enabled with the operator's --enable-cache-persistence flag"))
+		return
+	}
+
+	RequireField(allErrs, "size", ps.Size, p)
+	if ps.Size != "" {
+		if size, err := resource.ParseQuantity(ps.Size); err != nil {
+			*allErrs = append(*allErrs, field.Invalid(p.Child("size"), ps.Size, err.Error()))
+		} else if size.Sign() <= 0 {
+			*allErrs = append(*allErrs, field.Invalid(p.Child("size"), ps.Size, "size must be greater than zero"))
+		}
+	}
+
+	if ps.StorageClassName != "" {
+		for _, msg := range validation.IsDNS1123Subdomain(ps.StorageClassName) {
+			*allErrs = append(*allErrs, field.Invalid(p.Child("storageClassName"), ps.StorageClassName, msg))
+		}
+	}
+}
+
+// validateIntOrPercent validates that the value is either a non-negative integer or a percentage between 0% and 100%,
+// returning the integer or percentage value
+func validateIntOrPercent(allErrs *field.ErrorList, p *field.Path, value string) int {
+	if value == "" {
+		return -1
+	}
+
+	v := intstr.Parse(value)
+	var i int
+	if v.Type == intstr.String {
+		percent, err := strconv.Atoi(strings.TrimSuffix(v.StrVal, "%"))
+		if err != nil || !strings.HasSuffix(v.StrVal, "%") || percent > 100 {
+			*allErrs = append(*allErrs, field.Invalid(p, value, "must be an integer or a percentage between 0% and 100%"))
+			return -1
+		}
+		i = percent
+	} else {
+		i = v.IntValue()
+	}
+
+	if i < 0 {
+		*allErrs = append(*allErrs, field.Invalid(p, value, "must not be negative"))
+		return -1
+	}
+	return i
+}
+
+func validateVaultSource(allErrs *field.ErrorList, p *field.Path, v *VaultSource) {
+	RequireField(allErrs, "address", v.Address, p)
+	RequireField(allErrs, "path", v.Path, p)
+
+	if v.Role == "" && v.TokenSecretRef == nil {
+		*allErrs = append(*allErrs, field.Required(p, "'role' OR 'tokenSecretRef' must be supplied"))
+	} else if v.TokenSecretRef != nil {
+		RequireField(allErrs, "name", v.TokenSecretRef.Name, p.Child("tokenSecretRef"))
+	}
+
+	if v.RefreshInterval != "" {
+		if d, err := time.ParseDuration(v.RefreshInterval); err != nil {
+			*allErrs = append(*allErrs, field.Invalid(p.Child("refreshInterval"), v.RefreshInterval, err.Error()))
+		} else if d <= 0 {
+			*allErrs = append(*allErrs, field.Invalid(p.Child("refreshInterval"), v.RefreshInterval, "refreshInterval must be a positive duration"))
+		}
+	}
+}
+
+func validateNamespaceSelector(allErrs *field.ErrorList, p *field.Path, s *NamespaceSelector) {
+	if s == nil {
+		return
+	}
+
+	for i, name := range s.MatchNames {
+		if name == AllNamespaces {
+			continue
+		}
+		for _, msg := range validation.IsDNS1123Label(name) {
+			*allErrs = append(*allErrs, field.Invalid(p.Child("matchNames").Index(i), name, msg))
+		}
+	}
+	*allErrs = append(*allErrs, metav1validation.ValidateLabels(s.MatchLabels, p.Child("matchLabels"))...)
+}
+
+func validateLogging(allErrs *field.ErrorList, p *field.Path, l *Logging) {
+	if l == nil {
+		return
+	}
+
+	levels := sets.NewString(LogLevels...)
+	if l.Level != "" && !levels.Has(l.Level) {
+		*allErrs = append(*allErrs, field.NotSupported(p.Child("level"), l.Level, LogLevels))
+	}
+
+	for _, category := range sets.StringKeySet(l.Categories).List() {
+		if category == "" {
+			*allErrs = append(*allErrs, field.Invalid(p.Child("categories"), category, "category names must not be empty"))
+		} else if level := l.Categories[category]; !levels.Has(level) {
+			*allErrs = append(*allErrs, field.NotSupported(p.Child("categories").Key(category), level, LogLevels))
+		}
+	}
+}
+
+func validateExtraEnv(allErrs *field.ErrorList, p *field.Path, env []*EnvVar) {
+	names := make(map[string]bool, len(env))
+	for i, e := range env {
+		idx := p.Index(i)
+		if e.Name == "" {
+			*allErrs = append(*allErrs, field.Required(idx.Child("name"), "name must be supplied"))
+			continue
+		}
+
+		for _, msg := range validation.IsEnvVarName(e.Name) {
+			*allErrs = append(*allErrs, field.Invalid(idx.Child("name"), e.Name, msg))
+		}
+
+		if prefix, reserved := ReservedEnvPrefix(e.Name); reserved {
+			*allErrs = append(*allErrs, field.Forbidden(idx.Child("name"), fmt.Sprintf("environment variables prefixed with '%s' are owned by the operator", prefix)))
+		} else if ReservedEnv(e.Name) {
+			*allErrs = append(*allErrs, field.Forbidden(idx.Child("name"), fmt.Sprintf("environment variable '%s' is owned by the operator", e.Name)))
+		}
+
+		if names[e.Name] {
+			*allErrs = append(*allErrs, field.Duplicate(idx.Child("name"), e.Name))
+		}
+		names[e.Name] = true
+
+		sources := 0
+		for _, set := range []bool{e.Value != "", e.ConfigMapKeyRef != nil, e.SecretKeyRef != nil} {
+			if set {
+				sources++
+			}
+		}
+		if sources > 1 {
+			*allErrs = append(*allErrs, field.Invalid(idx, e.Name, "At most one of ['value', 'configMapKeyRef', 'secretKeyRef'] must be configured"))
+		}
+
+		for name, ref := range map[string]*KeySelector{"configMapKeyRef": e.ConfigMapKeyRef, "secretKeyRef": e.SecretKeyRef} {
+			if ref != nil {
+				RequireField(allErrs, "name", ref.Name, idx.Child(name))
+				RequireField(allErrs, "key", ref.Key, idx.Child(name))
+			}
+		}
+	}
+}
+
+func validateEnvFrom(allErrs *field.ErrorList, p *field.Path, envFrom []*EnvFromSource) {
+	for i, e := range envFrom {
+		idx := p.Index(i)
+		if (e.ConfigMapRef == nil) == (e.SecretRef == nil) {
+			*allErrs = append(*allErrs, field.Required(idx, "Exactly one of ['configMapRef', 'secretRef'] must be supplied"))
+		} else if e.ConfigMapRef != nil {
+			RequireField(allErrs, "name", e.ConfigMapRef.Name, idx.Child("configMapRef"))
+		} else {
+			RequireField(allErrs, "name", e.SecretRef.Name, idx.Child("secretRef"))
+		}
+
+		if e.Prefix != "" {
+			for _, msg := range validation.IsEnvVarName(e.Prefix) {
+				*allErrs = append(*allErrs, field.Invalid(idx.Child("prefix"), e.Prefix, msg))
+			}
+			if prefix, reserved := ReservedEnvPrefix(e.Prefix); reserved {
+				*allErrs = append(*allErrs, field.Forbidden(idx.Child("prefix"), fmt.Sprintf("environment variables prefixed with '%s' are owned by the operator", prefix)))
+			}
+		}
+	}
+}
+
 func validateResources(allErrs *field.ErrorList, p *field.Path, r *Resources) {
 	if r == nil {
 		return
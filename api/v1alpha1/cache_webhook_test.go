@@ -143,7 +143,7 @@ var _ = Describe("Cache Webhooks", func() {
 		}
 		ExpectInvalidErrStatus(
 			k8sClient.Create(ctx, invalid),
-			statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.dataSource", "'secretRef' OR 'serviceProviderRef' must be supplied"},
+			statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.dataSource", "'secretRef', 'serviceProviderRef' OR 'vault' must be supplied"},
 		)
 	})
 
@@ -169,7 +169,7 @@ var _ = Describe("Cache Webhooks", func() {
 		}
 		ExpectInvalidErrStatus(
 			k8sClient.Create(ctx, invalid),
-			statusDetailCause{metav1.CauseTypeFieldValueDuplicate, "spec.dataSource", "At most one of ['secretRef', 'serviceProviderRef'] must be configured"},
+			statusDetailCause{metav1.CauseTypeFieldValueDuplicate, "spec.dataSource", "At most one of ['secretRef', 'serviceProviderRef', 'vault'] must be configured"},
 		)
 	})
 
@@ -283,4 +283,400 @@ var _ = Describe("Cache Webhooks", func() {
 			statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.dbSyncer.resources.limits.memory", "quantities must match the regular expression"},
 		)
 	})
+	It("should reject invalid allowedRuleNamespaces", func() {
+
+		invalid := &Cache{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: CacheSpec{
+				DataSource: &DataSourceSpec{
+					DbType: DBType_POSTGRES_14.Enum(),
+					SecretRef: &LocalObjectReference{
+						Name: "some-secret",
+					},
+				},
+				AllowedRuleNamespaces: &NamespaceSelector{
+					MatchNames:  []string{AllNamespaces, "Invalid_Namespace"},
+					MatchLabels: map[string]string{"invalid key!": "value"},
+				},
+			},
+		}
+
+		ExpectInvalidErrStatus(k8sClient.Create(ctx, invalid),
+			statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.allowedRuleNamespaces.matchNames[1]", "a lowercase RFC 1123 label must consist of"},
+			statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.allowedRuleNamespaces.matchLabels", "name part must consist of"},
+		)
+	})
+	It("should reject invalid vault datasource", func() {
+
+		invalid := &Cache{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: CacheSpec{
+				DataSource: &DataSourceSpec{
+					DbType: DBType_POSTGRES_14.Enum(),
+					Vault: &VaultSource{
+						RefreshInterval: "often",
+					},
+				},
+			},
+		}
+
+		ExpectInvalidErrStatus(k8sClient.Create(ctx, invalid),
+			statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.dataSource.vault.address", "'address' field must not be empty"},
+			statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.dataSource.vault.path", "'path' field must not be empty"},
+			statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.dataSource.vault", "'role' OR 'tokenSecretRef' must be supplied"},
+			statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.dataSource.vault.refreshInterval", "invalid duration"},
+		)
+	})
+	It("should reject invalid updateStrategy", func() {
+
+		invalid := &Cache{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: CacheSpec{
+				Deployment: &CacheDeploymentSpec{
+					Type:     CacheDeploymentType_CLUSTER,
+					Replicas: 3,
+					UpdateStrategy: &UpdateStrategy{
+						MaxUnavailable: "150%",
+						MaxSurge:       "-1",
+						Partition:      1,
+					},
+				},
+				DataSource: &DataSourceSpec{
+					DbType: DBType_POSTGRES_14.Enum(),
+					SecretRef: &LocalObjectReference{
+						Name: "some-secret",
+					},
+				},
+			},
+		}
+
+		ExpectInvalidErrStatus(k8sClient.Create(ctx, invalid),
+			statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.deployment.updateStrategy.partition", "partition is only supported for LOCAL caches"},
+			statusDetailCause{"FieldValueForbidden", "spec.deployment.updateStrategy.maxSurge", "maxSurge is not supported for partitioned updates"},
+			statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.deployment.updateStrategy.maxUnavailable", "must be an integer or a percentage between 0% and 100%"},
+			statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.deployment.updateStrategy.maxSurge", "must not be negative"},
+		)
+
+		invalid.Spec.Deployment = &CacheDeploymentSpec{
+			Type: CacheDeploymentType_CLUSTER,
+			UpdateStrategy: &UpdateStrategy{
+				Type:           UpdateStrategyType_ON_DELETE,
+				MaxUnavailable: "1",
+			},
+		}
+		ExpectInvalidErrStatus(k8sClient.Create(ctx, invalid),
+			statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.deployment.updateStrategy.type", "ON_DELETE is only supported for LOCAL caches"},
+			statusDetailCause{"FieldValueForbidden", "spec.deployment.updateStrategy", "maxUnavailable, maxSurge and partition are only supported for ROLLING_UPDATE"},
+		)
+	})
+	It("should reject negative dbSyncer replicas", func() {
+
+		invalid := &Cache{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: CacheSpec{
+				DbSyncer: &DBSyncerDeploymentSpec{
+					Replicas: -1,
+				},
+				DataSource: &DataSourceSpec{
+					DbType: DBType_POSTGRES_14.Enum(),
+					SecretRef: &LocalObjectReference{
+						Name: "some-secret",
+					},
+				},
+			},
+		}
+
+		ExpectInvalidErrStatus(k8sClient.Create(ctx, invalid),
+			statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.dbSyncer.replicas", "replicas must not be negative"},
+		)
+	})
+	It("should reject invalid logging and environment", func() {
+
+		invalid := &Cache{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: CacheSpec{
+				Deployment: &CacheDeploymentSpec{
+					Type: CacheDeploymentType_LOCAL,
+					Logging: &Logging{
+						Level: "VERBOSE",
+						Categories: map[string]string{
+							"io.gingersnapproject": "DEBUG",
+							"io.quarkus":           "debug",
+						},
+					},
+					ExtraEnv: []*EnvVar{
+						{Name: "GINGERSNAP_K8S_NAMESPACE", Value: "other"},
+						{Name: "JAVA_OPTS", Value: "-Xmx1g", SecretKeyRef: &KeySelector{Name: "opts", Key: "java"}},
+						{Name: "JAVA_OPTS", ConfigMapKeyRef: &KeySelector{Name: "opts"}},
+					},
+				},
+				DbSyncer: &DBSyncerDeploymentSpec{
+					ExtraEnv: []*EnvVar{
+						{Name: "1INVALID"},
+						{Name: "GINGERSNAP_LEADER_ELECTION_ENABLED", Value: "false"},
+						{Name: "SERVICE_BINDING_ROOT", Value: "/tmp"},
+						{Name: "GINGERSNAP_PREFLIGHT", Value: "true"},
+						{Name: "GINGERSNAP_PREFLIGHTS", Value: "unrelated"},
+					},
+					EnvFrom: []*EnvFromSource{
+						{Prefix: "GINGERSNAP_K8S_", ConfigMapRef: &LocalObjectReference{Name: "env"}},
+						{},
+					},
+				},
+				DataSource: &DataSourceSpec{
+					DbType: DBType_POSTGRES_14.Enum(),
+					SecretRef: &LocalObjectReference{
+						Name: "some-secret",
+					},
+				},
+			},
+		}
+
+		ExpectInvalidErrStatus(k8sClient.Create(ctx, invalid),
+			statusDetailCause{metav1.CauseTypeFieldValueNotSupported, "spec.deployment.logging.level", "supported values"},
+			statusDetailCause{metav1.CauseTypeFieldValueNotSupported, "spec.deployment.logging.categories[io.quarkus]", "supported values"},
+			statusDetailCause{"FieldValueForbidden", "spec.deployment.extraEnv[0].name", "environment variables prefixed with 'GINGERSNAP_K8S_' are owned by the operator"},
+			statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.deployment.extraEnv[1]", "At most one of ['value', 'configMapKeyRef', 'secretKeyRef'] must be configured"},
+			statusDetailCause{metav1.CauseTypeFieldValueDuplicate, "spec.deployment.extraEnv[2].name", ""},
+			statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.deployment.extraEnv[2].configMapKeyRef.key", "'key' field must not be empty"},
+			statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.dbSyncer.extraEnv[0].name", "a valid environment variable name must consist of"},
+			statusDetailCause{"FieldValueForbidden", "spec.dbSyncer.extraEnv[1].name", "environment variables prefixed with 'GINGERSNAP_LEADER_ELECTION_' are owned by the operator"},
+			statusDetailCause{"FieldValueForbidden", "spec.dbSyncer.extraEnv[2].name", "environment variable 'SERVICE_BINDING_ROOT' is owned by the operator"},
+			statusDetailCause{"FieldValueForbidden", "spec.dbSyncer.extraEnv[3].name", "environment variable 'GINGERSNAP_PREFLIGHT' is owned by the operator"},
+			statusDetailCause{"FieldValueForbidden", "spec.dbSyncer.envFrom[0].prefix", "environment variables prefixed with 'GINGERSNAP_K8S_' are owned by the operator"},
+			statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.dbSyncer.envFrom[1]", "Exactly one of ['configMapRef', 'secretRef'] must be supplied"},
+		)
+	})
+
+	It("should reject invalid dbSyncer autoscaling", func() {
+
+		invalid := &Cache{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: CacheSpec{
+				DbSyncer: &DBSyncerDeploymentSpec{
+					Autoscaling: &DBSyncerAutoscalingSpec{
+						MinReplicas: -1,
+						TargetLag:   "500ms",
+					},
+				},
+				DataSource: &DataSourceSpec{
+					DbType: DBType_POSTGRES_14.Enum(),
+					SecretRef: &LocalObjectReference{
+						Name: "some-secret",
+					},
+				},
+			},
+		}
+
+		ExpectInvalidErrStatus(k8sClient.Create(ctx, invalid),
+			statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.dbSyncer.autoscaling.minReplicas", "minReplicas must not be negative"},
+			statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.dbSyncer.autoscaling.maxReplicas", "maxReplicas must be at least 1"},
+			statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.dbSyncer.autoscaling.targetLag", "targetLag must be at least 1s"},
+		)
+
+		invalid.Spec.DbSyncer.Autoscaling = &DBSyncerAutoscalingSpec{
+			MinReplicas: 3,
+			MaxReplicas: 2,
+			TargetLag:   "often",
+		}
+		ExpectInvalidErrStatus(k8sClient.Create(ctx, invalid),
+			statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.dbSyncer.autoscaling.maxReplicas", "maxReplicas must not be less than minReplicas"},
+			statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.dbSyncer.autoscaling.targetLag", "invalid duration"},
+		)
+	})
+
+	It("should reject scaling LOCAL caches", func() {
+
+		created := &Cache{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: CacheSpec{
+				DataSource: &DataSourceSpec{
+					DbType: DBType_POSTGRES_14.Enum(),
+					SecretRef: &LocalObjectReference{
+						Name: "some-secret",
+					},
+				},
+			},
+		}
+
+		Expect(k8sClient.Create(ctx, created)).Should(Succeed())
+		Expect(k8sClient.Get(ctx, key, created)).Should(Succeed())
+
+		created.Spec.Deployment.Replicas = 2
+		ExpectInvalidErrStatus(k8sClient.Update(ctx, created),
+			statusDetailCause{"FieldValueForbidden", "spec.deployment.replicas", "LOCAL caches run a pod on every node and cannot be scaled"},
+		)
+	})
+
+	It("should reject scaling CLUSTER caches to zero replicas", func() {
+
+		c := &Cache{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: key.Name,
+			},
+			Spec: CacheSpec{
+				Deployment: &CacheDeploymentSpec{
+					Type:     CacheDeploymentType_CLUSTER,
+					Replicas: 3,
+				},
+			},
+		}
+
+		Expect(validateScale(c, 3, 3)).Should(Succeed())
+		Expect(validateScale(c, 3, 5)).Should(Succeed())
+		ExpectInvalidErrStatus(validateScale(c, 3, 0),
+			statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.deployment.replicas", "CLUSTER caches must have at least one replica"},
+		)
+	})
+
+	It("should reject persistence unless it has been enabled", func() {
+
+		invalid := &Cache{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: CacheSpec{
+				Deployment: &CacheDeploymentSpec{
+					Type: CacheDeploymentType_CLUSTER,
+					Persistence: &CachePersistenceSpec{
+						Size: "1Gi",
+					},
+				},
+				DataSource: &DataSourceSpec{
+					DbType: DBType_POSTGRES_14.Enum(),
+					SecretRef: &LocalObjectReference{
+						Name: "some-secret",
+					},
+				},
+			},
+		}
+
+		ExpectInvalidErrStatus(k8sClient.Create(ctx, invalid),
+			statusDetailCause{"FieldValueForbidden", "spec.deployment.persistence", "persistence is experimental"},
+		)
+	})
+
+	Context("with persistence enabled", func() {
+
+		BeforeEach(func() {
+			CachePersistenceEnabled = true
+		})
+
+		AfterEach(func() {
+			CachePersistenceEnabled = false
+		})
+
+		It("should reject invalid persistence", func() {
+
+			invalid := &Cache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: CacheSpec{
+					Deployment: &CacheDeploymentSpec{
+						Type:        CacheDeploymentType_CLUSTER,
+						Persistence: &CachePersistenceSpec{},
+						UpdateStrategy: &UpdateStrategy{
+							MaxSurge: "1",
+						},
+					},
+					DataSource: &DataSourceSpec{
+						DbType: DBType_POSTGRES_14.Enum(),
+						SecretRef: &LocalObjectReference{
+							Name: "some-secret",
+						},
+					},
+				},
+			}
+
+			ExpectInvalidErrStatus(k8sClient.Create(ctx, invalid),
+				statusDetailCause{"FieldValueForbidden", "spec.deployment.updateStrategy.maxSurge", "maxSurge is not supported for CLUSTER caches with persistence"},
+				statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.deployment.persistence.size", "'size' field must not be empty"},
+			)
+
+			invalid.Spec.Deployment = &CacheDeploymentSpec{
+				Type: CacheDeploymentType_LOCAL,
+				Persistence: &CachePersistenceSpec{
+					Size:             "0",
+					StorageClassName: "Fast_SSD",
+				},
+			}
+			ExpectInvalidErrStatus(k8sClient.Create(ctx, invalid),
+				statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.deployment.persistence.size", "size must be greater than zero"},
+				statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.deployment.persistence.storageClassName", "a lowercase RFC 1123 subdomain"},
+			)
+
+			invalid.Spec.Deployment.Persistence.Size = "1Gb"
+			ExpectInvalidErrStatus(k8sClient.Create(ctx, invalid),
+				statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.deployment.persistence.size", "quantities must match the regular expression"},
+				statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.deployment.persistence.storageClassName", "a lowercase RFC 1123 subdomain"},
+			)
+		})
+
+		It("should reject changes to the persistence of CLUSTER caches", func() {
+
+			created := &Cache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: CacheSpec{
+					Deployment: &CacheDeploymentSpec{
+						Type: CacheDeploymentType_CLUSTER,
+						Persistence: &CachePersistenceSpec{
+							Size: "1Gi",
+						},
+					},
+					DataSource: &DataSourceSpec{
+						DbType: DBType_POSTGRES_14.Enum(),
+						SecretRef: &LocalObjectReference{
+							Name: "some-secret",
+						},
+					},
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, created)).Should(Succeed())
+			Expect(k8sClient.Get(ctx, key, created)).Should(Succeed())
+			Expect(created.WorkloadKind()).Should(Equal(WorkloadStatefulSet))
+
+			created.Spec.Deployment.Persistence = &CachePersistenceSpec{
+				Size:             "2Gi",
+				StorageClassName: "fast",
+			}
+			ExpectInvalidErrStatus(k8sClient.Update(ctx, created),
+				statusDetailCause{"FieldValueForbidden", "spec.deployment.persistence.size", "size cannot be changed for CLUSTER caches"},
+				statusDetailCause{"FieldValueForbidden", "spec.deployment.persistence.storageClassName", "storageClassName cannot be changed for CLUSTER caches"},
+			)
+
+			// Persistence can be disabled, migrating the Cache to a Deployment
+			Expect(k8sClient.Get(ctx, key, created)).Should(Succeed())
+			created.Spec.Deployment.Persistence = nil
+			Expect(k8sClient.Update(ctx, created)).Should(Succeed())
+			Expect(created.WorkloadKind()).Should(Equal(WorkloadDeployment))
+		})
+	})
 })
@@ -0,0 +1,45 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"path"
+)
+
+// Finished returns true if the transfer has either completed or failed
+func (p TransferPhase) Finished() bool {
+	return p == TransferCompleted || p == TransferFailed
+}
+
+// String returns a human-readable description of the archive location
+func (s *BackupStorage) String() string {
+	switch {
+	case s.S3 != nil:
+		return fmt.Sprintf("s3 object '%s/%s' at '%s'", s.S3.Bucket, s.S3.Key, s.S3.Endpoint)
+	default:
+		return "undefined storage"
+	}
+}
+
+// ArchiveName returns the default name of the archive of the CacheBackup
+func (b *CacheBackup) ArchiveName() string {
+	return fmt.Sprintf("%s.jsonl.gz", b.Name)
+}
+
+// ArchiveStorage returns the spec.storage of the CacheBackup with the default S3 key applied
+func (b *CacheBackup) ArchiveStorage() *BackupStorage {
+	storage := b.Spec.Storage.DeepCopy()
+	if s3 := storage.S3; s3 != nil && s3.Key == "" {
+		s3.Key = path.Join(b.Namespace, b.ArchiveName())
+	}
+	return storage
+}
+
+// CacheService returns the Cache whose entries are exported
+func (b *CacheBackup) CacheService() CacheService {
+	return CacheService{Name: b.Spec.Cache, Namespace: b.Namespace}
+}
+
+// CacheService returns the Cache that the entries are imported into
+func (r *CacheRestore) CacheService() CacheService {
+	return CacheService{Name: r.Spec.Cache, Namespace: r.Namespace}
+}
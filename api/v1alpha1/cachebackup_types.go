@@ -0,0 +1,127 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const KindCacheBackup = "CacheBackup"
+
+// +kubebuilder:validation:Enum=Pending;InProgress;Completed;Failed
+type TransferPhase string
+
+const (
+	// TransferPending the transfer is waiting for the Cache and its rules to become Ready
+	TransferPending TransferPhase = "Pending"
+	// TransferInProgress the cache entries are being transferred
+	TransferInProgress TransferPhase = "InProgress"
+	// TransferCompleted all cache entries have been transferred
+	TransferCompleted TransferPhase = "Completed"
+	// TransferFailed the transfer was aborted, the reason is reported in the status message
+	TransferFailed TransferPhase = "Failed"
+)
+
+// BackupStorage the location of a backup archive. Archives are stored outside the cluster, so that they are available
+// to whichever operator replica performs a restore
+type BackupStorage struct {
+	// S3 stores the archive in an S3-compatible object store, e.g. MinIO. This is the only supported storage, a
+	// PersistentVolumeClaim cannot be used directly as the operator cannot mount the claims of the Cache's namespace.
+	// An archive can be kept on a PersistentVolumeClaim by exposing the claim through an S3-compatible store
+	// +optional
+	S3 *S3Storage `json:"s3,omitempty"`
+}
+
+// S3Storage an object in an S3-compatible object store
+type S3Storage struct {
+	// Endpoint the URL of the object store, e.g. http://minio.minio.svc:9000. Buckets are addressed path-style
+	Endpoint string `json:"endpoint"`
+	// Region of the bucket, defaults to us-east-1
+	// +optional
+	Region string `json:"region,omitempty"`
+	// Bucket the name of the bucket
+	Bucket string `json:"bucket"`
+	// Key of the archive object. A CacheBackup defaults to <namespace>/<name>.jsonl.gz
+	// +optional
+	Key string `json:"key,omitempty"`
+	// CredentialsSecret the name of the Secret, in the namespace of the resource, containing the AWS_ACCESS_KEY_ID and
+	// AWS_SECRET_ACCESS_KEY of the object store
+	CredentialsSecret string `json:"credentialsSecret"`
+}
+
+// CacheBackupSpec defines the desired state of CacheBackup
+type CacheBackupSpec struct {
+	// Cache the name of the Cache, in the namespace of the CacheBackup, whose entries are exported
+	Cache string `json:"cache"`
+	// Rules the names of the rules whose entries are exported. Defaults to every rule attached to the Cache
+	// +optional
+	Rules []string `json:"rules,omitempty"`
+	// Storage the location of the backup archive
+	Storage BackupStorage `json:"storage"`
+}
+
+// TransferProgress the progress of a backup or restore
+type TransferProgress struct {
+	// Rules the number of rules whose entries have been transferred
+	Rules int32 `json:"rules"`
+	// TotalRules the number of rules whose entries are transferred
+	TotalRules int32 `json:"totalRules"`
+	// Entries the number of entries transferred
+	Entries int64 `json:"entries"`
+}
+
+// CacheBackupStatus defines the observed state of CacheBackup
+type CacheBackupStatus struct {
+	// +optional
+	Phase TransferPhase `json:"phase,omitempty"`
+	// Human-readable message indicating details about the phase
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Rules the names of the rules whose entries have been exported
+	// +optional
+	Rules []string `json:"rules,omitempty"`
+	// +optional
+	Progress *TransferProgress `json:"progress,omitempty"`
+	// Storage the location of the archive, with the defaults applied
+	// +optional
+	Storage *BackupStorage `json:"storage,omitempty"`
+	// Size of the archive in bytes
+	// +optional
+	Size int64 `json:"size,omitempty"`
+	// Checksum of the archive, sha256:<hex>
+	// +optional
+	Checksum string `json:"checksum,omitempty"`
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Cache",type=string,JSONPath=`.spec.cache`
+//+kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+//+kubebuilder:printcolumn:name="Entries",type=integer,JSONPath=`.status.progress.entries`
+//+kubebuilder:printcolumn:name="Size",type=integer,JSONPath=`.status.size`,description="Archive size in bytes"
+//+kubebuilder:printcolumn:name="Checksum",type=string,JSONPath=`.status.checksum`,priority=1
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// CacheBackup is the Schema for the cachebackups API
+type CacheBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CacheBackupSpec   `json:"spec,omitempty"`
+	Status CacheBackupStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// CacheBackupList contains a list of CacheBackup
+type CacheBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CacheBackup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CacheBackup{}, &CacheBackupList{})
+}
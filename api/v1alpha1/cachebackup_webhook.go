@@ -0,0 +1,71 @@
+package v1alpha1
+
+import (
+	"net/url"
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+var checksumRegex = regexp.MustCompile("^sha256:[0-9a-f]{64}$")
+
+func (b *CacheBackup) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(b).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-gingersnap-project-io-v1alpha1-cachebackup,mutating=false,failurePolicy=fail,sideEffects=None,groups=gingersnap-project.io,resources=cachebackups,verbs=create;update,versions=v1alpha1,name=vcachebackup.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &CacheBackup{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (b *CacheBackup) ValidateCreate() error {
+	var allErrs field.ErrorList
+	spec := field.NewPath("spec")
+	RequireField(&allErrs, "cache", b.Spec.Cache, spec)
+	validateBackupStorage(&allErrs, spec.Child("storage"), &b.Spec.Storage, false)
+	return StatusError(allErrs, b.Name, KindCacheBackup)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (b *CacheBackup) ValidateUpdate(old runtime.Object) error {
+	var allErrs field.ErrorList
+	if !equality.Semantic.DeepEqual(b.Spec, old.(*CacheBackup).Spec) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "The CacheBackup spec is immutable and cannot be updated after initial creation"))
+	}
+	return StatusError(allErrs, b.Name, KindCacheBackup)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (b *CacheBackup) ValidateDelete() error {
+	return nil
+}
+
+// validateBackupStorage ensures that a storage is configured. The S3 key is only required if the archive has no default
+// location
+func validateBackupStorage(allErrs *field.ErrorList, p *field.Path, s *BackupStorage, requireLocation bool) {
+	if s.S3 == nil {
+		*allErrs = append(*allErrs, field.Required(p.Child("s3"), "'s3' must be configured"))
+		return
+	}
+
+	if s3 := s.S3; s3 != nil {
+		s3Path := p.Child("s3")
+		RequireField(allErrs, "endpoint", s3.Endpoint, s3Path)
+		if s3.Endpoint != "" {
+			if u, err := url.Parse(s3.Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				*allErrs = append(*allErrs, field.Invalid(s3Path.Child("endpoint"), s3.Endpoint, "must be an http or https URL"))
+			}
+		}
+		RequireField(allErrs, "bucket", s3.Bucket, s3Path)
+		RequireField(allErrs, "credentialsSecret", s3.CredentialsSecret, s3Path)
+		if requireLocation {
+			RequireField(allErrs, "key", s3.Key, s3Path)
+		}
+	}
+}
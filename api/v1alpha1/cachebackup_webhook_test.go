@@ -0,0 +1,89 @@
+package v1alpha1
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("CacheBackup Webhooks", func() {
+
+	const timeout = time.Second * 30
+	const interval = time.Second * 1
+
+	key := types.NamespacedName{
+		Name:      "backup-envtest",
+		Namespace: "default",
+	}
+
+	AfterEach(func() {
+		By("Expecting to delete successfully")
+		backup := &CacheBackup{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}
+		Expect(runtimeClient.IgnoreNotFound(k8sClient.Delete(ctx, backup))).Should(Succeed())
+		Eventually(func() bool {
+			return apierrors.IsNotFound(k8sClient.Get(ctx, key, backup))
+		}, timeout, interval).Should(BeTrue())
+	})
+
+	It("should reject invalid storage", func() {
+
+		invalid := &CacheBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: CacheBackupSpec{
+				Cache:   "cache",
+				Storage: BackupStorage{},
+			},
+		}
+		ExpectInvalidErrStatus(k8sClient.Create(ctx, invalid),
+			statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.storage.s3", "'s3' must be configured"},
+		)
+
+		invalid.Spec.Storage = BackupStorage{
+			S3: &S3Storage{
+				Endpoint:          "minio:9000",
+				Bucket:            "backups",
+				CredentialsSecret: "minio",
+			},
+		}
+		ExpectInvalidErrStatus(k8sClient.Create(ctx, invalid),
+			statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.storage.s3.endpoint", "must be an http or https URL"},
+		)
+	})
+
+	It("should reject updates to the spec", func() {
+
+		backup := &CacheBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: CacheBackupSpec{
+				Cache: "cache",
+				Storage: BackupStorage{
+					S3: &S3Storage{
+						Endpoint:          "http://minio.minio.svc:9000",
+						Bucket:            "backups",
+						CredentialsSecret: "minio",
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, backup)).Should(Succeed())
+
+		backup.Spec.Rules = []string{"us-east"}
+		ExpectInvalidErrStatus(k8sClient.Update(ctx, backup),
+			statusDetailCause{"FieldValueForbidden", "spec", "The CacheBackup spec is immutable"},
+		)
+
+		// The default archive location is applied
+		Expect(backup.ArchiveStorage().S3.Key).Should(Equal("default/backup-envtest.jsonl.gz"))
+	})
+})
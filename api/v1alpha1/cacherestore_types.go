@@ -0,0 +1,82 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const KindCacheRestore = "CacheRestore"
+
+// CacheRestoreSpec defines the desired state of CacheRestore
+type CacheRestoreSpec struct {
+	// Cache the name of the Cache, in the namespace of the CacheRestore, that the entries are imported into. The rules
+	// of the archive must be attached to the Cache before the restore starts
+	Cache string `json:"cache"`
+	// Backup the name of a Completed CacheBackup, in the namespace of the CacheRestore, whose archive is imported.
+	// Exactly one of Backup or Storage must be configured
+	// +optional
+	Backup string `json:"backup,omitempty"`
+	// Storage the location of the archive to import, e.g. an archive created in another cluster
+	// +optional
+	Storage *BackupStorage `json:"storage,omitempty"`
+	// Checksum the expected checksum of the archive, sha256:<hex>. Defaults to the checksum of the Backup. The archive
+	// is verified before any entry is imported
+	// +optional
+	Checksum string `json:"checksum,omitempty"`
+	// Rules the names of the rules whose entries are imported. Defaults to every rule of the archive
+	// +optional
+	Rules []string `json:"rules,omitempty"`
+}
+
+// CacheRestoreStatus defines the observed state of CacheRestore
+type CacheRestoreStatus struct {
+	// +optional
+	Phase TransferPhase `json:"phase,omitempty"`
+	// Human-readable message indicating details about the phase
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Rules the names of the rules whose entries have been imported
+	// +optional
+	Rules []string `json:"rules,omitempty"`
+	// +optional
+	Progress *TransferProgress `json:"progress,omitempty"`
+	// Size of the archive in bytes
+	// +optional
+	Size int64 `json:"size,omitempty"`
+	// Checksum of the imported archive, sha256:<hex>
+	// +optional
+	Checksum string `json:"checksum,omitempty"`
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Cache",type=string,JSONPath=`.spec.cache`
+//+kubebuilder:printcolumn:name="Backup",type=string,JSONPath=`.spec.backup`
+//+kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+//+kubebuilder:printcolumn:name="Entries",type=integer,JSONPath=`.status.progress.entries`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// CacheRestore is the Schema for the cacherestores API
+type CacheRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CacheRestoreSpec   `json:"spec,omitempty"`
+	Status CacheRestoreStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// CacheRestoreList contains a list of CacheRestore
+type CacheRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CacheRestore `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CacheRestore{}, &CacheRestoreList{})
+}
@@ -0,0 +1,51 @@
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+func (r *CacheRestore) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-gingersnap-project-io-v1alpha1-cacherestore,mutating=false,failurePolicy=fail,sideEffects=None,groups=gingersnap-project.io,resources=cacherestores,verbs=create;update,versions=v1alpha1,name=vcacherestore.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &CacheRestore{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *CacheRestore) ValidateCreate() error {
+	var allErrs field.ErrorList
+	spec := field.NewPath("spec")
+	RequireField(&allErrs, "cache", r.Spec.Cache, spec)
+
+	if (r.Spec.Backup == "") == (r.Spec.Storage == nil) {
+		allErrs = append(allErrs, field.Invalid(spec, r.Spec.Backup, "exactly one of 'backup' or 'storage' must be configured"))
+	} else if r.Spec.Storage != nil {
+		validateBackupStorage(&allErrs, spec.Child("storage"), r.Spec.Storage, true)
+	}
+
+	if r.Spec.Checksum != "" && !checksumRegex.MatchString(r.Spec.Checksum) {
+		allErrs = append(allErrs, field.Invalid(spec.Child("checksum"), r.Spec.Checksum, "must be of the form sha256:<hex>"))
+	}
+	return StatusError(allErrs, r.Name, KindCacheRestore)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *CacheRestore) ValidateUpdate(old runtime.Object) error {
+	var allErrs field.ErrorList
+	if !equality.Semantic.DeepEqual(r.Spec, old.(*CacheRestore).Spec) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "The CacheRestore spec is immutable and cannot be updated after initial creation"))
+	}
+	return StatusError(allErrs, r.Name, KindCacheRestore)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *CacheRestore) ValidateDelete() error {
+	return nil
+}
@@ -0,0 +1,106 @@
+package v1alpha1
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("CacheRestore Webhooks", func() {
+
+	const timeout = time.Second * 30
+	const interval = time.Second * 1
+
+	key := types.NamespacedName{
+		Name:      "restore-envtest",
+		Namespace: "default",
+	}
+
+	AfterEach(func() {
+		By("Expecting to delete successfully")
+		restore := &CacheRestore{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}
+		Expect(runtimeClient.IgnoreNotFound(k8sClient.Delete(ctx, restore))).Should(Succeed())
+		Eventually(func() bool {
+			return apierrors.IsNotFound(k8sClient.Get(ctx, key, restore))
+		}, timeout, interval).Should(BeTrue())
+	})
+
+	It("should require exactly one archive source", func() {
+
+		invalid := &CacheRestore{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: CacheRestoreSpec{
+				Cache: "cache",
+			},
+		}
+		ExpectInvalidErrStatus(k8sClient.Create(ctx, invalid),
+			statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec", "exactly one of 'backup' or 'storage' must be configured"},
+		)
+
+		invalid.Spec.Backup = "backup"
+		invalid.Spec.Storage = &BackupStorage{
+			S3: &S3Storage{
+				Endpoint:          "http://minio.minio.svc:9000",
+				Bucket:            "backups",
+				Key:               "default/backup.jsonl.gz",
+				CredentialsSecret: "minio",
+			},
+		}
+		ExpectInvalidErrStatus(k8sClient.Create(ctx, invalid),
+			statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec", "exactly one of 'backup' or 'storage' must be configured"},
+		)
+	})
+
+	It("should require the archive location and a valid checksum", func() {
+
+		invalid := &CacheRestore{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: CacheRestoreSpec{
+				Cache: "cache",
+				Storage: &BackupStorage{
+					S3: &S3Storage{
+						Endpoint:          "http://minio.minio.svc:9000",
+						Bucket:            "backups",
+						CredentialsSecret: "minio",
+					},
+				},
+				Checksum: "md5:d41d8cd98f00b204e9800998ecf8427e",
+			},
+		}
+		ExpectInvalidErrStatus(k8sClient.Create(ctx, invalid),
+			statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.storage.s3.key", "'key' field must not be empty"},
+			statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.checksum", "must be of the form sha256:<hex>"},
+		)
+	})
+
+	It("should reject updates to the spec", func() {
+
+		restore := &CacheRestore{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: CacheRestoreSpec{
+				Cache:  "cache",
+				Backup: "backup",
+			},
+		}
+		Expect(k8sClient.Create(ctx, restore)).Should(Succeed())
+
+		restore.Spec.Cache = "other"
+		ExpectInvalidErrStatus(k8sClient.Update(ctx, restore),
+			statusDetailCause{"FieldValueForbidden", "spec", "The CacheRestore spec is immutable"},
+		)
+	})
+})
@@ -11,6 +11,13 @@ const (
 	LabelCacheNamespace = LabelCache + "-namespace"
 )
 
+const (
+	// HotRodPort the port of the cache-manager Hot Rod endpoint
+	HotRodPort = 11222
+	// RestPort the port of the cache-manager REST endpoint
+	RestPort = 8080
+)
+
 // CacheService defines the location of the Cache resource that this LazyCacheRule should be applied to
 type CacheService struct {
 	// Name is the name of the Cache resource that the LazyCacheRule will be applied to
@@ -58,10 +65,30 @@ func (s CacheService) SvcName() string {
 	return fmt.Sprintf("%s.%s.svc.cluster.local", s.Name, s.Namespace)
 }
 
+// Host returns the namespace qualified hostname of the Cache Service, resolvable from any namespace in the cluster
+func (s CacheService) Host() string {
+	return fmt.Sprintf("%s.%s.svc", s.Name, s.Namespace)
+}
+
+// RuleKey returns the key identifying a rule of the Cache in the rule ConfigMaps and the status reported by db-syncer.
+// Rules in the Cache namespace are identified by their name, whereas the name of rules in other namespaces is prefixed
+// with their namespace and '_', which is not permitted in resource names, so that rules in different namespaces never
+// share a key
+func (s CacheService) RuleKey(namespace, name string) string {
+	if namespace == s.Namespace {
+		return name
+	}
+	return fmt.Sprintf("%s_%s", namespace, name)
+}
+
 func (s CacheService) DBSyncerName() string {
 	return fmt.Sprintf("%s-db-syncer", s.Name)
 }
 
+func (s CacheService) DBSyncerLease() string {
+	return fmt.Sprintf("%s-leader", s.DBSyncerName())
+}
+
 func (s CacheService) DBSyncerDataServiceBinding() string {
 	return fmt.Sprintf("%s-data", s.DBSyncerName())
 }
@@ -77,3 +104,11 @@ func (s CacheService) DBSyncerCacheServiceBindingSecret() string {
 func (s CacheService) DataSourceServiceBinding() string {
 	return fmt.Sprintf("%s-cache", s.Name)
 }
+
+func (s CacheService) TransitionServiceBinding() string {
+	return fmt.Sprintf("%s-cache-transition", s.Name)
+}
+
+func (s CacheService) DataSourceCredentialsSecret() string {
+	return fmt.Sprintf("%s-db-credentials", s.Name)
+}
@@ -0,0 +1,8 @@
+package v1alpha1
+
+// v1alpha1 is the conversion hub. The operator reconciles v1alpha1 resources and every other served version
+// converts to and from it.
+
+func (*Cache) Hub()          {}
+func (*LazyCacheRule) Hub()  {}
+func (*EagerCacheRule) Hub() {}
@@ -1,6 +1,9 @@
 package v1alpha1
 
 import (
+	"fmt"
+	"time"
+
 	"google.golang.org/protobuf/encoding/protojson"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -29,6 +32,22 @@ func (r *EagerCacheRule) ConfigMap() string {
 	return r.CacheService().EagerCacheConfigMap()
 }
 
+// PreflightJob returns the name of the Job, created in the Cache namespace, that verifies the CDC prerequisites of the rule
+func (r *EagerCacheRule) PreflightJob() string {
+	return fmt.Sprintf("%s-%s-cdc-preflight", r.Namespace, r.Name)
+}
+
+// DefaultLagThreshold is the replication lag after which an EagerCacheRule is reported as Lagging if spec.lagThreshold is not set
+const DefaultLagThreshold = time.Minute
+
+// LagThreshold returns the replication lag after which the rule is reported as Lagging
+func (r *EagerCacheRule) LagThreshold() time.Duration {
+	if d, err := time.ParseDuration(r.Spec.LagThreshold); err == nil && d > 0 {
+		return d
+	}
+	return DefaultLagThreshold
+}
+
 func (r *EagerCacheRule) MarshallSpec() ([]byte, error) {
 	return protojson.MarshalOptions{Multiline: true}.Marshal(&r.Spec)
 }
@@ -65,3 +84,12 @@ func (r *EagerCacheRule) SetCondition(condition EagerCacheRuleCondition) (update
 	})
 	return true
 }
+
+// RetryAttempts the number of consecutive reconciliations requeued with backoff
+func (r *EagerCacheRule) RetryAttempts() int32 {
+	return r.Status.RetryAttempts
+}
+
+func (r *EagerCacheRule) SetRetryAttempts(attempts int32) {
+	r.Status.RetryAttempts = attempts
+}
@@ -6,11 +6,18 @@ import (
 
 const KindEagerCacheRule = "EagerCacheRule"
 
-// +kubebuilder:validation:Enum=Ready
+// +kubebuilder:validation:Enum=Ready;LeaderElected;Lagging;CDCReady
 type EagerCacheRuleConditionType string
 
 const (
 	EagerCacheRuleConditionReady EagerCacheRuleConditionType = "Ready"
+	// EagerCacheRuleConditionLeaderElected is False whilst no db-syncer replica holds the leader Lease, e.g. during failover
+	EagerCacheRuleConditionLeaderElected EagerCacheRuleConditionType = "LeaderElected"
+	// EagerCacheRuleConditionLagging is True when the replication lag of the rule exceeds spec.lagThreshold
+	EagerCacheRuleConditionLagging EagerCacheRuleConditionType = "Lagging"
+	// EagerCacheRuleConditionCDCReady is True once the database has been verified to meet db-syncer's change data
+	// capture prerequisites
+	EagerCacheRuleConditionCDCReady EagerCacheRuleConditionType = "CDCReady"
 )
 
 // EagerCacheRuleCondition indicates the current status of a deployment
@@ -29,6 +36,51 @@ type EagerCacheRuleCondition struct {
 type EagerCacheRuleStatus struct {
 	// +optional
 	Conditions []EagerCacheRuleCondition `json:"conditions,omitempty"`
+	// Credentials the data source credentials currently used by the db-syncer
+	// +optional
+	Credentials *CredentialsStatus `json:"credentials,omitempty"`
+	// Replication the change data capture progress of the rule as reported by the db-syncer leader
+	// +optional
+	Replication *ReplicationStatus `json:"replication,omitempty"`
+	// RetryAttempts the number of consecutive reconciliations that have been requeued with backoff without the rule
+	// making progress. Reset to zero once reconciliation progresses
+	// +optional
+	RetryAttempts int32 `json:"retryAttempts,omitempty"`
+}
+
+// ReplicationStatus describes the change data capture progress of an EagerCacheRule
+type ReplicationStatus struct {
+	// Lag between a change being committed to the database and it being applied to the cache
+	// +optional
+	Lag *metav1.Duration `json:"lag,omitempty"`
+	// LastProcessedChange the commit time of the most recent change applied to the cache
+	// +optional
+	LastProcessedChange *metav1.Time `json:"lastProcessedChange,omitempty"`
+	// Snapshot progress of the rule's table snapshot
+	// +optional
+	Snapshot *SnapshotStatus `json:"snapshot,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Pending;InProgress;Completed;Failed
+type SnapshotState string
+
+const (
+	SnapshotStatePending    SnapshotState = "Pending"
+	SnapshotStateInProgress SnapshotState = "InProgress"
+	SnapshotStateCompleted  SnapshotState = "Completed"
+	SnapshotStateFailed     SnapshotState = "Failed"
+)
+
+// SnapshotStatus describes the progress of a table snapshot
+type SnapshotStatus struct {
+	// State of the snapshot
+	State SnapshotState `json:"state,omitempty"`
+	// ProcessedRows the number of table rows loaded into the cache
+	ProcessedRows int64 `json:"processedRows,omitempty"`
+	// TotalRows the estimated number of table rows to be loaded
+	TotalRows int64 `json:"totalRows,omitempty"`
+	// ResyncGeneration the spec.resyncGeneration that triggered the snapshot
+	ResyncGeneration int64 `json:"resyncGeneration,omitempty"`
 }
 
 // +genclient
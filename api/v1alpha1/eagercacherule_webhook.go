@@ -5,10 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -135,6 +135,7 @@ func (rv *eagerRuleValidator) create(ctx context.Context, r *EagerCacheRule) err
 	} else {
 		RequireNonEmptyArray(&allErrs, "keyColumns", r.Spec.Key.KeyColumns, spec.Child("key"))
 	}
+	validateEagerRuleOperationalFields(&allErrs, r)
 
 	// Ensure that a EagerCacheRule CR with this cacheRef does not already exist in the cluster
 	if len(allErrs) == 0 {
@@ -144,12 +145,7 @@ func (rv *eagerRuleValidator) create(ctx context.Context, r *EagerCacheRule) err
 		}
 
 		list := &EagerCacheRuleList{}
-		listOpts := &runtimeClient.ListOptions{
-			LabelSelector: labels.SelectorFromSet(
-				cache.LabelSelector(),
-			),
-		}
-		if err := rv.client.List(ctx, list, listOpts); err != nil {
+		if err := rv.client.List(ctx, list, cache.MatchingRules()); err != nil {
 			allErrs = append(allErrs, field.InternalError(field.NewPath("spec"), err))
 		} else if len(list.Items) > 0 {
 			rule := &list.Items[0]
@@ -157,13 +153,47 @@ func (rv *eagerRuleValidator) create(ctx context.Context, r *EagerCacheRule) err
 			allErrs = append(allErrs, field.Duplicate(field.NewPath("spec").Child("cacheRef"), msg))
 		}
 	}
+
+	if len(allErrs) == 0 {
+		ValidateCacheAccess(ctx, rv.client, &allErrs, r.Namespace, r.CacheService())
+	}
 	return StatusError(allErrs, r.Name, KindEagerCacheRule)
 }
 
 func (rv *eagerRuleValidator) update(new, old *EagerCacheRule) error {
 	var allErrs field.ErrorList
-	if err := EnsureRuleImmutability(&allErrs, KindEagerCacheRule, new, old); err != nil {
+	validateEagerRuleOperationalFields(&allErrs, new)
+	if new.Spec.ResyncGeneration < old.Spec.ResyncGeneration {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec").Child("resyncGeneration"), new.Spec.ResyncGeneration, "resyncGeneration must not be decreased"))
+	}
+
+	// Operational fields do not change the data served by the rule, so they may be updated
+	newImmutable, oldImmutable := new.DeepCopy(), old.DeepCopy()
+	clearEagerRuleOperationalFields(newImmutable)
+	clearEagerRuleOperationalFields(oldImmutable)
+	if err := EnsureRuleImmutability(&allErrs, KindEagerCacheRule, newImmutable, oldImmutable); err != nil {
 		return fmt.Errorf("unable to compare updated rule with existing rule: %w", err)
 	}
 	return StatusError(allErrs, new.Name, KindEagerCacheRule)
 }
+
+func validateEagerRuleOperationalFields(allErrs *field.ErrorList, r *EagerCacheRule) {
+	if r.Spec.LagThreshold != "" {
+		p := field.NewPath("spec").Child("lagThreshold")
+		if d, err := time.ParseDuration(r.Spec.LagThreshold); err != nil {
+			*allErrs = append(*allErrs, field.Invalid(p, r.Spec.LagThreshold, err.Error()))
+		} else if d <= 0 {
+			*allErrs = append(*allErrs, field.Invalid(p, r.Spec.LagThreshold, "lagThreshold must be a positive duration"))
+		}
+	}
+
+	if r.Spec.ResyncGeneration < 0 {
+		*allErrs = append(*allErrs, field.Invalid(field.NewPath("spec").Child("resyncGeneration"), r.Spec.ResyncGeneration, "resyncGeneration must not be negative"))
+	}
+}
+
+func clearEagerRuleOperationalFields(r *EagerCacheRule) {
+	r.Spec.LagThreshold = ""
+	r.Spec.SnapshotMode = SnapshotMode_INITIAL
+	r.Spec.ResyncGeneration = 0
+}
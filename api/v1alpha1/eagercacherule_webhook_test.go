@@ -189,4 +189,72 @@ var _ = Describe("EagerCacheRule Webhooks", func() {
 			statusDetailCause{metav1.CauseTypeFieldValueDuplicate, "spec.cacheRef", "EagerCacheRule CR already exists"},
 		)
 	})
+	It("Should allow lagThreshold to be updated and reject invalid values", func() {
+
+		created := &EagerCacheRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: EagerCacheRuleSpec{
+				CacheRef: &NamespacedObjectReference{
+					Name:      "cache1",
+					Namespace: "cache2",
+				},
+				TableName: "SomeTable",
+				Key: &EagerCacheKey{
+					KeyColumns: []string{"col1"},
+				},
+				LagThreshold: "30s",
+			},
+		}
+		Expect(k8sClient.Create(ctx, created)).Should(Succeed())
+
+		updated := &EagerCacheRule{}
+		Expect(k8sClient.Get(ctx, key, updated)).Should(Succeed())
+		updated.Spec.LagThreshold = "2m"
+		Expect(k8sClient.Update(ctx, updated)).Should(Succeed())
+
+		Expect(k8sClient.Get(ctx, key, updated)).Should(Succeed())
+		updated.Spec.LagThreshold = "soon"
+		ExpectInvalidErrStatus(
+			k8sClient.Update(ctx, updated),
+			statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.lagThreshold", "invalid duration"},
+		)
+	})
+	It("Should allow a resync to be requested and snapshotMode to be updated", func() {
+
+		created := &EagerCacheRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: EagerCacheRuleSpec{
+				CacheRef: &NamespacedObjectReference{
+					Name:      "cache1",
+					Namespace: "cache2",
+				},
+				TableName: "SomeTable",
+				Key: &EagerCacheKey{
+					KeyColumns: []string{"col1"},
+				},
+				SnapshotMode: SnapshotMode_NEVER,
+			},
+		}
+		Expect(k8sClient.Create(ctx, created)).Should(Succeed())
+
+		updated := &EagerCacheRule{}
+		Expect(k8sClient.Get(ctx, key, updated)).Should(Succeed())
+		Expect(updated.Spec.SnapshotMode).Should(Equal(SnapshotMode_NEVER))
+		updated.Spec.SnapshotMode = SnapshotMode_ALWAYS
+		updated.Spec.ResyncGeneration = 2
+		Expect(k8sClient.Update(ctx, updated)).Should(Succeed())
+
+		Expect(k8sClient.Get(ctx, key, updated)).Should(Succeed())
+		updated.Spec.ResyncGeneration = 1
+		ExpectInvalidErrStatus(
+			k8sClient.Update(ctx, updated),
+			statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.resyncGeneration", "resyncGeneration must not be decreased"},
+		)
+	})
 })
@@ -0,0 +1,115 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gingersnap-project/operator/pkg/kubernetes"
+)
+
+// AnnotationConfigDefaults records the fields of a resource that were defaulted by a GingersnapConfig
+const AnnotationConfigDefaults = Group + "/config-defaults"
+
+// ClusterWide returns true if the GingersnapConfig applies to resources in every namespace
+func (c *GingersnapConfig) ClusterWide() bool {
+	return c.Namespace == kubernetes.OperatorNamespace()
+}
+
+// AppliedDefaults the fields of a resource that were defaulted, keyed by field path, and the <namespace>/<name> of the
+// GingersnapConfig that provided the default
+type AppliedDefaults map[string]string
+
+// ParseAppliedDefaults parses the AnnotationConfigDefaults value of a resource
+func ParseAppliedDefaults(annotations map[string]string) AppliedDefaults {
+	applied := AppliedDefaults{}
+	for _, entry := range strings.Split(annotations[AnnotationConfigDefaults], ",") {
+		if f, config, ok := strings.Cut(entry, "="); ok {
+			applied[f] = config
+		}
+	}
+	return applied
+}
+
+// String returns the AnnotationConfigDefaults value, a comma separated list of <field>=<namespace>/<name> entries
+func (a AppliedDefaults) String() string {
+	entries := make([]string, 0, len(a))
+	for f, config := range a {
+		entries = append(entries, fmt.Sprintf("%s=%s", f, config))
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, ",")
+}
+
+// Fields returns the sorted paths of the fields defaulted by the GingersnapConfig with the given namespaced name
+func (a AppliedDefaults) Fields(config string) []string {
+	var fields []string
+	for f, c := range a {
+		if c == config {
+			fields = append(fields, f)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// MergeDefaults sets the unset fields of the Cache spec to the defaults of the first GingersnapConfig providing them.
+// configs must be ordered by precedence
+func (c *Cache) MergeDefaults(configs []GingersnapConfig) AppliedDefaults {
+	applied := AppliedDefaults{}
+	for i := range configs {
+		config := &configs[i]
+		d := config.Spec.Cache
+		if d == nil {
+			continue
+		}
+		source := fmt.Sprintf("%s/%s", config.Namespace, config.Name)
+
+		if c.Spec.Deployment == nil {
+			c.Spec.Deployment = &CacheDeploymentSpec{}
+		}
+		if c.Spec.Deployment.Resources == nil && d.Resources != nil {
+			c.Spec.Deployment.Resources = d.Resources.DeepCopy()
+			applied["spec.deployment.resources"] = source
+		}
+		if c.Spec.Deployment.Logging == nil && d.Logging != nil {
+			c.Spec.Deployment.Logging = d.Logging.DeepCopy()
+			applied["spec.deployment.logging"] = source
+		}
+
+		if d.DBSyncerResources == nil && d.DBSyncerLogging == nil {
+			continue
+		}
+		if c.Spec.DbSyncer == nil {
+			c.Spec.DbSyncer = &DBSyncerDeploymentSpec{}
+		}
+		if c.Spec.DbSyncer.Resources == nil && d.DBSyncerResources != nil {
+			c.Spec.DbSyncer.Resources = d.DBSyncerResources.DeepCopy()
+			applied["spec.dbSyncer.resources"] = source
+		}
+		if c.Spec.DbSyncer.Logging == nil && d.DBSyncerLogging != nil {
+			c.Spec.DbSyncer.Logging = d.DBSyncerLogging.DeepCopy()
+			applied["spec.dbSyncer.logging"] = source
+		}
+	}
+	return applied
+}
+
+// MergeDefaults sets the unset fields of the EagerCacheRule spec to the defaults of the first GingersnapConfig
+// providing them. configs must be ordered by precedence
+func (r *EagerCacheRule) MergeDefaults(configs []GingersnapConfig) AppliedDefaults {
+	applied := AppliedDefaults{}
+	for i := range configs {
+		config := &configs[i]
+		d := config.Spec.EagerCacheRule
+		if d == nil {
+			continue
+		}
+
+		if r.Spec.LagThreshold == "" && d.LagThreshold != "" {
+			r.Spec.LagThreshold = d.LagThreshold
+			applied["spec.lagThreshold"] = fmt.Sprintf("%s/%s", config.Namespace, config.Name)
+		}
+	}
+	return applied
+}
@@ -0,0 +1,129 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const KindGingersnapConfig = "GingersnapConfig"
+
+// GingersnapConfigName is the name of the GingersnapConfig honoured in each namespace. The GingersnapConfig in the
+// operator namespace is cluster-wide, its defaults apply to resources in every namespace that does not override them
+const GingersnapConfigName = "default"
+
+// GingersnapConfigSpec defines the defaults merged into Gingersnap resources and the configuration of the operator
+type GingersnapConfigSpec struct {
+	// Cache defaults merged into Caches that do not configure them
+	// +optional
+	Cache *CacheDefaults `json:"cache,omitempty"`
+	// EagerCacheRule defaults merged into EagerCacheRules that do not configure them
+	// +optional
+	EagerCacheRule *EagerCacheRuleDefaults `json:"eagerCacheRule,omitempty"`
+	// Operator configures the behaviour of the operator. Only supported in the cluster-wide GingersnapConfig
+	// +optional
+	Operator *OperatorConfig `json:"operator,omitempty"`
+}
+
+// CacheDefaults the defaults merged into the spec of a Cache
+type CacheDefaults struct {
+	// Resources the default spec.deployment.resources
+	// +optional
+	Resources *Resources `json:"resources,omitempty"`
+	// Logging the default spec.deployment.logging
+	// +optional
+	Logging *Logging `json:"logging,omitempty"`
+	// DBSyncerResources the default spec.dbSyncer.resources
+	// +optional
+	DBSyncerResources *Resources `json:"dbSyncerResources,omitempty"`
+	// DBSyncerLogging the default spec.dbSyncer.logging
+	// +optional
+	DBSyncerLogging *Logging `json:"dbSyncerLogging,omitempty"`
+}
+
+// EagerCacheRuleDefaults the defaults merged into the spec of an EagerCacheRule
+type EagerCacheRuleDefaults struct {
+	// LagThreshold the default spec.lagThreshold
+	// +optional
+	LagThreshold string `json:"lagThreshold,omitempty"`
+}
+
+// OperatorConfig the configuration of the operator, reloaded whenever the cluster-wide GingersnapConfig changes
+type OperatorConfig struct {
+	// Images overrides the images configured via the operator's RELATED_IMAGE_ environment variables
+	// +optional
+	Images *ImagesConfig `json:"images,omitempty"`
+	// Backoff configures the delay of requeues of resources that are not making progress
+	// +optional
+	Backoff *BackoffConfig `json:"backoff,omitempty"`
+	// FieldManager the field manager of the resources applied by the operator. It is read once when the operator starts,
+	// a change only takes effect once the operator is restarted
+	// +optional
+	FieldManager string `json:"fieldManager,omitempty"`
+	// +kubebuilder:validation:Enum=debug;info;error
+	// LogLevel the level of the operator's logger
+	// +optional
+	LogLevel string `json:"logLevel,omitempty"`
+}
+
+// ImagesConfig the images of the workloads created by the operator
+type ImagesConfig struct {
+	// +optional
+	CacheManagerMySQL string `json:"cacheManagerMySQL,omitempty"`
+	// +optional
+	CacheManagerPostgres string `json:"cacheManagerPostgres,omitempty"`
+	// +optional
+	CacheManagerMSSQL string `json:"cacheManagerMSSQL,omitempty"`
+	// +optional
+	DBSyncer string `json:"dbSyncer,omitempty"`
+}
+
+// BackoffConfig the delay of consecutive requeues of a resource that is not making progress
+type BackoffConfig struct {
+	// Initial the delay of the first requeue, e.g. 2s
+	// +optional
+	Initial string `json:"initial,omitempty"`
+	// Max the upper bound of the delay, e.g. 5m
+	// +optional
+	Max string `json:"max,omitempty"`
+}
+
+// GingersnapConfigStatus defines the observed state of GingersnapConfig
+type GingersnapConfigStatus struct {
+	// AppliedTo the resources whose spec has been defaulted by this GingersnapConfig
+	// +optional
+	AppliedTo []AppliedDefaultsStatus `json:"appliedTo,omitempty"`
+}
+
+// AppliedDefaultsStatus the fields of a resource that were defaulted by a GingersnapConfig
+type AppliedDefaultsStatus struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Fields the paths of the defaulted fields, e.g. spec.deployment.resources
+	Fields []string `json:"fields"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// GingersnapConfig is the Schema for the gingersnapconfigs API
+type GingersnapConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GingersnapConfigSpec   `json:"spec,omitempty"`
+	Status GingersnapConfigStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// GingersnapConfigList contains a list of GingersnapConfig
+type GingersnapConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GingersnapConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GingersnapConfig{}, &GingersnapConfigList{})
+}
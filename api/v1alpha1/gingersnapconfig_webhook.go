@@ -0,0 +1,190 @@
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gingersnap-project/operator/pkg/kubernetes"
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func (c *GingersnapConfig) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(c).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-gingersnap-project-io-v1alpha1-gingersnapconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=gingersnap-project.io,resources=gingersnapconfigs,verbs=create;update,versions=v1alpha1,name=vgingersnapconfig.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &GingersnapConfig{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (c *GingersnapConfig) ValidateCreate() error {
+	return c.validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (c *GingersnapConfig) ValidateUpdate(_ runtime.Object) error {
+	return c.validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (c *GingersnapConfig) ValidateDelete() error {
+	return nil
+}
+
+func (c *GingersnapConfig) validate() error {
+	var allErrs field.ErrorList
+
+	if c.Name != GingersnapConfigName {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("metadata").Child("name"), c.Name, fmt.Sprintf("a GingersnapConfig must be named '%s'", GingersnapConfigName)))
+	}
+
+	spec := field.NewPath("spec")
+	if d := c.Spec.Cache; d != nil {
+		validateResources(&allErrs, spec.Child("cache").Child("resources"), d.Resources)
+		validateLogging(&allErrs, spec.Child("cache").Child("logging"), d.Logging)
+		validateResources(&allErrs, spec.Child("cache").Child("dbSyncerResources"), d.DBSyncerResources)
+		validateLogging(&allErrs, spec.Child("cache").Child("dbSyncerLogging"), d.DBSyncerLogging)
+	}
+
+	if d := c.Spec.EagerCacheRule; d != nil && d.LagThreshold != "" {
+		validatePositiveDuration(&allErrs, spec.Child("eagerCacheRule").Child("lagThreshold"), d.LagThreshold)
+	}
+
+	if o := c.Spec.Operator; o != nil {
+		if !c.ClusterWide() {
+			allErrs = append(allErrs, field.Forbidden(spec.Child("operator"), "operator configuration is only supported in the GingersnapConfig of the operator namespace"))
+		} else if b := o.Backoff; b != nil {
+			if b.Initial != "" {
+				validatePositiveDuration(&allErrs, spec.Child("operator").Child("backoff").Child("initial"), b.Initial)
+			}
+			if b.Max != "" {
+				validatePositiveDuration(&allErrs, spec.Child("operator").Child("backoff").Child("max"), b.Max)
+			}
+		}
+	}
+	return StatusError(allErrs, c.Name, KindGingersnapConfig)
+}
+
+func validatePositiveDuration(allErrs *field.ErrorList, p *field.Path, value string) {
+	if d, err := time.ParseDuration(value); err != nil {
+		*allErrs = append(*allErrs, field.Invalid(p, value, err.Error()))
+	} else if d <= 0 {
+		*allErrs = append(*allErrs, field.Invalid(p, value, "must be a positive duration"))
+	}
+}
+
+//+kubebuilder:webhook:path=/mutate-gingersnap-project-io-v1alpha1-config-defaults,mutating=true,failurePolicy=fail,sideEffects=None,groups=gingersnap-project.io,resources=caches;eagercacherules,verbs=create,versions=v1alpha1,name=mconfigdefaults.kb.io,admissionReviewVersions=v1
+
+// RegisterConfigDefaultingWebhook explicitly adds the mutating webhook that merges the defaults of the applicable
+// GingersnapConfigs into new Caches and EagerCacheRules. The defaults of the GingersnapConfig in the resource's
+// namespace take precedence over those of the cluster-wide GingersnapConfig
+func RegisterConfigDefaultingWebhook(mgr ctrl.Manager) {
+	hookServer := mgr.GetWebhookServer()
+	hookServer.Register("/mutate-gingersnap-project-io-v1alpha1-config-defaults", &webhook.Admission{
+		Handler: &configDefaulter{},
+	})
+}
+
+type configDefaulter struct {
+	client  runtimeClient.Client
+	decoder *admission.Decoder
+}
+
+var _ inject.Client = &configDefaulter{}
+var _ admission.Handler = &configDefaulter{}
+
+// InjectClient injects the client.
+func (cd *configDefaulter) InjectClient(c runtimeClient.Client) error {
+	cd.client = c
+	return nil
+}
+
+// InjectDecoder injects the decoder.
+func (cd *configDefaulter) InjectDecoder(d *admission.Decoder) error {
+	cd.decoder = d
+	return nil
+}
+
+func (cd *configDefaulter) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Create {
+		return admission.Allowed("")
+	}
+
+	configs, err := cd.configs(ctx, req.Namespace)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if len(configs) == 0 {
+		return admission.Allowed("")
+	}
+
+	var obj runtimeClient.Object
+	var applied AppliedDefaults
+	switch req.Kind.Kind {
+	case KindCache:
+		cache := &Cache{}
+		if err := cd.decoder.Decode(req, cache); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		obj, applied = cache, cache.MergeDefaults(configs)
+	case KindEagerCacheRule:
+		rule := &EagerCacheRule{}
+		if err := cd.decoder.Decode(req, rule); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		obj, applied = rule, rule.MergeDefaults(configs)
+	default:
+		return admission.Allowed("")
+	}
+
+	if len(applied) == 0 {
+		return admission.Allowed("")
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[AnnotationConfigDefaults] = applied.String()
+	obj.SetAnnotations(annotations)
+
+	marshalled, err := json.Marshal(obj)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshalled)
+}
+
+// configs returns the GingersnapConfigs applicable to resources in the namespace, ordered by precedence
+func (cd *configDefaulter) configs(ctx context.Context, namespace string) ([]GingersnapConfig, error) {
+	namespaces := []string{namespace}
+	if operatorNamespace := kubernetes.OperatorNamespace(); operatorNamespace != "" && operatorNamespace != namespace {
+		namespaces = append(namespaces, operatorNamespace)
+	}
+
+	var configs []GingersnapConfig
+	for _, ns := range namespaces {
+		config := &GingersnapConfig{}
+		if err := cd.client.Get(ctx, types.NamespacedName{Namespace: ns, Name: GingersnapConfigName}, config); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("unable to load GingersnapConfig '%s/%s': %w", ns, GingersnapConfigName, err)
+		}
+		configs = append(configs, *config)
+	}
+	return configs, nil
+}
@@ -0,0 +1,140 @@
+package v1alpha1
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("GingersnapConfig Webhooks", func() {
+
+	const timeout = time.Second * 30
+	const interval = time.Second * 1
+
+	key := types.NamespacedName{
+		Name:      GingersnapConfigName,
+		Namespace: "default",
+	}
+
+	cacheKey := types.NamespacedName{
+		Name:      "config-defaults-envtest",
+		Namespace: "default",
+	}
+
+	AfterEach(func() {
+		By("Expecting to delete successfully")
+		for _, obj := range []runtimeClient.Object{
+			&GingersnapConfig{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}},
+			&Cache{ObjectMeta: metav1.ObjectMeta{Name: cacheKey.Name, Namespace: cacheKey.Namespace}},
+		} {
+			Expect(runtimeClient.IgnoreNotFound(k8sClient.Delete(ctx, obj))).Should(Succeed())
+			Eventually(func() bool {
+				return apierrors.IsNotFound(k8sClient.Get(ctx, runtimeClient.ObjectKeyFromObject(obj), obj))
+			}, timeout, interval).Should(BeTrue())
+		}
+	})
+
+	It("should reject GingersnapConfigs not named default", func() {
+
+		invalid := &GingersnapConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "custom",
+				Namespace: key.Namespace,
+			},
+		}
+
+		ExpectInvalidErrStatus(k8sClient.Create(ctx, invalid),
+			statusDetailCause{metav1.CauseTypeFieldValueInvalid, "metadata.name", "must be named 'default'"},
+		)
+	})
+
+	It("should reject invalid defaults and operator configuration outside of the operator namespace", func() {
+
+		invalid := &GingersnapConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: GingersnapConfigSpec{
+				Cache: &CacheDefaults{
+					Resources: &Resources{
+						Requests: &ResourceQuantity{
+							Cpu:    "0.5",
+							Memory: "512mi",
+						},
+					},
+				},
+				EagerCacheRule: &EagerCacheRuleDefaults{
+					LagThreshold: "-1s",
+				},
+				Operator: &OperatorConfig{
+					FieldManager: "custom",
+				},
+			},
+		}
+
+		ExpectInvalidErrStatus(k8sClient.Create(ctx, invalid),
+			statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.cache.resources.requests.memory", "unable to parse quantity's suffix"},
+			statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.eagerCacheRule.lagThreshold", "must be a positive duration"},
+			statusDetailCause{"FieldValueForbidden", "spec.operator", "only supported in the GingersnapConfig of the operator namespace"},
+		)
+	})
+
+	It("should merge the namespace defaults into new Caches", func() {
+
+		config := &GingersnapConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: GingersnapConfigSpec{
+				Cache: &CacheDefaults{
+					Resources: &Resources{
+						Requests: &ResourceQuantity{
+							Cpu:    "0.5",
+							Memory: "256Mi",
+						},
+					},
+					Logging: &Logging{
+						Level: "DEBUG",
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, config)).Should(Succeed())
+
+		cache := &Cache{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      cacheKey.Name,
+				Namespace: cacheKey.Namespace,
+			},
+			Spec: CacheSpec{
+				Deployment: &CacheDeploymentSpec{
+					Logging: &Logging{
+						Level: "INFO",
+					},
+				},
+				DataSource: &DataSourceSpec{
+					DbType: DBType_POSTGRES_14.Enum(),
+					SecretRef: &LocalObjectReference{
+						Name: "some-secret",
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, cache)).Should(Succeed())
+
+		created := &Cache{}
+		Expect(k8sClient.Get(ctx, cacheKey, created)).Should(Succeed())
+		// Only unset fields are defaulted
+		Expect(created.Spec.Deployment.Logging.Level).Should(Equal("INFO"))
+		Expect(created.Spec.Deployment.Resources.Requests.Cpu).Should(Equal("0.5"))
+		Expect(created.Spec.Deployment.Resources.Requests.Memory).Should(Equal("256Mi"))
+		Expect(created.Annotations).Should(HaveKeyWithValue(AnnotationConfigDefaults, "spec.deployment.resources=default/default"))
+	})
+})
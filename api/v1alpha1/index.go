@@ -0,0 +1,42 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CacheRefField the field index of the Cache referenced by an EagerCacheRule or LazyCacheRule, in the format
+// <namespace>/<name>
+const CacheRefField = "spec.cacheRef"
+
+// SetupCacheRefIndexes registers the CacheRefField index of EagerCacheRule and LazyCacheRule with the indexer. Must be
+// called once before the manager is started
+func SetupCacheRefIndexes(ctx context.Context, indexer client.FieldIndexer) error {
+	for _, obj := range []client.Object{&EagerCacheRule{}, &LazyCacheRule{}} {
+		if err := indexer.IndexField(ctx, obj, CacheRefField, indexCacheRef); err != nil {
+			return fmt.Errorf("unable to index %s of %T: %w", CacheRefField, obj, err)
+		}
+	}
+	return nil
+}
+
+// MatchingRules returns the ListOption selecting the rules that reference the Cache
+func (s CacheService) MatchingRules() client.MatchingFields {
+	return client.MatchingFields{CacheRefField: s.String()}
+}
+
+func indexCacheRef(obj client.Object) []string {
+	var ref *NamespacedObjectReference
+	switch r := obj.(type) {
+	case *EagerCacheRule:
+		ref = r.Spec.CacheRef
+	case *LazyCacheRule:
+		ref = r.Spec.CacheRef
+	}
+	if ref == nil {
+		return nil
+	}
+	return []string{CacheService{Name: ref.Name, Namespace: ref.Namespace}.String()}
+}
@@ -0,0 +1,21 @@
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CacheRef index", func() {
+
+	It("should index rules by the namespaced name of the referenced Cache", func() {
+		ref := &NamespacedObjectReference{Name: "some-cache", Namespace: "cache-namespace"}
+		Expect(indexCacheRef(&EagerCacheRule{Spec: EagerCacheRuleSpec{CacheRef: ref}})).To(Equal([]string{"cache-namespace/some-cache"}))
+		Expect(indexCacheRef(&LazyCacheRule{Spec: LazyCacheRuleSpec{CacheRef: ref}})).To(Equal([]string{"cache-namespace/some-cache"}))
+		Expect(CacheService{Name: ref.Name, Namespace: ref.Namespace}.MatchingRules()).To(HaveKeyWithValue(CacheRefField, "cache-namespace/some-cache"))
+	})
+
+	It("should not index rules without a cacheRef", func() {
+		Expect(indexCacheRef(&EagerCacheRule{})).To(BeEmpty())
+		Expect(indexCacheRef(&LazyCacheRule{})).To(BeEmpty())
+	})
+})
@@ -65,3 +65,12 @@ func (r *LazyCacheRule) SetCondition(condition LazyCacheRuleCondition) (updated
 	})
 	return true
 }
+
+// RetryAttempts the number of consecutive reconciliations requeued with backoff
+func (r *LazyCacheRule) RetryAttempts() int32 {
+	return r.Status.RetryAttempts
+}
+
+func (r *LazyCacheRule) SetRetryAttempts(attempts int32) {
+	r.Status.RetryAttempts = attempts
+}
@@ -29,6 +29,10 @@ type LazyCacheRuleCondition struct {
 type LazyCacheRuleStatus struct {
 	// +optional
 	Conditions []LazyCacheRuleCondition `json:"conditions,omitempty"`
+	// RetryAttempts the number of consecutive reconciliations that have been requeued with backoff without the rule
+	// making progress. Reset to zero once reconciliation progresses
+	// +optional
+	RetryAttempts int32 `json:"retryAttempts,omitempty"`
 }
 
 // +genclient
@@ -9,7 +9,6 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -136,12 +135,7 @@ func (rv *lazyRuleValidator) create(ctx context.Context, r *LazyCacheRule) error
 		}
 
 		list := &LazyCacheRuleList{}
-		listOpts := &runtimeClient.ListOptions{
-			LabelSelector: labels.SelectorFromSet(
-				cache.LabelSelector(),
-			),
-		}
-		if err := rv.client.List(ctx, list, listOpts); err != nil {
+		if err := rv.client.List(ctx, list, cache.MatchingRules()); err != nil {
 			allErrs = append(allErrs, field.InternalError(field.NewPath("spec"), err))
 		} else if len(list.Items) > 0 {
 			rule := &list.Items[0]
@@ -149,6 +143,10 @@ func (rv *lazyRuleValidator) create(ctx context.Context, r *LazyCacheRule) error
 			allErrs = append(allErrs, field.Duplicate(field.NewPath("spec").Child("cacheRef"), msg))
 		}
 	}
+
+	if len(allErrs) == 0 {
+		ValidateCacheAccess(ctx, rv.client, &allErrs, r.Namespace, r.CacheService())
+	}
 	return StatusError(allErrs, r.Name, KindLazyCacheRule)
 }
 
@@ -164,4 +164,67 @@ var _ = Describe("LazyCacheRule Webhooks", func() {
 			statusDetailCause{metav1.CauseTypeFieldValueDuplicate, "spec.cacheRef", "LazyCacheRule CR already exists"},
 		)
 	})
+	It("Should only allow rules in namespaces granted access to the Cache", func() {
+
+		cache := &Cache{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "restricted-cache",
+				Namespace: "default",
+			},
+			Spec: CacheSpec{
+				DataSource: &DataSourceSpec{
+					DbType: DBType_POSTGRES_14.Enum(),
+					SecretRef: &LocalObjectReference{
+						Name: "some-secret",
+					},
+				},
+			},
+		}
+
+		ruleNamespace := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "rule-namespace",
+				Labels: map[string]string{"team": "app"},
+			},
+		}
+
+		rule := &LazyCacheRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: ruleNamespace.Name,
+			},
+			Spec: LazyCacheRuleSpec{
+				CacheRef: &NamespacedObjectReference{
+					Name:      cache.Name,
+					Namespace: cache.Namespace,
+				},
+				Query: "SELECT * FROM table",
+			},
+		}
+
+		defer func() {
+			_ = k8sClient.Delete(ctx, rule)
+			_ = k8sClient.Delete(ctx, cache)
+			_ = k8sClient.Delete(ctx, ruleNamespace)
+		}()
+
+		Expect(k8sClient.Create(ctx, ruleNamespace)).Should(Succeed())
+		Expect(k8sClient.Create(ctx, cache)).Should(Succeed())
+
+		ExpectInvalidErrStatus(
+			k8sClient.Create(ctx, rule.DeepCopy()),
+			statusDetailCause{"FieldValueForbidden", "spec.cacheRef", "rules in namespace 'rule-namespace' are not permitted to reference Cache 'default/restricted-cache'"},
+		)
+
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: cache.Name, Namespace: cache.Namespace}, cache)).Should(Succeed())
+		cache.Spec.AllowedRuleNamespaces = &NamespaceSelector{
+			MatchLabels: map[string]string{"team": "app"},
+		}
+		Expect(k8sClient.Update(ctx, cache)).Should(Succeed())
+
+		// The webhook client is cache backed, so wait for the updated Cache to be observed
+		Eventually(func() error {
+			return k8sClient.Create(ctx, rule.DeepCopy())
+		}, timeout, interval).Should(Succeed())
+	})
 })
@@ -21,3 +21,12 @@ func (x *KeyFormat) UnmarshalJSON(b []byte) error {
 	*x = KeyFormat(KeyFormat_value[string(b[1:len(b)-1])])
 	return nil
 }
+
+func (x SnapshotMode) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("\"%s\"", SnapshotMode_name[int32(x)])), nil
+}
+
+func (x *SnapshotMode) UnmarshalJSON(b []byte) error {
+	*x = SnapshotMode(SnapshotMode_value[string(b[1:len(b)-1])])
+	return nil
+}
@@ -2,11 +2,14 @@ package v1alpha1
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type CacheRule interface {
@@ -32,6 +35,29 @@ func EnsureRuleImmutability(allErrs *field.ErrorList, ruleKind string, a, b Cach
 	return nil
 }
 
+// ValidateCacheAccess ensures that rules in ruleNamespace are permitted to reference the Cache. If the Cache does not
+// exist yet, the check is deferred to the rule controller
+func ValidateCacheAccess(ctx context.Context, c runtimeClient.Reader, allErrs *field.ErrorList, ruleNamespace string, cacheRef CacheService) {
+	cacheRefField := field.NewPath("spec").Child("cacheRef")
+	cache := &Cache{}
+	if err := c.Get(ctx, runtimeClient.ObjectKey{Name: cacheRef.Name, Namespace: cacheRef.Namespace}, cache); err != nil {
+		if !apierrors.IsNotFound(err) {
+			*allErrs = append(*allErrs, field.InternalError(cacheRefField, err))
+		}
+		return
+	}
+
+	namespace := &corev1.Namespace{}
+	if err := c.Get(ctx, runtimeClient.ObjectKey{Name: ruleNamespace}, namespace); err != nil {
+		*allErrs = append(*allErrs, field.InternalError(cacheRefField, err))
+		return
+	}
+
+	if !cache.AllowsRuleNamespace(namespace) {
+		*allErrs = append(*allErrs, field.Forbidden(cacheRefField, cache.RuleNamespaceForbiddenMsg(ruleNamespace)))
+	}
+}
+
 func StatusError(allErrs field.ErrorList, name, kind string) error {
 	if len(allErrs) != 0 {
 		return apierrors.NewInvalid(
@@ -88,9 +88,14 @@ var _ = BeforeSuite(func() {
 	})
 	Expect(err).NotTo(HaveOccurred())
 
+	err = SetupCacheRefIndexes(ctx, mgr.GetFieldIndexer())
+	Expect(err).NotTo(HaveOccurred())
+
 	err = (&Cache{}).SetupWebhookWithManager(mgr)
 	Expect(err).NotTo(HaveOccurred())
 
+	RegisterCacheScaleValidatingWebhook(mgr)
+
 	err = (&LazyCacheRule{}).SetupWebhookWithManager(mgr)
 	Expect(err).NotTo(HaveOccurred())
 
@@ -101,6 +106,17 @@ var _ = BeforeSuite(func() {
 
 	RegisterEagerRuleValidatingWebhook(mgr)
 
+	err = (&GingersnapConfig{}).SetupWebhookWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
+
+	RegisterConfigDefaultingWebhook(mgr)
+
+	err = (&CacheBackup{}).SetupWebhookWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = (&CacheRestore{}).SetupWebhookWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
+
 	//+kubebuilder:scaffold:webhook
 
 	go func() {
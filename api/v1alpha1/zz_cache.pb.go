@@ -69,6 +69,55 @@ func (CacheDeploymentType) EnumDescriptor() ([]byte, []int) {
 	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{0}
 }
 
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:validation:Type=string
+// The type of cache workload update
+type UpdateStrategyType int32
+
+const (
+	UpdateStrategyType_ROLLING_UPDATE UpdateStrategyType = 0
+	UpdateStrategyType_ON_DELETE      UpdateStrategyType = 1
+)
+
+// Enum value maps for UpdateStrategyType.
+var (
+	UpdateStrategyType_name = map[int32]string{
+		0: "ROLLING_UPDATE",
+		1: "ON_DELETE",
+	}
+	UpdateStrategyType_value = map[string]int32{
+		"ROLLING_UPDATE": 0,
+		"ON_DELETE":      1,
+	}
+)
+
+func (x UpdateStrategyType) Enum() *UpdateStrategyType {
+	p := new(UpdateStrategyType)
+	*p = x
+	return p
+}
+
+func (x UpdateStrategyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpdateStrategyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_cache_v1alpha1_cache_proto_enumTypes[1].Descriptor()
+}
+
+func (UpdateStrategyType) Type() protoreflect.EnumType {
+	return &file_config_cache_v1alpha1_cache_proto_enumTypes[1]
+}
+
+func (x UpdateStrategyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpdateStrategyType.Descriptor instead.
+func (UpdateStrategyType) EnumDescriptor() ([]byte, []int) {
+	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{1}
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:validation:Type=string
 // Type of the database in format DBTYPE_VERSION
@@ -105,11 +154,11 @@ func (x DBType) String() string {
 }
 
 func (DBType) Descriptor() protoreflect.EnumDescriptor {
-	return file_config_cache_v1alpha1_cache_proto_enumTypes[1].Descriptor()
+	return file_config_cache_v1alpha1_cache_proto_enumTypes[2].Descriptor()
 }
 
 func (DBType) Type() protoreflect.EnumType {
-	return &file_config_cache_v1alpha1_cache_proto_enumTypes[1]
+	return &file_config_cache_v1alpha1_cache_proto_enumTypes[2]
 }
 
 func (x DBType) Number() protoreflect.EnumNumber {
@@ -118,7 +167,56 @@ func (x DBType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DBType.Descriptor instead.
 func (DBType) EnumDescriptor() ([]byte, []int) {
-	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{1}
+	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{2}
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:validation:Type=string
+// The Vault secrets engine serving data source credentials
+type VaultSecretEngine int32
+
+const (
+	VaultSecretEngine_KV       VaultSecretEngine = 0
+	VaultSecretEngine_DATABASE VaultSecretEngine = 1
+)
+
+// Enum value maps for VaultSecretEngine.
+var (
+	VaultSecretEngine_name = map[int32]string{
+		0: "KV",
+		1: "DATABASE",
+	}
+	VaultSecretEngine_value = map[string]int32{
+		"KV":       0,
+		"DATABASE": 1,
+	}
+)
+
+func (x VaultSecretEngine) Enum() *VaultSecretEngine {
+	p := new(VaultSecretEngine)
+	*p = x
+	return p
+}
+
+func (x VaultSecretEngine) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VaultSecretEngine) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_cache_v1alpha1_cache_proto_enumTypes[3].Descriptor()
+}
+
+func (VaultSecretEngine) Type() protoreflect.EnumType {
+	return &file_config_cache_v1alpha1_cache_proto_enumTypes[3]
+}
+
+func (x VaultSecretEngine) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VaultSecretEngine.Descriptor instead.
+func (VaultSecretEngine) EnumDescriptor() ([]byte, []int) {
+	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{3}
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -134,6 +232,8 @@ type CacheSpec struct {
 	DbSyncer *DBSyncerDeploymentSpec `protobuf:"bytes,2,opt,name=db_syncer,json=dbSyncer,proto3" json:"dbSyncer,omitempty"`
 	// DatasourceRef or a ServiceBindingRef (TODO clarify)
 	DataSource *DataSourceSpec `protobuf:"bytes,3,opt,name=data_source,json=dataSource,proto3" json:"dataSource,omitempty"`
+	// Namespaces, in addition to the Cache namespace, whose rules are permitted to reference this Cache
+	AllowedRuleNamespaces *NamespaceSelector `protobuf:"bytes,4,opt,name=allowed_rule_namespaces,json=allowedRuleNamespaces,proto3" json:"allowedRuleNamespaces,omitempty"`
 }
 
 func (x *CacheSpec) Reset() {
@@ -189,6 +289,13 @@ func (x *CacheSpec) GetDataSource() *DataSourceSpec {
 	return nil
 }
 
+func (x *CacheSpec) GetAllowedRuleNamespaces() *NamespaceSelector {
+	if x != nil {
+		return x.AllowedRuleNamespaces
+	}
+	return nil
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // Describes the spec of the cache provider
 type CacheDeploymentSpec struct {
@@ -203,6 +310,21 @@ type CacheDeploymentSpec struct {
 	Resources *Resources `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
 	// Max number of replicas for type CLUSTER
 	Replicas int32 `protobuf:"varint,3,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	// Controls how cache pods are replaced when the cache workload is updated
+	UpdateStrategy *UpdateStrategy `protobuf:"bytes,4,opt,name=update_strategy,json=updateStrategy,proto3" json:"updateStrategy,omitempty"`
+	// Logging configuration of cache pods
+	Logging *Logging `protobuf:"bytes,5,opt,name=logging,proto3" json:"logging,omitempty"`
+	// Additional environment variables of cache pods, e.g. Quarkus or JVM settings. Variables prefixed with
+	// GINGERSNAP_K8S_ are reserved by the operator
+	ExtraEnv []*EnvVar `protobuf:"bytes,6,rep,name=extra_env,json=extraEnv,proto3" json:"extraEnv,omitempty"`
+	// ConfigMaps and Secrets whose entries are exposed as environment variables of cache pods. Entries are overridden
+	// by variables set by the operator or in extraEnv
+	EnvFrom []*EnvFromSource `protobuf:"bytes,7,rep,name=env_from,json=envFrom,proto3" json:"envFrom,omitempty"`
+	// Storage of the file-based stores of cache-manager. The pods of a CLUSTER cache are deployed by a StatefulSet with a
+	// PersistentVolumeClaim per pod, whereas the pods of a LOCAL cache are attached a generic ephemeral volume that is
+	// removed with the pod. Experimental: rejected unless the operator is started with --enable-cache-persistence, and
+	// cache-manager does not store its caches in the volumes yet
+	Persistence *CachePersistenceSpec `protobuf:"bytes,8,opt,name=persistence,proto3" json:"persistence,omitempty"`
 }
 
 func (x *CacheDeploymentSpec) Reset() {
@@ -258,6 +380,181 @@ func (x *CacheDeploymentSpec) GetReplicas() int32 {
 	return 0
 }
 
+func (x *CacheDeploymentSpec) GetUpdateStrategy() *UpdateStrategy {
+	if x != nil {
+		return x.UpdateStrategy
+	}
+	return nil
+}
+
+func (x *CacheDeploymentSpec) GetLogging() *Logging {
+	if x != nil {
+		return x.Logging
+	}
+	return nil
+}
+
+func (x *CacheDeploymentSpec) GetExtraEnv() []*EnvVar {
+	if x != nil {
+		return x.ExtraEnv
+	}
+	return nil
+}
+
+func (x *CacheDeploymentSpec) GetEnvFrom() []*EnvFromSource {
+	if x != nil {
+		return x.EnvFrom
+	}
+	return nil
+}
+
+func (x *CacheDeploymentSpec) GetPersistence() *CachePersistenceSpec {
+	if x != nil {
+		return x.Persistence
+	}
+	return nil
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// Describes the volumes of the file-based stores of cache-manager. The PersistentVolumeClaims of a CLUSTER cache are
+// removed when the Cache is deleted or persistence is disabled
+type CachePersistenceSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Size of the volume of each cache pod, e.g. 1Gi. Cannot be changed for type CLUSTER
+	Size string `protobuf:"bytes,1,opt,name=size,proto3" json:"size,omitempty"`
+	// StorageClass of the volumes. The default StorageClass of the cluster is used if not set. Cannot be changed for
+	// type CLUSTER
+	StorageClassName string `protobuf:"bytes,2,opt,name=storage_class_name,json=storageClassName,proto3" json:"storageClassName,omitempty"`
+}
+
+func (x *CachePersistenceSpec) Reset() {
+	*x = CachePersistenceSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CachePersistenceSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CachePersistenceSpec) ProtoMessage() {}
+
+func (x *CachePersistenceSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CachePersistenceSpec.ProtoReflect.Descriptor instead.
+func (*CachePersistenceSpec) Descriptor() ([]byte, []int) {
+	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CachePersistenceSpec) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *CachePersistenceSpec) GetStorageClassName() string {
+	if x != nil {
+		return x.StorageClassName
+	}
+	return ""
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// Describes how cache pods are replaced when the cache workload is updated
+type UpdateStrategy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// +kubebuilder:validation:Enum=ROLLING_UPDATE;ON_DELETE
+	// The type of update. ON_DELETE is only supported for type LOCAL. Defaults to ROLLING_UPDATE
+	Type UpdateStrategyType `protobuf:"varint,1,opt,name=type,proto3,enum=gingersnap.config.cache.v1alpha1.UpdateStrategyType" json:"type,omitempty"`
+	// Maximum number, or percentage, of cache pods that can be unavailable during a ROLLING_UPDATE, e.g. 1 or 25%
+	MaxUnavailable string `protobuf:"bytes,2,opt,name=max_unavailable,json=maxUnavailable,proto3" json:"maxUnavailable,omitempty"`
+	// Maximum number, or percentage, of cache pods that can be created above the desired number during a ROLLING_UPDATE
+	MaxSurge string `protobuf:"bytes,3,opt,name=max_surge,json=maxSurge,proto3" json:"maxSurge,omitempty"`
+	// Number of cache pods that retain the previous revision during a ROLLING_UPDATE. Only supported for type LOCAL,
+	// where the operator replaces outdated pods, honouring maxUnavailable, until this number of pods remain
+	Partition int32 `protobuf:"varint,4,opt,name=partition,proto3" json:"partition,omitempty"`
+}
+
+func (x *UpdateStrategy) Reset() {
+	*x = UpdateStrategy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateStrategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStrategy) ProtoMessage() {}
+
+func (x *UpdateStrategy) ProtoReflect() protoreflect.Message {
+	mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStrategy.ProtoReflect.Descriptor instead.
+func (*UpdateStrategy) Descriptor() ([]byte, []int) {
+	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateStrategy) GetType() UpdateStrategyType {
+	if x != nil {
+		return x.Type
+	}
+	return UpdateStrategyType_ROLLING_UPDATE
+}
+
+func (x *UpdateStrategy) GetMaxUnavailable() string {
+	if x != nil {
+		return x.MaxUnavailable
+	}
+	return ""
+}
+
+func (x *UpdateStrategy) GetMaxSurge() string {
+	if x != nil {
+		return x.MaxSurge
+	}
+	return ""
+}
+
+func (x *UpdateStrategy) GetPartition() int32 {
+	if x != nil {
+		return x.Partition
+	}
+	return 0
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // Describes the spec of the db-syncer deployment
 type DBSyncerDeploymentSpec struct {
@@ -267,12 +564,26 @@ type DBSyncerDeploymentSpec struct {
 
 	// Resource profile for db-syncer pods
 	Resources *Resources `protobuf:"bytes,1,opt,name=resources,proto3" json:"resources,omitempty"`
+	// Number of db-syncer replicas. A single replica, elected via a Lease, replicates changes whilst the others remain on
+	// standby ready to take over. Defaults to 1
+	Replicas int32 `protobuf:"varint,2,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	// Logging configuration of db-syncer pods
+	Logging *Logging `protobuf:"bytes,3,opt,name=logging,proto3" json:"logging,omitempty"`
+	// Additional environment variables of db-syncer pods, e.g. Quarkus or JVM settings. Variables prefixed with
+	// GINGERSNAP_K8S_ are reserved by the operator
+	ExtraEnv []*EnvVar `protobuf:"bytes,4,rep,name=extra_env,json=extraEnv,proto3" json:"extraEnv,omitempty"`
+	// ConfigMaps and Secrets whose entries are exposed as environment variables of db-syncer pods. Entries are
+	// overridden by variables set by the operator or in extraEnv
+	EnvFrom []*EnvFromSource `protobuf:"bytes,5,rep,name=env_from,json=envFrom,proto3" json:"envFrom,omitempty"`
+	// Scales the db-syncer according to the replication lag of the Cache's EagerCacheRules. When set, replicas is
+	// ignored and the tables replicated by db-syncer are partitioned across all replicas
+	Autoscaling *DBSyncerAutoscalingSpec `protobuf:"bytes,6,opt,name=autoscaling,proto3" json:"autoscaling,omitempty"`
 }
 
 func (x *DBSyncerDeploymentSpec) Reset() {
 	*x = DBSyncerDeploymentSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[2]
+		mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -285,7 +596,7 @@ func (x *DBSyncerDeploymentSpec) String() string {
 func (*DBSyncerDeploymentSpec) ProtoMessage() {}
 
 func (x *DBSyncerDeploymentSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[2]
+	mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -298,7 +609,7 @@ func (x *DBSyncerDeploymentSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DBSyncerDeploymentSpec.ProtoReflect.Descriptor instead.
 func (*DBSyncerDeploymentSpec) Descriptor() ([]byte, []int) {
-	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{2}
+	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *DBSyncerDeploymentSpec) GetResources() *Resources {
@@ -308,6 +619,391 @@ func (x *DBSyncerDeploymentSpec) GetResources() *Resources {
 	return nil
 }
 
+func (x *DBSyncerDeploymentSpec) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *DBSyncerDeploymentSpec) GetLogging() *Logging {
+	if x != nil {
+		return x.Logging
+	}
+	return nil
+}
+
+func (x *DBSyncerDeploymentSpec) GetExtraEnv() []*EnvVar {
+	if x != nil {
+		return x.ExtraEnv
+	}
+	return nil
+}
+
+func (x *DBSyncerDeploymentSpec) GetEnvFrom() []*EnvFromSource {
+	if x != nil {
+		return x.EnvFrom
+	}
+	return nil
+}
+
+func (x *DBSyncerDeploymentSpec) GetAutoscaling() *DBSyncerAutoscalingSpec {
+	if x != nil {
+		return x.Autoscaling
+	}
+	return nil
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// Describes how the db-syncer is scaled. A KEDA ScaledObject is created if KEDA is installed on the cluster, otherwise
+// a HorizontalPodAutoscaler on the custom metric reported by db-syncer, which requires a custom metrics adapter
+type DBSyncerAutoscalingSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Minimum number of db-syncer replicas. Defaults to 1
+	MinReplicas int32 `protobuf:"varint,1,opt,name=min_replicas,json=minReplicas,proto3" json:"minReplicas,omitempty"`
+	// Maximum number of db-syncer replicas
+	MaxReplicas int32 `protobuf:"varint,2,opt,name=max_replicas,json=maxReplicas,proto3" json:"maxReplicas,omitempty"`
+	// Average replication lag per replica, e.g. 30s, above which db-syncer is scaled out. Defaults to 30s
+	TargetLag string `protobuf:"bytes,3,opt,name=target_lag,json=targetLag,proto3" json:"targetLag,omitempty"`
+}
+
+func (x *DBSyncerAutoscalingSpec) Reset() {
+	*x = DBSyncerAutoscalingSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DBSyncerAutoscalingSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBSyncerAutoscalingSpec) ProtoMessage() {}
+
+func (x *DBSyncerAutoscalingSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBSyncerAutoscalingSpec.ProtoReflect.Descriptor instead.
+func (*DBSyncerAutoscalingSpec) Descriptor() ([]byte, []int) {
+	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DBSyncerAutoscalingSpec) GetMinReplicas() int32 {
+	if x != nil {
+		return x.MinReplicas
+	}
+	return 0
+}
+
+func (x *DBSyncerAutoscalingSpec) GetMaxReplicas() int32 {
+	if x != nil {
+		return x.MaxReplicas
+	}
+	return 0
+}
+
+func (x *DBSyncerAutoscalingSpec) GetTargetLag() string {
+	if x != nil {
+		return x.TargetLag
+	}
+	return ""
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// Describes the logging configuration of a workload
+type Logging struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Level of the root logger, one of OFF, FATAL, ERROR, WARN, INFO, DEBUG, TRACE or ALL. Defaults to INFO
+	Level string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	// Levels of individual log categories, e.g. io.gingersnapproject: DEBUG
+	Categories map[string]string `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Output console logs as JSON
+	Json bool `protobuf:"varint,3,opt,name=json,proto3" json:"json,omitempty"`
+}
+
+func (x *Logging) Reset() {
+	*x = Logging{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Logging) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Logging) ProtoMessage() {}
+
+func (x *Logging) ProtoReflect() protoreflect.Message {
+	mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Logging.ProtoReflect.Descriptor instead.
+func (*Logging) Descriptor() ([]byte, []int) {
+	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Logging) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *Logging) GetCategories() map[string]string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *Logging) GetJson() bool {
+	if x != nil {
+		return x.Json
+	}
+	return false
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// Describes an environment variable whose value is either a literal or the entry of a ConfigMap or Secret
+type EnvVar struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the environment variable
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Literal value of the environment variable
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Selects an entry of a ConfigMap in the Cache namespace
+	ConfigMapKeyRef *KeySelector `protobuf:"bytes,3,opt,name=config_map_key_ref,json=configMapKeyRef,proto3" json:"configMapKeyRef,omitempty"`
+	// Selects an entry of a Secret in the Cache namespace
+	SecretKeyRef *KeySelector `protobuf:"bytes,4,opt,name=secret_key_ref,json=secretKeyRef,proto3" json:"secretKeyRef,omitempty"`
+}
+
+func (x *EnvVar) Reset() {
+	*x = EnvVar{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnvVar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvVar) ProtoMessage() {}
+
+func (x *EnvVar) ProtoReflect() protoreflect.Message {
+	mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvVar.ProtoReflect.Descriptor instead.
+func (*EnvVar) Descriptor() ([]byte, []int) {
+	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EnvVar) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EnvVar) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *EnvVar) GetConfigMapKeyRef() *KeySelector {
+	if x != nil {
+		return x.ConfigMapKeyRef
+	}
+	return nil
+}
+
+func (x *EnvVar) GetSecretKeyRef() *KeySelector {
+	if x != nil {
+		return x.SecretKeyRef
+	}
+	return nil
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// Selects an entry of a ConfigMap or Secret
+type KeySelector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the ConfigMap or Secret
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Key of the entry
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Whether the ConfigMap or Secret, and the entry, may be absent
+	Optional bool `protobuf:"varint,3,opt,name=optional,proto3" json:"optional,omitempty"`
+}
+
+func (x *KeySelector) Reset() {
+	*x = KeySelector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeySelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeySelector) ProtoMessage() {}
+
+func (x *KeySelector) ProtoReflect() protoreflect.Message {
+	mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeySelector.ProtoReflect.Descriptor instead.
+func (*KeySelector) Descriptor() ([]byte, []int) {
+	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *KeySelector) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KeySelector) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KeySelector) GetOptional() bool {
+	if x != nil {
+		return x.Optional
+	}
+	return false
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// Exposes all entries of a ConfigMap or Secret as environment variables
+type EnvFromSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Prefix added to the name of each environment variable
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// ConfigMap in the Cache namespace
+	ConfigMapRef *LocalObjectReference `protobuf:"bytes,2,opt,name=config_map_ref,json=configMapRef,proto3" json:"configMapRef,omitempty"`
+	// Secret in the Cache namespace
+	SecretRef *LocalObjectReference `protobuf:"bytes,3,opt,name=secret_ref,json=secretRef,proto3" json:"secretRef,omitempty"`
+}
+
+func (x *EnvFromSource) Reset() {
+	*x = EnvFromSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnvFromSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvFromSource) ProtoMessage() {}
+
+func (x *EnvFromSource) ProtoReflect() protoreflect.Message {
+	mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvFromSource.ProtoReflect.Descriptor instead.
+func (*EnvFromSource) Descriptor() ([]byte, []int) {
+	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EnvFromSource) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *EnvFromSource) GetConfigMapRef() *LocalObjectReference {
+	if x != nil {
+		return x.ConfigMapRef
+	}
+	return nil
+}
+
+func (x *EnvFromSource) GetSecretRef() *LocalObjectReference {
+	if x != nil {
+		return x.SecretRef
+	}
+	return nil
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // Describes a resources profile required for a workload
 type Resources struct {
@@ -322,7 +1018,7 @@ type Resources struct {
 func (x *Resources) Reset() {
 	*x = Resources{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[3]
+		mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -335,7 +1031,7 @@ func (x *Resources) String() string {
 func (*Resources) ProtoMessage() {}
 
 func (x *Resources) ProtoReflect() protoreflect.Message {
-	mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[3]
+	mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -348,7 +1044,7 @@ func (x *Resources) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Resources.ProtoReflect.Descriptor instead.
 func (*Resources) Descriptor() ([]byte, []int) {
-	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{3}
+	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Resources) GetRequests() *ResourceQuantity {
@@ -385,7 +1081,7 @@ type ResourceQuantity struct {
 func (x *ResourceQuantity) Reset() {
 	*x = ResourceQuantity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[4]
+		mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -398,7 +1094,7 @@ func (x *ResourceQuantity) String() string {
 func (*ResourceQuantity) ProtoMessage() {}
 
 func (x *ResourceQuantity) ProtoReflect() protoreflect.Message {
-	mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[4]
+	mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,7 +1107,7 @@ func (x *ResourceQuantity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResourceQuantity.ProtoReflect.Descriptor instead.
 func (*ResourceQuantity) Descriptor() ([]byte, []int) {
-	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{4}
+	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ResourceQuantity) GetMemory() string {
@@ -445,12 +1141,14 @@ type DataSourceSpec struct {
 	SecretRef *LocalObjectReference `protobuf:"bytes,3,opt,name=secret_ref,json=secretRef,proto3" json:"secretRef,omitempty"`
 	// Reference to ServiceBinding provider
 	ServiceProviderRef *ServiceRef `protobuf:"bytes,4,opt,name=service_provider_ref,json=serviceProviderRef,proto3" json:"serviceProviderRef,omitempty"`
+	// Credentials retrieved from a HashiCorp Vault server
+	Vault *VaultSource `protobuf:"bytes,5,opt,name=vault,proto3" json:"vault,omitempty"`
 }
 
 func (x *DataSourceSpec) Reset() {
 	*x = DataSourceSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[5]
+		mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -463,7 +1161,7 @@ func (x *DataSourceSpec) String() string {
 func (*DataSourceSpec) ProtoMessage() {}
 
 func (x *DataSourceSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[5]
+	mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -476,7 +1174,7 @@ func (x *DataSourceSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataSourceSpec.ProtoReflect.Descriptor instead.
 func (*DataSourceSpec) Descriptor() ([]byte, []int) {
-	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{5}
+	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DataSourceSpec) GetDbType() DBType {
@@ -507,6 +1205,13 @@ func (x *DataSourceSpec) GetServiceProviderRef() *ServiceRef {
 	return nil
 }
 
+func (x *DataSourceSpec) GetVault() *VaultSource {
+	if x != nil {
+		return x.Vault
+	}
+	return nil
+}
+
 // LocalObjectRef contains enough information to let you locate the referenced object inside the same namespace.
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 type LocalObjectReference struct {
@@ -521,7 +1226,7 @@ type LocalObjectReference struct {
 func (x *LocalObjectReference) Reset() {
 	*x = LocalObjectReference{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[6]
+		mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -534,7 +1239,7 @@ func (x *LocalObjectReference) String() string {
 func (*LocalObjectReference) ProtoMessage() {}
 
 func (x *LocalObjectReference) ProtoReflect() protoreflect.Message {
-	mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[6]
+	mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -547,7 +1252,7 @@ func (x *LocalObjectReference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LocalObjectReference.ProtoReflect.Descriptor instead.
 func (*LocalObjectReference) Descriptor() ([]byte, []int) {
-	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{6}
+	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *LocalObjectReference) GetName() string {
@@ -575,7 +1280,7 @@ type ServiceRef struct {
 func (x *ServiceRef) Reset() {
 	*x = ServiceRef{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[7]
+		mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -588,7 +1293,7 @@ func (x *ServiceRef) String() string {
 func (*ServiceRef) ProtoMessage() {}
 
 func (x *ServiceRef) ProtoReflect() protoreflect.Message {
-	mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[7]
+	mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -601,7 +1306,7 @@ func (x *ServiceRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServiceRef.ProtoReflect.Descriptor instead.
 func (*ServiceRef) Descriptor() ([]byte, []int) {
-	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{7}
+	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ServiceRef) GetApiVersion() string {
@@ -625,6 +1330,184 @@ func (x *ServiceRef) GetName() string {
 	return ""
 }
 
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// Selects namespaces by name or by label. A namespace is selected if it matches any of the criteria
+type NamespaceSelector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Names of the selected namespaces. The value "*" selects all namespaces
+	MatchNames []string `protobuf:"bytes,1,rep,name=match_names,json=matchNames,proto3" json:"matchNames,omitempty"`
+	// Labels that a namespace must have in order to be selected
+	MatchLabels map[string]string `protobuf:"bytes,2,rep,name=match_labels,json=matchLabels,proto3" json:"matchLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *NamespaceSelector) Reset() {
+	*x = NamespaceSelector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamespaceSelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceSelector) ProtoMessage() {}
+
+func (x *NamespaceSelector) ProtoReflect() protoreflect.Message {
+	mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceSelector.ProtoReflect.Descriptor instead.
+func (*NamespaceSelector) Descriptor() ([]byte, []int) {
+	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *NamespaceSelector) GetMatchNames() []string {
+	if x != nil {
+		return x.MatchNames
+	}
+	return nil
+}
+
+func (x *NamespaceSelector) GetMatchLabels() map[string]string {
+	if x != nil {
+		return x.MatchLabels
+	}
+	return nil
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// Describes how data source credentials are retrieved from HashiCorp Vault. The retrieved credentials are
+// materialised in a binding Secret, owned by the Cache, which is refreshed before the credentials expire
+type VaultSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Address of the Vault server, e.g. https://vault.vault.svc:8200
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// +kubebuilder:validation:Enum=KV;DATABASE
+	// The secrets engine serving the credentials
+	Engine VaultSecretEngine `protobuf:"varint,2,opt,name=engine,proto3,enum=gingersnap.config.cache.v1alpha1.VaultSecretEngine" json:"engine,omitempty"`
+	// Path of the secret to read, e.g. secret/data/db for the KV engine or database/creds/my-role for the database engine.
+	// The lease of database credentials is renewed until it reaches its maximum TTL, after which new credentials are
+	// generated and the replaced lease is revoked, so the Vault policy must also grant update on sys/leases/renew and
+	// sys/leases/revoke. The lease of the credentials of a deleted Cache expires at the end of its TTL
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// Vault role used to authenticate with the Kubernetes auth method using a short-lived token of the Cache's
+	// ServiceAccount, which has the same name as the Cache
+	Role string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	// Mount path of the Kubernetes auth method. Defaults to "kubernetes"
+	AuthPath string `protobuf:"bytes,5,opt,name=auth_path,json=authPath,proto3" json:"authPath,omitempty"`
+	// Reference to a Secret containing a Vault token under the "token" key. Used instead of the Kubernetes auth method
+	TokenSecretRef *LocalObjectReference `protobuf:"bytes,6,opt,name=token_secret_ref,json=tokenSecretRef,proto3" json:"tokenSecretRef,omitempty"`
+	// Non-sensitive entries, such as host, port and database, added to the binding Secret alongside the credentials
+	BindingData map[string]string `protobuf:"bytes,7,rep,name=binding_data,json=bindingData,proto3" json:"bindingData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// How often credentials that do not expire, such as KV secrets, are re-read. Defaults to 5m
+	RefreshInterval string `protobuf:"bytes,8,opt,name=refresh_interval,json=refreshInterval,proto3" json:"refreshInterval,omitempty"`
+}
+
+func (x *VaultSource) Reset() {
+	*x = VaultSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultSource) ProtoMessage() {}
+
+func (x *VaultSource) ProtoReflect() protoreflect.Message {
+	mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultSource.ProtoReflect.Descriptor instead.
+func (*VaultSource) Descriptor() ([]byte, []int) {
+	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *VaultSource) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *VaultSource) GetEngine() VaultSecretEngine {
+	if x != nil {
+		return x.Engine
+	}
+	return VaultSecretEngine_KV
+}
+
+func (x *VaultSource) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *VaultSource) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *VaultSource) GetAuthPath() string {
+	if x != nil {
+		return x.AuthPath
+	}
+	return ""
+}
+
+func (x *VaultSource) GetTokenSecretRef() *LocalObjectReference {
+	if x != nil {
+		return x.TokenSecretRef
+	}
+	return nil
+}
+
+func (x *VaultSource) GetBindingData() map[string]string {
+	if x != nil {
+		return x.BindingData
+	}
+	return nil
+}
+
+func (x *VaultSource) GetRefreshInterval() string {
+	if x != nil {
+		return x.RefreshInterval
+	}
+	return ""
+}
+
 // Document representation of a cache and all the related rules
 type CacheConf struct {
 	state         protoimpl.MessageState
@@ -643,7 +1526,7 @@ type CacheConf struct {
 func (x *CacheConf) Reset() {
 	*x = CacheConf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[8]
+		mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -656,7 +1539,7 @@ func (x *CacheConf) String() string {
 func (*CacheConf) ProtoMessage() {}
 
 func (x *CacheConf) ProtoReflect() protoreflect.Message {
-	mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[8]
+	mi := &file_config_cache_v1alpha1_cache_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -669,7 +1552,7 @@ func (x *CacheConf) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CacheConf.ProtoReflect.Descriptor instead.
 func (*CacheConf) Descriptor() ([]byte, []int) {
-	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{8}
+	return file_config_cache_v1alpha1_cache_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CacheConf) GetCacheSpec() *CacheSpec {
@@ -702,7 +1585,7 @@ var file_config_cache_v1alpha1_cache_proto_rawDesc = []byte{
 	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61,
 	0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x21, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x63, 0x61,
 	0x63, 0x68, 0x65, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x72, 0x75, 0x6c,
-	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8c, 0x02, 0x0a, 0x09, 0x43, 0x61, 0x63,
+	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf9, 0x02, 0x0a, 0x09, 0x43, 0x61, 0x63,
 	0x68, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x55, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79,
 	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x67, 0x69, 0x6e,
 	0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63,
@@ -719,126 +1602,306 @@ var file_config_cache_v1alpha1_cache_proto_rawDesc = []byte{
 	0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61,
 	0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74,
 	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0a, 0x64, 0x61, 0x74,
-	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xc7, 0x01, 0x0a, 0x13, 0x43, 0x61, 0x63, 0x68,
-	0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12,
-	0x49, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e,
-	0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x49, 0x0a, 0x09, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
-	0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x73, 0x22, 0x63, 0x0a, 0x16, 0x44, 0x42, 0x53, 0x79, 0x6e, 0x63, 0x65, 0x72, 0x44, 0x65, 0x70,
-	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x49, 0x0a, 0x09, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
-	0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x09, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x09, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73,
+	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x6b, 0x0a, 0x17, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65,
+	0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x15, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x73, 0x22, 0xd4, 0x04, 0x0a, 0x13, 0x43, 0x61, 0x63, 0x68, 0x65, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x49, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x67, 0x69, 0x6e,
+	0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x49, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x67, 0x69, 0x6e,
+	0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x59,
+	0x0a, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72,
+	0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x43, 0x0a, 0x07, 0x6c, 0x6f, 0x67,
+	0x67, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x69, 0x6e,
+	0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f,
+	0x67, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x45,
+	0x0a, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x65, 0x6e, 0x76, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x76, 0x56, 0x61, 0x72, 0x52, 0x08, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x45, 0x6e, 0x76, 0x12, 0x4a, 0x0a, 0x08, 0x65, 0x6e, 0x76, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72,
+	0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x76, 0x46, 0x72,
+	0x6f, 0x6d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x46, 0x72, 0x6f,
+	0x6d, 0x12, 0x58, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73,
 	0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x73, 0x12, 0x4a, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x50,
+	0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0b,
+	0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x58, 0x0a, 0x14, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x53,
+	0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x48, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73,
+	0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x61, 0x78,
+	0x55, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x75, 0x72, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x53, 0x75, 0x72, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb4, 0x03, 0x0a, 0x16, 0x44, 0x42, 0x53, 0x79, 0x6e,
+	0x63, 0x65, 0x72, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65,
+	0x63, 0x12, 0x49, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61,
 	0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76,
 	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73,
-	0x22, 0x3c, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51, 0x75, 0x61, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x63, 0x70, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x70, 0x75, 0x22, 0xe5,
-	0x03, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65,
-	0x63, 0x12, 0x46, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x28, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x42, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x06,
-	0x64, 0x62, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x7f, 0x0a, 0x15, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4a, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65,
-	0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63,
-	0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x55, 0x0a, 0x0a, 0x73, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36,
+	0x73, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x43, 0x0a, 0x07, 0x6c, 0x6f, 0x67, 0x67,
+	0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x67,
+	0x67, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x45, 0x0a,
+	0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x65, 0x6e, 0x76, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x76, 0x56, 0x61, 0x72, 0x52, 0x08, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x45, 0x6e, 0x76, 0x12, 0x4a, 0x0a, 0x08, 0x65, 0x6e, 0x76, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73,
+	0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x76, 0x46, 0x72, 0x6f,
+	0x6d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x46, 0x72, 0x6f, 0x6d,
+	0x12, 0x5b, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e,
+	0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x42, 0x53, 0x79, 0x6e, 0x63, 0x65,
+	0x72, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x63,
+	0x52, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x22, 0x7e, 0x0a,
+	0x17, 0x44, 0x42, 0x53, 0x79, 0x6e, 0x63, 0x65, 0x72, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61,
+	0x6c, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x63, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d,
+	0x61, 0x78, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x61, 0x67, 0x22, 0xcd, 0x01,
+	0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x59, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x2e, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6a, 0x73,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x1a, 0x3d,
+	0x0a, 0x0f, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe3, 0x01,
+	0x0a, 0x06, 0x45, 0x6e, 0x76, 0x56, 0x61, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x5a, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6d, 0x61, 0x70,
+	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d,
 	0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
 	0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65,
-	0x66, 0x12, 0x5e, 0x0a, 0x14, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2c, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e,
+	0x31, 0x2e, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x66, 0x12, 0x53,
+	0x0a, 0x0e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x65, 0x66,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73,
+	0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0c, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x66, 0x22, 0x4f, 0x0a, 0x0b, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x22, 0xdc, 0x01, 0x0a, 0x0d, 0x45, 0x6e, 0x76, 0x46, 0x72, 0x6f, 0x6d,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x5c,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x72, 0x65, 0x66,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73,
+	0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0c,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x66, 0x12, 0x55, 0x0a, 0x0a,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x36, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x52, 0x65, 0x66, 0x22, 0xa7, 0x01, 0x0a, 0x09, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x12, 0x4e, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x12, 0x4a, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x32, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x3c, 0x0a,
+	0x10, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x70, 0x75,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x70, 0x75, 0x22, 0xaa, 0x04, 0x0a, 0x0e,
+	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x46,
+	0x0a, 0x07, 0x64, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x28, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e,
 	0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x66, 0x52, 0x12, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x66, 0x1a, 0x47, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50,
-	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64,
-	0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x2a, 0x0a, 0x14, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x55, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x66,
-	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xc4, 0x04, 0x0a, 0x09, 0x43, 0x61,
-	0x63, 0x68, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x4a, 0x0a, 0x0a, 0x63, 0x61, 0x63, 0x68, 0x65,
-	0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x67, 0x69,
+	0x61, 0x31, 0x2e, 0x44, 0x42, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x06, 0x64, 0x62, 0x54,
+	0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x7f, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4a, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e,
+	0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x55, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67, 0x69,
 	0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
-	0x61, 0x63, 0x68, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x09, 0x63, 0x61, 0x63, 0x68, 0x65, 0x53,
-	0x70, 0x65, 0x63, 0x12, 0x79, 0x0a, 0x16, 0x65, 0x61, 0x67, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x63,
-	0x68, 0x65, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70,
-	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x2e, 0x45, 0x61, 0x67, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x53,
-	0x70, 0x65, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x65, 0x61, 0x67, 0x65, 0x72,
-	0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x73, 0x12, 0x76,
-	0x0a, 0x15, 0x6c, 0x61, 0x7a, 0x79, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x72, 0x75, 0x6c,
-	0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x6c, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x66, 0x12, 0x5e,
+	0x0a, 0x14, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67,
+	0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x66, 0x52, 0x12, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x66, 0x12, 0x43,
+	0x0a, 0x05, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
 	0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
 	0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x4c, 0x61, 0x7a, 0x79, 0x43,
-	0x61, 0x63, 0x68, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x12, 0x6c, 0x61, 0x7a, 0x79, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x75, 0x6c,
-	0x65, 0x53, 0x70, 0x65, 0x63, 0x73, 0x1a, 0x7c, 0x0a, 0x18, 0x45, 0x61, 0x67, 0x65, 0x72, 0x43,
-	0x61, 0x63, 0x68, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x4a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70,
-	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x61, 0x67, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68,
-	0x65, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x7a, 0x0a, 0x17, 0x4c, 0x61, 0x7a, 0x79, 0x43, 0x61, 0x63, 0x68,
-	0x65, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61,
+	0x75, 0x6c, 0x74, 0x1a, 0x47, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x64, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x2a, 0x0a, 0x14, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x55, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xdd, 0x01, 0x0a, 0x11,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x12, 0x67, 0x0a, 0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65,
+	0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
 	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x49, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x33, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x4c, 0x61, 0x7a, 0x79, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x75, 0x6c,
-	0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x2a, 0x2d, 0x0a, 0x13, 0x43, 0x61, 0x63, 0x68, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x43, 0x41, 0x4c,
-	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x10, 0x01, 0x2a,
-	0x3b, 0x0a, 0x06, 0x44, 0x42, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x4f, 0x53,
-	0x54, 0x47, 0x52, 0x45, 0x53, 0x5f, 0x31, 0x34, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x59,
-	0x53, 0x51, 0x4c, 0x5f, 0x38, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x51, 0x4c, 0x5f, 0x53,
-	0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x32, 0x30, 0x31, 0x39, 0x10, 0x02, 0x42, 0x32, 0x0a, 0x2e,
-	0x69, 0x6f, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x50, 0x01,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe9, 0x03, 0x0a, 0x0b,
+	0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4b, 0x0a, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e,
+	0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x06, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x75, 0x74, 0x68, 0x50, 0x61, 0x74, 0x68, 0x12, 0x60, 0x0a, 0x10, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x36, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x66, 0x12, 0x61, 0x0a, 0x0c, 0x62, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3e, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0b, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x10,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x1a, 0x3e, 0x0a, 0x10, 0x42, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc4, 0x04, 0x0a, 0x09, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x4a, 0x0a, 0x0a, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x73,
+	0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x67, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x09, 0x63, 0x61, 0x63, 0x68, 0x65, 0x53, 0x70, 0x65,
+	0x63, 0x12, 0x79, 0x0a, 0x16, 0x65, 0x61, 0x67, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x44, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x45,
+	0x61, 0x67, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x70, 0x65,
+	0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x65, 0x61, 0x67, 0x65, 0x72, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x73, 0x12, 0x76, 0x0a, 0x15,
+	0x6c, 0x61, 0x7a, 0x79, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f,
+	0x73, 0x70, 0x65, 0x63, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x67, 0x69,
+	0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x4c, 0x61, 0x7a, 0x79, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x12, 0x6c, 0x61, 0x7a, 0x79, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x53,
+	0x70, 0x65, 0x63, 0x73, 0x1a, 0x7c, 0x0a, 0x18, 0x45, 0x61, 0x67, 0x65, 0x72, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x4a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x34, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x61, 0x67, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52,
+	0x75, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x7a, 0x0a, 0x17, 0x4c, 0x61, 0x7a, 0x79, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52,
+	0x75, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x49, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33,
+	0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4c, 0x61, 0x7a, 0x79, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x53,
+	0x70, 0x65, 0x63, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x2a, 0x2d,
+	0x0a, 0x13, 0x43, 0x61, 0x63, 0x68, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x00,
+	0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x10, 0x01, 0x2a, 0x37, 0x0a,
+	0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x4f, 0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x55,
+	0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4f, 0x4e, 0x5f, 0x44, 0x45,
+	0x4c, 0x45, 0x54, 0x45, 0x10, 0x01, 0x2a, 0x3b, 0x0a, 0x06, 0x44, 0x42, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x4f, 0x53, 0x54, 0x47, 0x52, 0x45, 0x53, 0x5f, 0x31, 0x34, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x59, 0x53, 0x51, 0x4c, 0x5f, 0x38, 0x10, 0x01, 0x12, 0x13,
+	0x0a, 0x0f, 0x53, 0x51, 0x4c, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x32, 0x30, 0x31,
+	0x39, 0x10, 0x02, 0x2a, 0x29, 0x0a, 0x11, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x4b, 0x56, 0x10, 0x00,
+	0x12, 0x0c, 0x0a, 0x08, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x10, 0x01, 0x42, 0x32,
+	0x0a, 0x2e, 0x69, 0x6f, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x50, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -853,49 +1916,84 @@ func file_config_cache_v1alpha1_cache_proto_rawDescGZIP() []byte {
 	return file_config_cache_v1alpha1_cache_proto_rawDescData
 }
 
-var file_config_cache_v1alpha1_cache_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_config_cache_v1alpha1_cache_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_config_cache_v1alpha1_cache_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_config_cache_v1alpha1_cache_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_config_cache_v1alpha1_cache_proto_goTypes = []interface{}{
-	(CacheDeploymentType)(0),       // 0: gingersnap.config.cache.v1alpha1.CacheDeploymentType
-	(DBType)(0),                    // 1: gingersnap.config.cache.v1alpha1.DBType
-	(*CacheSpec)(nil),              // 2: gingersnap.config.cache.v1alpha1.CacheSpec
-	(*CacheDeploymentSpec)(nil),    // 3: gingersnap.config.cache.v1alpha1.CacheDeploymentSpec
-	(*DBSyncerDeploymentSpec)(nil), // 4: gingersnap.config.cache.v1alpha1.DBSyncerDeploymentSpec
-	(*Resources)(nil),              // 5: gingersnap.config.cache.v1alpha1.Resources
-	(*ResourceQuantity)(nil),       // 6: gingersnap.config.cache.v1alpha1.ResourceQuantity
-	(*DataSourceSpec)(nil),         // 7: gingersnap.config.cache.v1alpha1.DataSourceSpec
-	(*LocalObjectReference)(nil),   // 8: gingersnap.config.cache.v1alpha1.LocalObjectReference
-	(*ServiceRef)(nil),             // 9: gingersnap.config.cache.v1alpha1.ServiceRef
-	(*CacheConf)(nil),              // 10: gingersnap.config.cache.v1alpha1.CacheConf
-	nil,                            // 11: gingersnap.config.cache.v1alpha1.DataSourceSpec.ConnectionPropertiesEntry
-	nil,                            // 12: gingersnap.config.cache.v1alpha1.CacheConf.EagerCacheRuleSpecsEntry
-	nil,                            // 13: gingersnap.config.cache.v1alpha1.CacheConf.LazyCacheRuleSpecsEntry
-	(*EagerCacheRuleSpec)(nil),     // 14: gingersnap.config.cache.v1alpha1.EagerCacheRuleSpec
-	(*LazyCacheRuleSpec)(nil),      // 15: gingersnap.config.cache.v1alpha1.LazyCacheRuleSpec
+	(CacheDeploymentType)(0),        // 0: gingersnap.config.cache.v1alpha1.CacheDeploymentType
+	(UpdateStrategyType)(0),         // 1: gingersnap.config.cache.v1alpha1.UpdateStrategyType
+	(DBType)(0),                     // 2: gingersnap.config.cache.v1alpha1.DBType
+	(VaultSecretEngine)(0),          // 3: gingersnap.config.cache.v1alpha1.VaultSecretEngine
+	(*CacheSpec)(nil),               // 4: gingersnap.config.cache.v1alpha1.CacheSpec
+	(*CacheDeploymentSpec)(nil),     // 5: gingersnap.config.cache.v1alpha1.CacheDeploymentSpec
+	(*CachePersistenceSpec)(nil),    // 6: gingersnap.config.cache.v1alpha1.CachePersistenceSpec
+	(*UpdateStrategy)(nil),          // 7: gingersnap.config.cache.v1alpha1.UpdateStrategy
+	(*DBSyncerDeploymentSpec)(nil),  // 8: gingersnap.config.cache.v1alpha1.DBSyncerDeploymentSpec
+	(*DBSyncerAutoscalingSpec)(nil), // 9: gingersnap.config.cache.v1alpha1.DBSyncerAutoscalingSpec
+	(*Logging)(nil),                 // 10: gingersnap.config.cache.v1alpha1.Logging
+	(*EnvVar)(nil),                  // 11: gingersnap.config.cache.v1alpha1.EnvVar
+	(*KeySelector)(nil),             // 12: gingersnap.config.cache.v1alpha1.KeySelector
+	(*EnvFromSource)(nil),           // 13: gingersnap.config.cache.v1alpha1.EnvFromSource
+	(*Resources)(nil),               // 14: gingersnap.config.cache.v1alpha1.Resources
+	(*ResourceQuantity)(nil),        // 15: gingersnap.config.cache.v1alpha1.ResourceQuantity
+	(*DataSourceSpec)(nil),          // 16: gingersnap.config.cache.v1alpha1.DataSourceSpec
+	(*LocalObjectReference)(nil),    // 17: gingersnap.config.cache.v1alpha1.LocalObjectReference
+	(*ServiceRef)(nil),              // 18: gingersnap.config.cache.v1alpha1.ServiceRef
+	(*NamespaceSelector)(nil),       // 19: gingersnap.config.cache.v1alpha1.NamespaceSelector
+	(*VaultSource)(nil),             // 20: gingersnap.config.cache.v1alpha1.VaultSource
+	(*CacheConf)(nil),               // 21: gingersnap.config.cache.v1alpha1.CacheConf
+	nil,                             // 22: gingersnap.config.cache.v1alpha1.Logging.CategoriesEntry
+	nil,                             // 23: gingersnap.config.cache.v1alpha1.DataSourceSpec.ConnectionPropertiesEntry
+	nil,                             // 24: gingersnap.config.cache.v1alpha1.NamespaceSelector.MatchLabelsEntry
+	nil,                             // 25: gingersnap.config.cache.v1alpha1.VaultSource.BindingDataEntry
+	nil,                             // 26: gingersnap.config.cache.v1alpha1.CacheConf.EagerCacheRuleSpecsEntry
+	nil,                             // 27: gingersnap.config.cache.v1alpha1.CacheConf.LazyCacheRuleSpecsEntry
+	(*EagerCacheRuleSpec)(nil),      // 28: gingersnap.config.cache.v1alpha1.EagerCacheRuleSpec
+	(*LazyCacheRuleSpec)(nil),       // 29: gingersnap.config.cache.v1alpha1.LazyCacheRuleSpec
 }
 var file_config_cache_v1alpha1_cache_proto_depIdxs = []int32{
-	3,  // 0: gingersnap.config.cache.v1alpha1.CacheSpec.deployment:type_name -> gingersnap.config.cache.v1alpha1.CacheDeploymentSpec
-	4,  // 1: gingersnap.config.cache.v1alpha1.CacheSpec.db_syncer:type_name -> gingersnap.config.cache.v1alpha1.DBSyncerDeploymentSpec
-	7,  // 2: gingersnap.config.cache.v1alpha1.CacheSpec.data_source:type_name -> gingersnap.config.cache.v1alpha1.DataSourceSpec
-	0,  // 3: gingersnap.config.cache.v1alpha1.CacheDeploymentSpec.type:type_name -> gingersnap.config.cache.v1alpha1.CacheDeploymentType
-	5,  // 4: gingersnap.config.cache.v1alpha1.CacheDeploymentSpec.resources:type_name -> gingersnap.config.cache.v1alpha1.Resources
-	5,  // 5: gingersnap.config.cache.v1alpha1.DBSyncerDeploymentSpec.resources:type_name -> gingersnap.config.cache.v1alpha1.Resources
-	6,  // 6: gingersnap.config.cache.v1alpha1.Resources.requests:type_name -> gingersnap.config.cache.v1alpha1.ResourceQuantity
-	6,  // 7: gingersnap.config.cache.v1alpha1.Resources.limits:type_name -> gingersnap.config.cache.v1alpha1.ResourceQuantity
-	1,  // 8: gingersnap.config.cache.v1alpha1.DataSourceSpec.db_type:type_name -> gingersnap.config.cache.v1alpha1.DBType
-	11, // 9: gingersnap.config.cache.v1alpha1.DataSourceSpec.connection_properties:type_name -> gingersnap.config.cache.v1alpha1.DataSourceSpec.ConnectionPropertiesEntry
-	8,  // 10: gingersnap.config.cache.v1alpha1.DataSourceSpec.secret_ref:type_name -> gingersnap.config.cache.v1alpha1.LocalObjectReference
-	9,  // 11: gingersnap.config.cache.v1alpha1.DataSourceSpec.service_provider_ref:type_name -> gingersnap.config.cache.v1alpha1.ServiceRef
-	2,  // 12: gingersnap.config.cache.v1alpha1.CacheConf.cache_spec:type_name -> gingersnap.config.cache.v1alpha1.CacheSpec
-	12, // 13: gingersnap.config.cache.v1alpha1.CacheConf.eager_cache_rule_specs:type_name -> gingersnap.config.cache.v1alpha1.CacheConf.EagerCacheRuleSpecsEntry
-	13, // 14: gingersnap.config.cache.v1alpha1.CacheConf.lazy_cache_rule_specs:type_name -> gingersnap.config.cache.v1alpha1.CacheConf.LazyCacheRuleSpecsEntry
-	14, // 15: gingersnap.config.cache.v1alpha1.CacheConf.EagerCacheRuleSpecsEntry.value:type_name -> gingersnap.config.cache.v1alpha1.EagerCacheRuleSpec
-	15, // 16: gingersnap.config.cache.v1alpha1.CacheConf.LazyCacheRuleSpecsEntry.value:type_name -> gingersnap.config.cache.v1alpha1.LazyCacheRuleSpec
-	17, // [17:17] is the sub-list for method output_type
-	17, // [17:17] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	5,  // 0: gingersnap.config.cache.v1alpha1.CacheSpec.deployment:type_name -> gingersnap.config.cache.v1alpha1.CacheDeploymentSpec
+	8,  // 1: gingersnap.config.cache.v1alpha1.CacheSpec.db_syncer:type_name -> gingersnap.config.cache.v1alpha1.DBSyncerDeploymentSpec
+	16, // 2: gingersnap.config.cache.v1alpha1.CacheSpec.data_source:type_name -> gingersnap.config.cache.v1alpha1.DataSourceSpec
+	19, // 3: gingersnap.config.cache.v1alpha1.CacheSpec.allowed_rule_namespaces:type_name -> gingersnap.config.cache.v1alpha1.NamespaceSelector
+	0,  // 4: gingersnap.config.cache.v1alpha1.CacheDeploymentSpec.type:type_name -> gingersnap.config.cache.v1alpha1.CacheDeploymentType
+	14, // 5: gingersnap.config.cache.v1alpha1.CacheDeploymentSpec.resources:type_name -> gingersnap.config.cache.v1alpha1.Resources
+	7,  // 6: gingersnap.config.cache.v1alpha1.CacheDeploymentSpec.update_strategy:type_name -> gingersnap.config.cache.v1alpha1.UpdateStrategy
+	10, // 7: gingersnap.config.cache.v1alpha1.CacheDeploymentSpec.logging:type_name -> gingersnap.config.cache.v1alpha1.Logging
+	11, // 8: gingersnap.config.cache.v1alpha1.CacheDeploymentSpec.extra_env:type_name -> gingersnap.config.cache.v1alpha1.EnvVar
+	13, // 9: gingersnap.config.cache.v1alpha1.CacheDeploymentSpec.env_from:type_name -> gingersnap.config.cache.v1alpha1.EnvFromSource
+	6,  // 10: gingersnap.config.cache.v1alpha1.CacheDeploymentSpec.persistence:type_name -> gingersnap.config.cache.v1alpha1.CachePersistenceSpec
+	1,  // 11: gingersnap.config.cache.v1alpha1.UpdateStrategy.type:type_name -> gingersnap.config.cache.v1alpha1.UpdateStrategyType
+	14, // 12: gingersnap.config.cache.v1alpha1.DBSyncerDeploymentSpec.resources:type_name -> gingersnap.config.cache.v1alpha1.Resources
+	10, // 13: gingersnap.config.cache.v1alpha1.DBSyncerDeploymentSpec.logging:type_name -> gingersnap.config.cache.v1alpha1.Logging
+	11, // 14: gingersnap.config.cache.v1alpha1.DBSyncerDeploymentSpec.extra_env:type_name -> gingersnap.config.cache.v1alpha1.EnvVar
+	13, // 15: gingersnap.config.cache.v1alpha1.DBSyncerDeploymentSpec.env_from:type_name -> gingersnap.config.cache.v1alpha1.EnvFromSource
+	9,  // 16: gingersnap.config.cache.v1alpha1.DBSyncerDeploymentSpec.autoscaling:type_name -> gingersnap.config.cache.v1alpha1.DBSyncerAutoscalingSpec
+	22, // 17: gingersnap.config.cache.v1alpha1.Logging.categories:type_name -> gingersnap.config.cache.v1alpha1.Logging.CategoriesEntry
+	12, // 18: gingersnap.config.cache.v1alpha1.EnvVar.config_map_key_ref:type_name -> gingersnap.config.cache.v1alpha1.KeySelector
+	12, // 19: gingersnap.config.cache.v1alpha1.EnvVar.secret_key_ref:type_name -> gingersnap.config.cache.v1alpha1.KeySelector
+	17, // 20: gingersnap.config.cache.v1alpha1.EnvFromSource.config_map_ref:type_name -> gingersnap.config.cache.v1alpha1.LocalObjectReference
+	17, // 21: gingersnap.config.cache.v1alpha1.EnvFromSource.secret_ref:type_name -> gingersnap.config.cache.v1alpha1.LocalObjectReference
+	15, // 22: gingersnap.config.cache.v1alpha1.Resources.requests:type_name -> gingersnap.config.cache.v1alpha1.ResourceQuantity
+	15, // 23: gingersnap.config.cache.v1alpha1.Resources.limits:type_name -> gingersnap.config.cache.v1alpha1.ResourceQuantity
+	2,  // 24: gingersnap.config.cache.v1alpha1.DataSourceSpec.db_type:type_name -> gingersnap.config.cache.v1alpha1.DBType
+	23, // 25: gingersnap.config.cache.v1alpha1.DataSourceSpec.connection_properties:type_name -> gingersnap.config.cache.v1alpha1.DataSourceSpec.ConnectionPropertiesEntry
+	17, // 26: gingersnap.config.cache.v1alpha1.DataSourceSpec.secret_ref:type_name -> gingersnap.config.cache.v1alpha1.LocalObjectReference
+	18, // 27: gingersnap.config.cache.v1alpha1.DataSourceSpec.service_provider_ref:type_name -> gingersnap.config.cache.v1alpha1.ServiceRef
+	20, // 28: gingersnap.config.cache.v1alpha1.DataSourceSpec.vault:type_name -> gingersnap.config.cache.v1alpha1.VaultSource
+	24, // 29: gingersnap.config.cache.v1alpha1.NamespaceSelector.match_labels:type_name -> gingersnap.config.cache.v1alpha1.NamespaceSelector.MatchLabelsEntry
+	3,  // 30: gingersnap.config.cache.v1alpha1.VaultSource.engine:type_name -> gingersnap.config.cache.v1alpha1.VaultSecretEngine
+	17, // 31: gingersnap.config.cache.v1alpha1.VaultSource.token_secret_ref:type_name -> gingersnap.config.cache.v1alpha1.LocalObjectReference
+	25, // 32: gingersnap.config.cache.v1alpha1.VaultSource.binding_data:type_name -> gingersnap.config.cache.v1alpha1.VaultSource.BindingDataEntry
+	4,  // 33: gingersnap.config.cache.v1alpha1.CacheConf.cache_spec:type_name -> gingersnap.config.cache.v1alpha1.CacheSpec
+	26, // 34: gingersnap.config.cache.v1alpha1.CacheConf.eager_cache_rule_specs:type_name -> gingersnap.config.cache.v1alpha1.CacheConf.EagerCacheRuleSpecsEntry
+	27, // 35: gingersnap.config.cache.v1alpha1.CacheConf.lazy_cache_rule_specs:type_name -> gingersnap.config.cache.v1alpha1.CacheConf.LazyCacheRuleSpecsEntry
+	28, // 36: gingersnap.config.cache.v1alpha1.CacheConf.EagerCacheRuleSpecsEntry.value:type_name -> gingersnap.config.cache.v1alpha1.EagerCacheRuleSpec
+	29, // 37: gingersnap.config.cache.v1alpha1.CacheConf.LazyCacheRuleSpecsEntry.value:type_name -> gingersnap.config.cache.v1alpha1.LazyCacheRuleSpec
+	38, // [38:38] is the sub-list for method output_type
+	38, // [38:38] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_config_cache_v1alpha1_cache_proto_init() }
@@ -930,7 +2028,7 @@ func file_config_cache_v1alpha1_cache_proto_init() {
 			}
 		}
 		file_config_cache_v1alpha1_cache_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DBSyncerDeploymentSpec); i {
+			switch v := v.(*CachePersistenceSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -942,7 +2040,7 @@ func file_config_cache_v1alpha1_cache_proto_init() {
 			}
 		}
 		file_config_cache_v1alpha1_cache_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Resources); i {
+			switch v := v.(*UpdateStrategy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -954,7 +2052,7 @@ func file_config_cache_v1alpha1_cache_proto_init() {
 			}
 		}
 		file_config_cache_v1alpha1_cache_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResourceQuantity); i {
+			switch v := v.(*DBSyncerDeploymentSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -966,7 +2064,7 @@ func file_config_cache_v1alpha1_cache_proto_init() {
 			}
 		}
 		file_config_cache_v1alpha1_cache_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataSourceSpec); i {
+			switch v := v.(*DBSyncerAutoscalingSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -978,7 +2076,7 @@ func file_config_cache_v1alpha1_cache_proto_init() {
 			}
 		}
 		file_config_cache_v1alpha1_cache_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LocalObjectReference); i {
+			switch v := v.(*Logging); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -990,7 +2088,7 @@ func file_config_cache_v1alpha1_cache_proto_init() {
 			}
 		}
 		file_config_cache_v1alpha1_cache_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceRef); i {
+			switch v := v.(*EnvVar); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1002,6 +2100,114 @@ func file_config_cache_v1alpha1_cache_proto_init() {
 			}
 		}
 		file_config_cache_v1alpha1_cache_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeySelector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_cache_v1alpha1_cache_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnvFromSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_cache_v1alpha1_cache_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Resources); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_cache_v1alpha1_cache_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceQuantity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_cache_v1alpha1_cache_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataSourceSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_cache_v1alpha1_cache_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalObjectReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_cache_v1alpha1_cache_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_cache_v1alpha1_cache_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamespaceSelector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_cache_v1alpha1_cache_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_cache_v1alpha1_cache_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CacheConf); i {
 			case 0:
 				return &v.state
@@ -1014,14 +2220,14 @@ func file_config_cache_v1alpha1_cache_proto_init() {
 			}
 		}
 	}
-	file_config_cache_v1alpha1_cache_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_config_cache_v1alpha1_cache_proto_msgTypes[12].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_cache_v1alpha1_cache_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   12,
+			NumEnums:      4,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -48,6 +48,48 @@ func (in *CacheDeploymentSpec) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using CachePersistenceSpec within kubernetes types, where deepcopy-gen is used.
+func (in *CachePersistenceSpec) DeepCopyInto(out *CachePersistenceSpec) {
+	p := proto.Clone(in).(*CachePersistenceSpec)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachePersistenceSpec. Required by controller-gen.
+func (in *CachePersistenceSpec) DeepCopy() *CachePersistenceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CachePersistenceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new CachePersistenceSpec. Required by controller-gen.
+func (in *CachePersistenceSpec) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using UpdateStrategy within kubernetes types, where deepcopy-gen is used.
+func (in *UpdateStrategy) DeepCopyInto(out *UpdateStrategy) {
+	p := proto.Clone(in).(*UpdateStrategy)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateStrategy. Required by controller-gen.
+func (in *UpdateStrategy) DeepCopy() *UpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(UpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new UpdateStrategy. Required by controller-gen.
+func (in *UpdateStrategy) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using DBSyncerDeploymentSpec within kubernetes types, where deepcopy-gen is used.
 func (in *DBSyncerDeploymentSpec) DeepCopyInto(out *DBSyncerDeploymentSpec) {
 	p := proto.Clone(in).(*DBSyncerDeploymentSpec)
@@ -69,6 +111,111 @@ func (in *DBSyncerDeploymentSpec) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using DBSyncerAutoscalingSpec within kubernetes types, where deepcopy-gen is used.
+func (in *DBSyncerAutoscalingSpec) DeepCopyInto(out *DBSyncerAutoscalingSpec) {
+	p := proto.Clone(in).(*DBSyncerAutoscalingSpec)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSyncerAutoscalingSpec. Required by controller-gen.
+func (in *DBSyncerAutoscalingSpec) DeepCopy() *DBSyncerAutoscalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSyncerAutoscalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new DBSyncerAutoscalingSpec. Required by controller-gen.
+func (in *DBSyncerAutoscalingSpec) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using Logging within kubernetes types, where deepcopy-gen is used.
+func (in *Logging) DeepCopyInto(out *Logging) {
+	p := proto.Clone(in).(*Logging)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Logging. Required by controller-gen.
+func (in *Logging) DeepCopy() *Logging {
+	if in == nil {
+		return nil
+	}
+	out := new(Logging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Logging. Required by controller-gen.
+func (in *Logging) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using EnvVar within kubernetes types, where deepcopy-gen is used.
+func (in *EnvVar) DeepCopyInto(out *EnvVar) {
+	p := proto.Clone(in).(*EnvVar)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvVar. Required by controller-gen.
+func (in *EnvVar) DeepCopy() *EnvVar {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvVar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new EnvVar. Required by controller-gen.
+func (in *EnvVar) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using KeySelector within kubernetes types, where deepcopy-gen is used.
+func (in *KeySelector) DeepCopyInto(out *KeySelector) {
+	p := proto.Clone(in).(*KeySelector)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeySelector. Required by controller-gen.
+func (in *KeySelector) DeepCopy() *KeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(KeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new KeySelector. Required by controller-gen.
+func (in *KeySelector) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using EnvFromSource within kubernetes types, where deepcopy-gen is used.
+func (in *EnvFromSource) DeepCopyInto(out *EnvFromSource) {
+	p := proto.Clone(in).(*EnvFromSource)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvFromSource. Required by controller-gen.
+func (in *EnvFromSource) DeepCopy() *EnvFromSource {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvFromSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new EnvFromSource. Required by controller-gen.
+func (in *EnvFromSource) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using Resources within kubernetes types, where deepcopy-gen is used.
 func (in *Resources) DeepCopyInto(out *Resources) {
 	p := proto.Clone(in).(*Resources)
@@ -174,6 +321,48 @@ func (in *ServiceRef) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using NamespaceSelector within kubernetes types, where deepcopy-gen is used.
+func (in *NamespaceSelector) DeepCopyInto(out *NamespaceSelector) {
+	p := proto.Clone(in).(*NamespaceSelector)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceSelector. Required by controller-gen.
+func (in *NamespaceSelector) DeepCopy() *NamespaceSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceSelector. Required by controller-gen.
+func (in *NamespaceSelector) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using VaultSource within kubernetes types, where deepcopy-gen is used.
+func (in *VaultSource) DeepCopyInto(out *VaultSource) {
+	p := proto.Clone(in).(*VaultSource)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultSource. Required by controller-gen.
+func (in *VaultSource) DeepCopy() *VaultSource {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new VaultSource. Required by controller-gen.
+func (in *VaultSource) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using CacheConf within kubernetes types, where deepcopy-gen is used.
 func (in *CacheConf) DeepCopyInto(out *CacheConf) {
 	p := proto.Clone(in).(*CacheConf)
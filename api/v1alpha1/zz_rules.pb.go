@@ -20,6 +20,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:validation:Type=string
+// When an eager rule's table is snapshotted into the cache
+type SnapshotMode int32
+
+const (
+	SnapshotMode_INITIAL SnapshotMode = 0
+	SnapshotMode_NEVER   SnapshotMode = 1
+	SnapshotMode_ALWAYS  SnapshotMode = 2
+)
+
+// Enum value maps for SnapshotMode.
+var (
+	SnapshotMode_name = map[int32]string{
+		0: "INITIAL",
+		1: "NEVER",
+		2: "ALWAYS",
+	}
+	SnapshotMode_value = map[string]int32{
+		"INITIAL": 0,
+		"NEVER":   1,
+		"ALWAYS":  2,
+	}
+)
+
+func (x SnapshotMode) Enum() *SnapshotMode {
+	p := new(SnapshotMode)
+	*p = x
+	return p
+}
+
+func (x SnapshotMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SnapshotMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_cache_v1alpha1_rules_proto_enumTypes[0].Descriptor()
+}
+
+func (SnapshotMode) Type() protoreflect.EnumType {
+	return &file_config_cache_v1alpha1_rules_proto_enumTypes[0]
+}
+
+func (x SnapshotMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SnapshotMode.Descriptor instead.
+func (SnapshotMode) EnumDescriptor() ([]byte, []int) {
+	return file_config_cache_v1alpha1_rules_proto_rawDescGZIP(), []int{0}
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:validation:Type=string
 // Supported format for the key of the cache entry
@@ -53,11 +105,11 @@ func (x KeyFormat) String() string {
 }
 
 func (KeyFormat) Descriptor() protoreflect.EnumDescriptor {
-	return file_config_cache_v1alpha1_rules_proto_enumTypes[0].Descriptor()
+	return file_config_cache_v1alpha1_rules_proto_enumTypes[1].Descriptor()
 }
 
 func (KeyFormat) Type() protoreflect.EnumType {
-	return &file_config_cache_v1alpha1_rules_proto_enumTypes[0]
+	return &file_config_cache_v1alpha1_rules_proto_enumTypes[1]
 }
 
 func (x KeyFormat) Number() protoreflect.EnumNumber {
@@ -66,7 +118,7 @@ func (x KeyFormat) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use KeyFormat.Descriptor instead.
 func (KeyFormat) EnumDescriptor() ([]byte, []int) {
-	return file_config_cache_v1alpha1_rules_proto_rawDescGZIP(), []int{0}
+	return file_config_cache_v1alpha1_rules_proto_rawDescGZIP(), []int{1}
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -85,6 +137,14 @@ type EagerCacheRuleSpec struct {
 	Key *EagerCacheKey `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
 	// Query columns used to build the entry value
 	Value *Value `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	// Replication lag after which the rule is reported as Lagging, e.g. 30s. Defaults to 1m
+	LagThreshold string `protobuf:"bytes,5,opt,name=lag_threshold,json=lagThreshold,proto3" json:"lagThreshold,omitempty"`
+	// +kubebuilder:validation:Enum=INITIAL;NEVER;ALWAYS
+	// When the table is snapshotted into the cache. INITIAL snapshots the table when the rule is created, NEVER only
+	// replicates subsequent changes and ALWAYS snapshots the table whenever db-syncer starts. Defaults to INITIAL
+	SnapshotMode SnapshotMode `protobuf:"varint,6,opt,name=snapshot_mode,json=snapshotMode,proto3,enum=gingersnap.config.cache.v1alpha1.SnapshotMode" json:"snapshotMode,omitempty"`
+	// Incrementing the generation requests that db-syncer re-snapshots the table, e.g. after a schema migration
+	ResyncGeneration int64 `protobuf:"varint,7,opt,name=resync_generation,json=resyncGeneration,proto3" json:"resyncGeneration,omitempty"`
 }
 
 func (x *EagerCacheRuleSpec) Reset() {
@@ -147,6 +207,27 @@ func (x *EagerCacheRuleSpec) GetValue() *Value {
 	return nil
 }
 
+func (x *EagerCacheRuleSpec) GetLagThreshold() string {
+	if x != nil {
+		return x.LagThreshold
+	}
+	return ""
+}
+
+func (x *EagerCacheRuleSpec) GetSnapshotMode() SnapshotMode {
+	if x != nil {
+		return x.SnapshotMode
+	}
+	return SnapshotMode_INITIAL
+}
+
+func (x *EagerCacheRuleSpec) GetResyncGeneration() int64 {
+	if x != nil {
+		return x.ResyncGeneration
+	}
+	return 0
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // Describes a caching rule behaviours
 type LazyCacheRuleSpec struct {
@@ -460,7 +541,7 @@ var file_config_cache_v1alpha1_rules_proto_rawDesc = []byte{
 	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x20, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e,
 	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0x8f, 0x02, 0x0a, 0x12, 0x45, 0x61, 0x67, 0x65, 0x72, 0x43,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0xb6, 0x03, 0x0a, 0x12, 0x45, 0x61, 0x67, 0x65, 0x72, 0x43,
 	0x61, 0x63, 0x68, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x58, 0x0a, 0x09,
 	0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x3b, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e,
@@ -477,50 +558,64 @@ var file_config_cache_v1alpha1_rules_proto_rawDesc = []byte{
 	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72,
 	0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68,
 	0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xc5, 0x01, 0x0a, 0x11, 0x4c, 0x61, 0x7a, 0x79,
-	0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x58, 0x0a,
-	0x09, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x3b, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x63,
-	0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x40, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x67, 0x69, 0x6e,
-	0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63,
-	0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x61,
-	0x7a, 0x79, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22,
-	0x78, 0x0a, 0x0c, 0x4c, 0x61, 0x7a, 0x79, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4b, 0x65, 0x79, 0x12,
-	0x43, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x2b, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x65, 0x70, 0x61,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6b, 0x65, 0x79,
-	0x53, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x9a, 0x01, 0x0a, 0x0d, 0x45, 0x61,
-	0x67, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x43, 0x0a, 0x06, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x67, 0x69,
-	0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4b,
-	0x65, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-	0x12, 0x23, 0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x53, 0x65, 0x70, 0x61,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x65, 0x79, 0x5f, 0x63, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x65, 0x79, 0x43,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0x2c, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x73, 0x22, 0x4d, 0x0a, 0x19, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x2a, 0x1f, 0x0a, 0x09, 0x4b, 0x65, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-	0x12, 0x08, 0x0a, 0x04, 0x54, 0x45, 0x58, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53,
-	0x4f, 0x4e, 0x10, 0x01, 0x42, 0x32, 0x0a, 0x2e, 0x69, 0x6f, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65,
-	0x72, 0x73, 0x6e, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x50, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x67, 0x5f, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x6c, 0x61, 0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x53, 0x0a, 0x0d,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x0c, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65,
+	0x73, 0x79, 0x6e, 0x63, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc5,
+	0x01, 0x0a, 0x11, 0x4c, 0x61, 0x7a, 0x79, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x75, 0x6c, 0x65,
+	0x53, 0x70, 0x65, 0x63, 0x12, 0x58, 0x0a, 0x09, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x72, 0x65,
+	0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72,
+	0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x63, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x66, 0x12, 0x14,
+	0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x40, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x61, 0x7a, 0x79, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4b, 0x65,
+	0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x78, 0x0a, 0x0c, 0x4c, 0x61, 0x7a, 0x79, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x43, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73,
+	0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6b,
+	0x65, 0x79, 0x5f, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x53, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x22, 0x9a, 0x01, 0x0a, 0x0d, 0x45, 0x61, 0x67, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4b,
+	0x65, 0x79, 0x12, 0x43, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x73,
+	0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x6b, 0x65, 0x79, 0x53, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6b, 0x65, 0x79, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x6b, 0x65, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0x2c, 0x0a,
+	0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0x4d, 0x0a, 0x19, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2a, 0x32, 0x0a, 0x0c, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e,
+	0x49, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x45, 0x56, 0x45, 0x52,
+	0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x4c, 0x57, 0x41, 0x59, 0x53, 0x10, 0x02, 0x2a, 0x1f,
+	0x0a, 0x09, 0x4b, 0x65, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x08, 0x0a, 0x04, 0x54,
+	0x45, 0x58, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x42,
+	0x32, 0x0a, 0x2e, 0x69, 0x6f, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x50, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -535,30 +630,32 @@ func file_config_cache_v1alpha1_rules_proto_rawDescGZIP() []byte {
 	return file_config_cache_v1alpha1_rules_proto_rawDescData
 }
 
-var file_config_cache_v1alpha1_rules_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_config_cache_v1alpha1_rules_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_config_cache_v1alpha1_rules_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_config_cache_v1alpha1_rules_proto_goTypes = []interface{}{
-	(KeyFormat)(0),                    // 0: gingersnap.config.cache.v1alpha1.KeyFormat
-	(*EagerCacheRuleSpec)(nil),        // 1: gingersnap.config.cache.v1alpha1.EagerCacheRuleSpec
-	(*LazyCacheRuleSpec)(nil),         // 2: gingersnap.config.cache.v1alpha1.LazyCacheRuleSpec
-	(*LazyCacheKey)(nil),              // 3: gingersnap.config.cache.v1alpha1.LazyCacheKey
-	(*EagerCacheKey)(nil),             // 4: gingersnap.config.cache.v1alpha1.EagerCacheKey
-	(*Value)(nil),                     // 5: gingersnap.config.cache.v1alpha1.Value
-	(*NamespacedObjectReference)(nil), // 6: gingersnap.config.cache.v1alpha1.NamespacedObjectReference
+	(SnapshotMode)(0),                 // 0: gingersnap.config.cache.v1alpha1.SnapshotMode
+	(KeyFormat)(0),                    // 1: gingersnap.config.cache.v1alpha1.KeyFormat
+	(*EagerCacheRuleSpec)(nil),        // 2: gingersnap.config.cache.v1alpha1.EagerCacheRuleSpec
+	(*LazyCacheRuleSpec)(nil),         // 3: gingersnap.config.cache.v1alpha1.LazyCacheRuleSpec
+	(*LazyCacheKey)(nil),              // 4: gingersnap.config.cache.v1alpha1.LazyCacheKey
+	(*EagerCacheKey)(nil),             // 5: gingersnap.config.cache.v1alpha1.EagerCacheKey
+	(*Value)(nil),                     // 6: gingersnap.config.cache.v1alpha1.Value
+	(*NamespacedObjectReference)(nil), // 7: gingersnap.config.cache.v1alpha1.NamespacedObjectReference
 }
 var file_config_cache_v1alpha1_rules_proto_depIdxs = []int32{
-	6, // 0: gingersnap.config.cache.v1alpha1.EagerCacheRuleSpec.cache_ref:type_name -> gingersnap.config.cache.v1alpha1.NamespacedObjectReference
-	4, // 1: gingersnap.config.cache.v1alpha1.EagerCacheRuleSpec.key:type_name -> gingersnap.config.cache.v1alpha1.EagerCacheKey
-	5, // 2: gingersnap.config.cache.v1alpha1.EagerCacheRuleSpec.value:type_name -> gingersnap.config.cache.v1alpha1.Value
-	6, // 3: gingersnap.config.cache.v1alpha1.LazyCacheRuleSpec.cache_ref:type_name -> gingersnap.config.cache.v1alpha1.NamespacedObjectReference
-	3, // 4: gingersnap.config.cache.v1alpha1.LazyCacheRuleSpec.key:type_name -> gingersnap.config.cache.v1alpha1.LazyCacheKey
-	0, // 5: gingersnap.config.cache.v1alpha1.LazyCacheKey.format:type_name -> gingersnap.config.cache.v1alpha1.KeyFormat
-	0, // 6: gingersnap.config.cache.v1alpha1.EagerCacheKey.format:type_name -> gingersnap.config.cache.v1alpha1.KeyFormat
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	7, // 0: gingersnap.config.cache.v1alpha1.EagerCacheRuleSpec.cache_ref:type_name -> gingersnap.config.cache.v1alpha1.NamespacedObjectReference
+	5, // 1: gingersnap.config.cache.v1alpha1.EagerCacheRuleSpec.key:type_name -> gingersnap.config.cache.v1alpha1.EagerCacheKey
+	6, // 2: gingersnap.config.cache.v1alpha1.EagerCacheRuleSpec.value:type_name -> gingersnap.config.cache.v1alpha1.Value
+	0, // 3: gingersnap.config.cache.v1alpha1.EagerCacheRuleSpec.snapshot_mode:type_name -> gingersnap.config.cache.v1alpha1.SnapshotMode
+	7, // 4: gingersnap.config.cache.v1alpha1.LazyCacheRuleSpec.cache_ref:type_name -> gingersnap.config.cache.v1alpha1.NamespacedObjectReference
+	4, // 5: gingersnap.config.cache.v1alpha1.LazyCacheRuleSpec.key:type_name -> gingersnap.config.cache.v1alpha1.LazyCacheKey
+	1, // 6: gingersnap.config.cache.v1alpha1.LazyCacheKey.format:type_name -> gingersnap.config.cache.v1alpha1.KeyFormat
+	1, // 7: gingersnap.config.cache.v1alpha1.EagerCacheKey.format:type_name -> gingersnap.config.cache.v1alpha1.KeyFormat
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_config_cache_v1alpha1_rules_proto_init() }
@@ -645,7 +742,7 @@ func file_config_cache_v1alpha1_rules_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_cache_v1alpha1_rules_proto_rawDesc,
-			NumEnums:      1,
+			NumEnums:      2,
 			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
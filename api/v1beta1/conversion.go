@@ -0,0 +1,106 @@
+package v1beta1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+var (
+	_ conversion.Convertible = &Cache{}
+	_ conversion.Convertible = &LazyCacheRule{}
+	_ conversion.Convertible = &EagerCacheRule{}
+)
+
+// ConvertTo converts this Cache to the Hub version (v1alpha1)
+func (src *Cache) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.Cache)
+	dst.ObjectMeta = src.ObjectMeta
+	if err := convertSpec(&src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+	return convertStatus(&src.Status, &dst.Status)
+}
+
+// ConvertFrom converts from the Hub version (v1alpha1) to this version
+func (dst *Cache) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.Cache)
+	dst.ObjectMeta = src.ObjectMeta
+	if err := convertSpec(&src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+	return convertStatus(&src.Status, &dst.Status)
+}
+
+// ConvertTo converts this LazyCacheRule to the Hub version (v1alpha1)
+func (src *LazyCacheRule) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.LazyCacheRule)
+	dst.ObjectMeta = src.ObjectMeta
+	if err := convertSpec(&src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+	return convertStatus(&src.Status, &dst.Status)
+}
+
+// ConvertFrom converts from the Hub version (v1alpha1) to this version
+func (dst *LazyCacheRule) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.LazyCacheRule)
+	dst.ObjectMeta = src.ObjectMeta
+	if err := convertSpec(&src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+	return convertStatus(&src.Status, &dst.Status)
+}
+
+// ConvertTo converts this EagerCacheRule to the Hub version (v1alpha1)
+func (src *EagerCacheRule) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.EagerCacheRule)
+	dst.ObjectMeta = src.ObjectMeta
+	if err := convertSpec(&src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+	return convertStatus(&src.Status, &dst.Status)
+}
+
+// ConvertFrom converts from the Hub version (v1alpha1) to this version
+func (dst *EagerCacheRule) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.EagerCacheRule)
+	dst.ObjectMeta = src.ObjectMeta
+	if err := convertSpec(&src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+	return convertStatus(&src.Status, &dst.Status)
+}
+
+// convertSpec copies a protobuf spec between API versions using the canonical protobuf JSON mapping. Unknown fields
+// are rejected so that a field added to one version but not the other fails conversion instead of being dropped.
+func convertSpec(src, dst proto.Message) error {
+	bytes, err := protojson.Marshal(src)
+	if err != nil {
+		return fmt.Errorf("unable to marshal %T: %w", src, err)
+	}
+	if err = protojson.Unmarshal(bytes, dst); err != nil {
+		return fmt.Errorf("unable to convert %T to %T: %w", src, dst, err)
+	}
+	return nil
+}
+
+// convertStatus copies a status between API versions. As with convertSpec, unknown fields are rejected so that the
+// status structs of each version are kept in sync.
+func convertStatus(src, dst interface{}) error {
+	b, err := json.Marshal(src)
+	if err != nil {
+		return fmt.Errorf("unable to marshal %T: %w", src, err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.DisallowUnknownFields()
+	if err = decoder.Decode(dst); err != nil {
+		return fmt.Errorf("unable to convert %T to %T: %w", src, dst, err)
+	}
+	return nil
+}
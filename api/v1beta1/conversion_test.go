@@ -0,0 +1,191 @@
+package v1beta1_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	"github.com/gingersnap-project/operator/api/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestConversion(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Conversion Suite")
+}
+
+var objectMeta = metav1.ObjectMeta{
+	Name:        "name",
+	Namespace:   "namespace",
+	Labels:      map[string]string{"label": "value"},
+	Annotations: map[string]string{"annotation": "value"},
+	Finalizers:  []string{"finalizer"},
+	Generation:  2,
+}
+
+var _ = Describe("Conversion", func() {
+
+	It("should round-trip every Cache field", func() {
+		spoke := &v1beta1.Cache{
+			ObjectMeta: objectMeta,
+			Status: v1beta1.CacheStatus{
+				Conditions: []v1beta1.CacheCondition{{
+					Type:    v1beta1.CacheConditionReady,
+					Status:  metav1.ConditionTrue,
+					Message: "message",
+				}},
+				ServiceBinding: &v1beta1.ServiceBinding{Name: "binding"},
+			},
+		}
+		populate(spoke.Spec.ProtoReflect())
+
+		hub := &v1alpha1.Cache{}
+		Expect(spoke.ConvertTo(hub)).To(Succeed())
+		Expect(hub.ObjectMeta).To(Equal(spoke.ObjectMeta))
+		Expect(hub.Status.Conditions).To(HaveLen(1))
+		Expect(hub.Status.Conditions[0].Type).To(Equal(v1alpha1.CacheConditionReady))
+		Expect(hub.Status.Conditions[0].Status).To(Equal(metav1.ConditionTrue))
+		Expect(hub.Status.Conditions[0].Message).To(Equal("message"))
+		Expect(hub.Status.ServiceBinding.Name).To(Equal("binding"))
+		Expect(hub.Local()).To(BeFalse())
+		Expect(hub.Spec.DataSource.SecretRef.Name).To(Equal(spoke.Spec.DataSource.SecretRef.Name))
+
+		result := &v1beta1.Cache{}
+		Expect(result.ConvertFrom(hub)).To(Succeed())
+		Expect(result.ObjectMeta).To(Equal(spoke.ObjectMeta))
+		Expect(result.Status).To(Equal(spoke.Status))
+		Expect(proto.Equal(&result.Spec, &spoke.Spec)).To(BeTrue(), "spec %v != %v", &result.Spec, &spoke.Spec)
+	})
+
+	It("should round-trip every LazyCacheRule field", func() {
+		spoke := &v1beta1.LazyCacheRule{
+			ObjectMeta: objectMeta,
+			Status: v1beta1.LazyCacheRuleStatus{
+				Conditions: []v1beta1.LazyCacheRuleCondition{{
+					Type:    v1beta1.LazyCacheRuleConditionReady,
+					Status:  metav1.ConditionFalse,
+					Message: "message",
+				}},
+			},
+		}
+		populate(spoke.Spec.ProtoReflect())
+
+		hub := &v1alpha1.LazyCacheRule{}
+		Expect(spoke.ConvertTo(hub)).To(Succeed())
+		Expect(hub.ObjectMeta).To(Equal(spoke.ObjectMeta))
+		Expect(hub.Spec.Query).To(Equal(spoke.Spec.Query))
+		Expect(hub.Spec.Key.Format).To(Equal(v1alpha1.KeyFormat_JSON))
+		Expect(hub.Status.Conditions[0].Message).To(Equal("message"))
+
+		result := &v1beta1.LazyCacheRule{}
+		Expect(result.ConvertFrom(hub)).To(Succeed())
+		Expect(result.ObjectMeta).To(Equal(spoke.ObjectMeta))
+		Expect(result.Status).To(Equal(spoke.Status))
+		Expect(proto.Equal(&result.Spec, &spoke.Spec)).To(BeTrue(), "spec %v != %v", &result.Spec, &spoke.Spec)
+	})
+
+	It("should round-trip every EagerCacheRule field", func() {
+		spoke := &v1beta1.EagerCacheRule{
+			ObjectMeta: objectMeta,
+			Status: v1beta1.EagerCacheRuleStatus{
+				Conditions: []v1beta1.EagerCacheRuleCondition{{
+					Type:    v1beta1.EagerCacheRuleConditionReady,
+					Status:  metav1.ConditionUnknown,
+					Message: "message",
+				}},
+			},
+		}
+		populate(spoke.Spec.ProtoReflect())
+
+		hub := &v1alpha1.EagerCacheRule{}
+		Expect(spoke.ConvertTo(hub)).To(Succeed())
+		Expect(hub.ObjectMeta).To(Equal(spoke.ObjectMeta))
+		Expect(hub.Spec.TableName).To(Equal(spoke.Spec.TableName))
+		Expect(hub.Spec.Key.KeyColumns).To(Equal(spoke.Spec.Key.KeyColumns))
+		Expect(hub.Status.Conditions[0].Status).To(Equal(metav1.ConditionUnknown))
+
+		result := &v1beta1.EagerCacheRule{}
+		Expect(result.ConvertFrom(hub)).To(Succeed())
+		Expect(result.ObjectMeta).To(Equal(spoke.ObjectMeta))
+		Expect(result.Status).To(Equal(spoke.Status))
+		Expect(proto.Equal(&result.Spec, &spoke.Spec)).To(BeTrue(), "spec %v != %v", &result.Spec, &spoke.Spec)
+	})
+
+	It("should round-trip every hub field", func() {
+		hub := &v1alpha1.Cache{ObjectMeta: objectMeta}
+		populate(hub.Spec.ProtoReflect())
+
+		spoke := &v1beta1.Cache{}
+		Expect(spoke.ConvertFrom(hub)).To(Succeed())
+
+		result := &v1alpha1.Cache{}
+		Expect(spoke.ConvertTo(result)).To(Succeed())
+		Expect(proto.Equal(&result.Spec, &hub.Spec)).To(BeTrue(), "spec %v != %v", &result.Spec, &hub.Spec)
+	})
+})
+
+// populate sets every field of the message, recursively, to a non-default value so that conversion tests fail if a
+// field is added to one API version without a counterpart in the other
+func populate(m protoreflect.Message) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		switch {
+		case fd.IsMap():
+			mv := m.Mutable(fd).Map()
+			mv.Set(scalar(fd.MapKey()).MapKey(), mapValue(mv, fd.MapValue()))
+		case fd.IsList():
+			lv := m.Mutable(fd).List()
+			if fd.Kind() == protoreflect.MessageKind {
+				populate(lv.AppendMutable().Message())
+			} else {
+				lv.Append(scalar(fd))
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			populate(m.Mutable(fd).Message())
+		default:
+			m.Set(fd, scalar(fd))
+		}
+	}
+}
+
+func mapValue(mv protoreflect.Map, fd protoreflect.FieldDescriptor) protoreflect.Value {
+	if fd.Kind() == protoreflect.MessageKind {
+		v := mv.NewValue()
+		populate(v.Message())
+		return v
+	}
+	return scalar(fd)
+}
+
+func scalar(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(fmt.Sprintf("%s-value", fd.Name()))
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(true)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(int32(fd.Number()) + 1)
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(int64(fd.Number()) + 1)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(uint32(fd.Number()) + 1)
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(uint64(fd.Number()) + 1)
+	case protoreflect.FloatKind:
+		return protoreflect.ValueOfFloat32(float32(fd.Number()) + 0.5)
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(float64(fd.Number()) + 0.5)
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes([]byte(fd.Name()))
+	case protoreflect.EnumKind:
+		// Use the last declared value so that it differs from the proto3 default
+		values := fd.Enum().Values()
+		return protoreflect.ValueOfEnum(values.Get(values.Len() - 1).Number())
+	}
+	panic(fmt.Sprintf("unsupported field kind %s", fd.Kind()))
+}
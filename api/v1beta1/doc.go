@@ -0,0 +1,4 @@
+// Package v1beta1 contains API Schema definitions for the gingersnap v1beta1 API group
+//+kubebuilder:object:generate=true
+//+groupName=gingersnap-project.io
+package v1beta1
@@ -0,0 +1,112 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const KindEagerCacheRule = "EagerCacheRule"
+
+// +kubebuilder:validation:Enum=Ready;LeaderElected;Lagging;CDCReady
+type EagerCacheRuleConditionType string
+
+const (
+	EagerCacheRuleConditionReady EagerCacheRuleConditionType = "Ready"
+	// EagerCacheRuleConditionLeaderElected is False whilst no db-syncer replica holds the leader Lease, e.g. during failover
+	EagerCacheRuleConditionLeaderElected EagerCacheRuleConditionType = "LeaderElected"
+	// EagerCacheRuleConditionLagging is True when the replication lag of the rule exceeds spec.lagThreshold
+	EagerCacheRuleConditionLagging EagerCacheRuleConditionType = "Lagging"
+	// EagerCacheRuleConditionCDCReady is True once the database has been verified to meet db-syncer's change data
+	// capture prerequisites
+	EagerCacheRuleConditionCDCReady EagerCacheRuleConditionType = "CDCReady"
+)
+
+// EagerCacheRuleCondition indicates the current status of a deployment
+type EagerCacheRuleCondition struct {
+	// Type is the type of the condition.
+	Type EagerCacheRuleConditionType `json:"type,omitempty"`
+	// +kubebuilder:validation:Enum=True;False;Unknown
+	// Status is the status of the condition.
+	Status metav1.ConditionStatus `json:"status,omitempty"`
+	// Human-readable message indicating details about last transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// EagerCacheRuleStatus defines the observed state of EagerCacheRule
+type EagerCacheRuleStatus struct {
+	// +optional
+	Conditions []EagerCacheRuleCondition `json:"conditions,omitempty"`
+	// Credentials the data source credentials currently used by the db-syncer
+	// +optional
+	Credentials *CredentialsStatus `json:"credentials,omitempty"`
+	// Replication the change data capture progress of the rule as reported by the db-syncer leader
+	// +optional
+	Replication *ReplicationStatus `json:"replication,omitempty"`
+	// RetryAttempts the number of consecutive reconciliations that have been requeued with backoff without the rule
+	// making progress. Reset to zero once reconciliation progresses
+	// +optional
+	RetryAttempts int32 `json:"retryAttempts,omitempty"`
+}
+
+// ReplicationStatus describes the change data capture progress of an EagerCacheRule
+type ReplicationStatus struct {
+	// Lag between a change being committed to the database and it being applied to the cache
+	// +optional
+	Lag *metav1.Duration `json:"lag,omitempty"`
+	// LastProcessedChange the commit time of the most recent change applied to the cache
+	// +optional
+	LastProcessedChange *metav1.Time `json:"lastProcessedChange,omitempty"`
+	// Snapshot progress of the rule's table snapshot
+	// +optional
+	Snapshot *SnapshotStatus `json:"snapshot,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Pending;InProgress;Completed;Failed
+type SnapshotState string
+
+const (
+	SnapshotStatePending    SnapshotState = "Pending"
+	SnapshotStateInProgress SnapshotState = "InProgress"
+	SnapshotStateCompleted  SnapshotState = "Completed"
+	SnapshotStateFailed     SnapshotState = "Failed"
+)
+
+// SnapshotStatus describes the progress of a table snapshot
+type SnapshotStatus struct {
+	// State of the snapshot
+	State SnapshotState `json:"state,omitempty"`
+	// ProcessedRows the number of table rows loaded into the cache
+	ProcessedRows int64 `json:"processedRows,omitempty"`
+	// TotalRows the estimated number of table rows to be loaded
+	TotalRows int64 `json:"totalRows,omitempty"`
+	// ResyncGeneration the spec.resyncGeneration that triggered the snapshot
+	ResyncGeneration int64 `json:"resyncGeneration,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:storageversion
+
+// EagerCacheRule is the Schema for the eagercacherules API
+type EagerCacheRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EagerCacheRuleSpec   `json:"spec,omitempty"`
+	Status EagerCacheRuleStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// EagerCacheRuleList contains a list of EagerCacheRule
+type EagerCacheRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EagerCacheRule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&EagerCacheRule{}, &EagerCacheRuleList{})
+}
@@ -0,0 +1,59 @@
+package v1beta1
+
+import (
+	"fmt"
+)
+
+func (x CacheDeploymentType) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("\"%s\"", CacheDeploymentType_name[int32(x)])), nil
+}
+
+func (x *CacheDeploymentType) UnmarshalJSON(b []byte) error {
+	*x = CacheDeploymentType(CacheDeploymentType_value[string(b[1:len(b)-1])])
+	return nil
+}
+
+func (x DBType) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("\"%s\"", DBType_name[int32(x)])), nil
+}
+
+func (x *DBType) UnmarshalJSON(b []byte) error {
+	*x = DBType(DBType_value[string(b[1:len(b)-1])])
+	return nil
+}
+
+func (x KeyFormat) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("\"%s\"", KeyFormat_name[int32(x)])), nil
+}
+
+func (x *KeyFormat) UnmarshalJSON(b []byte) error {
+	*x = KeyFormat(KeyFormat_value[string(b[1:len(b)-1])])
+	return nil
+}
+
+func (x VaultSecretEngine) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("\"%s\"", VaultSecretEngine_name[int32(x)])), nil
+}
+
+func (x *VaultSecretEngine) UnmarshalJSON(b []byte) error {
+	*x = VaultSecretEngine(VaultSecretEngine_value[string(b[1:len(b)-1])])
+	return nil
+}
+
+func (x UpdateStrategyType) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("\"%s\"", UpdateStrategyType_name[int32(x)])), nil
+}
+
+func (x *UpdateStrategyType) UnmarshalJSON(b []byte) error {
+	*x = UpdateStrategyType(UpdateStrategyType_value[string(b[1:len(b)-1])])
+	return nil
+}
+
+func (x SnapshotMode) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("\"%s\"", SnapshotMode_name[int32(x)])), nil
+}
+
+func (x *SnapshotMode) UnmarshalJSON(b []byte) error {
+	*x = SnapshotMode(SnapshotMode_value[string(b[1:len(b)-1])])
+	return nil
+}
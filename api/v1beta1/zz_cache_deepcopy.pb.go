@@ -0,0 +1,385 @@
+// Code generated by protoc-gen-deepcopy. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	proto "google.golang.org/protobuf/proto"
+)
+
+// DeepCopyInto supports using CacheSpec within kubernetes types, where deepcopy-gen is used.
+func (in *CacheSpec) DeepCopyInto(out *CacheSpec) {
+	p := proto.Clone(in).(*CacheSpec)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheSpec. Required by controller-gen.
+func (in *CacheSpec) DeepCopy() *CacheSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new CacheSpec. Required by controller-gen.
+func (in *CacheSpec) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using CacheDeploymentSpec within kubernetes types, where deepcopy-gen is used.
+func (in *CacheDeploymentSpec) DeepCopyInto(out *CacheDeploymentSpec) {
+	p := proto.Clone(in).(*CacheDeploymentSpec)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheDeploymentSpec. Required by controller-gen.
+func (in *CacheDeploymentSpec) DeepCopy() *CacheDeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheDeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new CacheDeploymentSpec. Required by controller-gen.
+func (in *CacheDeploymentSpec) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using CachePersistenceSpec within kubernetes types, where deepcopy-gen is used.
+func (in *CachePersistenceSpec) DeepCopyInto(out *CachePersistenceSpec) {
+	p := proto.Clone(in).(*CachePersistenceSpec)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachePersistenceSpec. Required by controller-gen.
+func (in *CachePersistenceSpec) DeepCopy() *CachePersistenceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CachePersistenceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new CachePersistenceSpec. Required by controller-gen.
+func (in *CachePersistenceSpec) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using UpdateStrategy within kubernetes types, where deepcopy-gen is used.
+func (in *UpdateStrategy) DeepCopyInto(out *UpdateStrategy) {
+	p := proto.Clone(in).(*UpdateStrategy)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateStrategy. Required by controller-gen.
+func (in *UpdateStrategy) DeepCopy() *UpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(UpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new UpdateStrategy. Required by controller-gen.
+func (in *UpdateStrategy) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using DBSyncerDeploymentSpec within kubernetes types, where deepcopy-gen is used.
+func (in *DBSyncerDeploymentSpec) DeepCopyInto(out *DBSyncerDeploymentSpec) {
+	p := proto.Clone(in).(*DBSyncerDeploymentSpec)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSyncerDeploymentSpec. Required by controller-gen.
+func (in *DBSyncerDeploymentSpec) DeepCopy() *DBSyncerDeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSyncerDeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new DBSyncerDeploymentSpec. Required by controller-gen.
+func (in *DBSyncerDeploymentSpec) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using DBSyncerAutoscalingSpec within kubernetes types, where deepcopy-gen is used.
+func (in *DBSyncerAutoscalingSpec) DeepCopyInto(out *DBSyncerAutoscalingSpec) {
+	p := proto.Clone(in).(*DBSyncerAutoscalingSpec)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSyncerAutoscalingSpec. Required by controller-gen.
+func (in *DBSyncerAutoscalingSpec) DeepCopy() *DBSyncerAutoscalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSyncerAutoscalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new DBSyncerAutoscalingSpec. Required by controller-gen.
+func (in *DBSyncerAutoscalingSpec) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using Logging within kubernetes types, where deepcopy-gen is used.
+func (in *Logging) DeepCopyInto(out *Logging) {
+	p := proto.Clone(in).(*Logging)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Logging. Required by controller-gen.
+func (in *Logging) DeepCopy() *Logging {
+	if in == nil {
+		return nil
+	}
+	out := new(Logging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Logging. Required by controller-gen.
+func (in *Logging) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using EnvVar within kubernetes types, where deepcopy-gen is used.
+func (in *EnvVar) DeepCopyInto(out *EnvVar) {
+	p := proto.Clone(in).(*EnvVar)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvVar. Required by controller-gen.
+func (in *EnvVar) DeepCopy() *EnvVar {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvVar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new EnvVar. Required by controller-gen.
+func (in *EnvVar) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using KeySelector within kubernetes types, where deepcopy-gen is used.
+func (in *KeySelector) DeepCopyInto(out *KeySelector) {
+	p := proto.Clone(in).(*KeySelector)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeySelector. Required by controller-gen.
+func (in *KeySelector) DeepCopy() *KeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(KeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new KeySelector. Required by controller-gen.
+func (in *KeySelector) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using EnvFromSource within kubernetes types, where deepcopy-gen is used.
+func (in *EnvFromSource) DeepCopyInto(out *EnvFromSource) {
+	p := proto.Clone(in).(*EnvFromSource)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvFromSource. Required by controller-gen.
+func (in *EnvFromSource) DeepCopy() *EnvFromSource {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvFromSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new EnvFromSource. Required by controller-gen.
+func (in *EnvFromSource) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using Resources within kubernetes types, where deepcopy-gen is used.
+func (in *Resources) DeepCopyInto(out *Resources) {
+	p := proto.Clone(in).(*Resources)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Resources. Required by controller-gen.
+func (in *Resources) DeepCopy() *Resources {
+	if in == nil {
+		return nil
+	}
+	out := new(Resources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Resources. Required by controller-gen.
+func (in *Resources) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using ResourceQuantity within kubernetes types, where deepcopy-gen is used.
+func (in *ResourceQuantity) DeepCopyInto(out *ResourceQuantity) {
+	p := proto.Clone(in).(*ResourceQuantity)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuantity. Required by controller-gen.
+func (in *ResourceQuantity) DeepCopy() *ResourceQuantity {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceQuantity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuantity. Required by controller-gen.
+func (in *ResourceQuantity) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using DataSourceSpec within kubernetes types, where deepcopy-gen is used.
+func (in *DataSourceSpec) DeepCopyInto(out *DataSourceSpec) {
+	p := proto.Clone(in).(*DataSourceSpec)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataSourceSpec. Required by controller-gen.
+func (in *DataSourceSpec) DeepCopy() *DataSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DataSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new DataSourceSpec. Required by controller-gen.
+func (in *DataSourceSpec) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using LocalObjectReference within kubernetes types, where deepcopy-gen is used.
+func (in *LocalObjectReference) DeepCopyInto(out *LocalObjectReference) {
+	p := proto.Clone(in).(*LocalObjectReference)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalObjectReference. Required by controller-gen.
+func (in *LocalObjectReference) DeepCopy() *LocalObjectReference {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalObjectReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new LocalObjectReference. Required by controller-gen.
+func (in *LocalObjectReference) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using ServiceRef within kubernetes types, where deepcopy-gen is used.
+func (in *ServiceRef) DeepCopyInto(out *ServiceRef) {
+	p := proto.Clone(in).(*ServiceRef)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceRef. Required by controller-gen.
+func (in *ServiceRef) DeepCopy() *ServiceRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new ServiceRef. Required by controller-gen.
+func (in *ServiceRef) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using NamespaceSelector within kubernetes types, where deepcopy-gen is used.
+func (in *NamespaceSelector) DeepCopyInto(out *NamespaceSelector) {
+	p := proto.Clone(in).(*NamespaceSelector)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceSelector. Required by controller-gen.
+func (in *NamespaceSelector) DeepCopy() *NamespaceSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceSelector. Required by controller-gen.
+func (in *NamespaceSelector) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using VaultSource within kubernetes types, where deepcopy-gen is used.
+func (in *VaultSource) DeepCopyInto(out *VaultSource) {
+	p := proto.Clone(in).(*VaultSource)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultSource. Required by controller-gen.
+func (in *VaultSource) DeepCopy() *VaultSource {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new VaultSource. Required by controller-gen.
+func (in *VaultSource) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using CacheConf within kubernetes types, where deepcopy-gen is used.
+func (in *CacheConf) DeepCopyInto(out *CacheConf) {
+	p := proto.Clone(in).(*CacheConf)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheConf. Required by controller-gen.
+func (in *CacheConf) DeepCopy() *CacheConf {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheConf)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new CacheConf. Required by controller-gen.
+func (in *CacheConf) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
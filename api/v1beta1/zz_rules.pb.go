@@ -0,0 +1,758 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.9
+// source: config/cache/v1beta1/rules.proto
+
+package v1beta1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:validation:Type=string
+// When an eager rule's table is snapshotted into the cache
+type SnapshotMode int32
+
+const (
+	SnapshotMode_INITIAL SnapshotMode = 0
+	SnapshotMode_NEVER   SnapshotMode = 1
+	SnapshotMode_ALWAYS  SnapshotMode = 2
+)
+
+// Enum value maps for SnapshotMode.
+var (
+	SnapshotMode_name = map[int32]string{
+		0: "INITIAL",
+		1: "NEVER",
+		2: "ALWAYS",
+	}
+	SnapshotMode_value = map[string]int32{
+		"INITIAL": 0,
+		"NEVER":   1,
+		"ALWAYS":  2,
+	}
+)
+
+func (x SnapshotMode) Enum() *SnapshotMode {
+	p := new(SnapshotMode)
+	*p = x
+	return p
+}
+
+func (x SnapshotMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SnapshotMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_cache_v1beta1_rules_proto_enumTypes[0].Descriptor()
+}
+
+func (SnapshotMode) Type() protoreflect.EnumType {
+	return &file_config_cache_v1beta1_rules_proto_enumTypes[0]
+}
+
+func (x SnapshotMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SnapshotMode.Descriptor instead.
+func (SnapshotMode) EnumDescriptor() ([]byte, []int) {
+	return file_config_cache_v1beta1_rules_proto_rawDescGZIP(), []int{0}
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:validation:Type=string
+// Supported format for the key of the cache entry
+type KeyFormat int32
+
+const (
+	KeyFormat_TEXT KeyFormat = 0
+	KeyFormat_JSON KeyFormat = 1
+)
+
+// Enum value maps for KeyFormat.
+var (
+	KeyFormat_name = map[int32]string{
+		0: "TEXT",
+		1: "JSON",
+	}
+	KeyFormat_value = map[string]int32{
+		"TEXT": 0,
+		"JSON": 1,
+	}
+)
+
+func (x KeyFormat) Enum() *KeyFormat {
+	p := new(KeyFormat)
+	*p = x
+	return p
+}
+
+func (x KeyFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KeyFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_cache_v1beta1_rules_proto_enumTypes[1].Descriptor()
+}
+
+func (KeyFormat) Type() protoreflect.EnumType {
+	return &file_config_cache_v1beta1_rules_proto_enumTypes[1]
+}
+
+func (x KeyFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KeyFormat.Descriptor instead.
+func (KeyFormat) EnumDescriptor() ([]byte, []int) {
+	return file_config_cache_v1beta1_rules_proto_rawDescGZIP(), []int{1}
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// Describes a caching rule behaviours
+type EagerCacheRuleSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Reference to the related Cache CR
+	CacheRef *NamespacedObjectReference `protobuf:"bytes,1,opt,name=cache_ref,json=cacheRef,proto3" json:"cacheRef,omitempty"`
+	// Name of the table from where the data will be produced. Format could change depending
+	// on the DB: table or schema.table must be at least supported
+	TableName string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"tableName,omitempty"`
+	// Format of the key for the get(key) operation
+	Key *EagerCacheKey `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// Query columns used to build the entry value
+	Value *Value `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	// Replication lag after which the rule is reported as Lagging, e.g. 30s. Defaults to 1m
+	LagThreshold string `protobuf:"bytes,5,opt,name=lag_threshold,json=lagThreshold,proto3" json:"lagThreshold,omitempty"`
+	// +kubebuilder:validation:Enum=INITIAL;NEVER;ALWAYS
+	// When the table is snapshotted into the cache. INITIAL snapshots the table when the rule is created, NEVER only
+	// replicates subsequent changes and ALWAYS snapshots the table whenever db-syncer starts. Defaults to INITIAL
+	SnapshotMode SnapshotMode `protobuf:"varint,6,opt,name=snapshot_mode,json=snapshotMode,proto3,enum=gingersnap.config.cache.v1beta1.SnapshotMode" json:"snapshotMode,omitempty"`
+	// Incrementing the generation requests that db-syncer re-snapshots the table, e.g. after a schema migration
+	ResyncGeneration int64 `protobuf:"varint,7,opt,name=resync_generation,json=resyncGeneration,proto3" json:"resyncGeneration,omitempty"`
+}
+
+func (x *EagerCacheRuleSpec) Reset() {
+	*x = EagerCacheRuleSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_cache_v1beta1_rules_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EagerCacheRuleSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EagerCacheRuleSpec) ProtoMessage() {}
+
+func (x *EagerCacheRuleSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_config_cache_v1beta1_rules_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EagerCacheRuleSpec.ProtoReflect.Descriptor instead.
+func (*EagerCacheRuleSpec) Descriptor() ([]byte, []int) {
+	return file_config_cache_v1beta1_rules_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EagerCacheRuleSpec) GetCacheRef() *NamespacedObjectReference {
+	if x != nil {
+		return x.CacheRef
+	}
+	return nil
+}
+
+func (x *EagerCacheRuleSpec) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *EagerCacheRuleSpec) GetKey() *EagerCacheKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *EagerCacheRuleSpec) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *EagerCacheRuleSpec) GetLagThreshold() string {
+	if x != nil {
+		return x.LagThreshold
+	}
+	return ""
+}
+
+func (x *EagerCacheRuleSpec) GetSnapshotMode() SnapshotMode {
+	if x != nil {
+		return x.SnapshotMode
+	}
+	return SnapshotMode_INITIAL
+}
+
+func (x *EagerCacheRuleSpec) GetResyncGeneration() int64 {
+	if x != nil {
+		return x.ResyncGeneration
+	}
+	return 0
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// Describes a caching rule behaviours
+type LazyCacheRuleSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Reference to the related Cache CR
+	CacheRef *NamespacedObjectReference `protobuf:"bytes,1,opt,name=cache_ref,json=cacheRef,proto3" json:"cacheRef,omitempty"`
+	// The select query needed to fetch values from the DB
+	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// Format of the key for the get(key) operation
+	Key *LazyCacheKey `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *LazyCacheRuleSpec) Reset() {
+	*x = LazyCacheRuleSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_cache_v1beta1_rules_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LazyCacheRuleSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LazyCacheRuleSpec) ProtoMessage() {}
+
+func (x *LazyCacheRuleSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_config_cache_v1beta1_rules_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LazyCacheRuleSpec.ProtoReflect.Descriptor instead.
+func (*LazyCacheRuleSpec) Descriptor() ([]byte, []int) {
+	return file_config_cache_v1beta1_rules_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LazyCacheRuleSpec) GetCacheRef() *NamespacedObjectReference {
+	if x != nil {
+		return x.CacheRef
+	}
+	return nil
+}
+
+func (x *LazyCacheRuleSpec) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *LazyCacheRuleSpec) GetKey() *LazyCacheKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// Describes how the key is build from the query result row
+type LazyCacheKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// +kubebuilder:validation:Enum=TEXT;JSON
+	// Format of the key for the get(key) operation
+	Format KeyFormat `protobuf:"varint,1,opt,name=format,proto3,enum=gingersnap.config.cache.v1beta1.KeyFormat" json:"format,omitempty"`
+	// Separator character in case of plain test key format
+	KeySeparator string `protobuf:"bytes,2,opt,name=key_separator,json=keySeparator,proto3" json:"keySeparator,omitempty"`
+}
+
+func (x *LazyCacheKey) Reset() {
+	*x = LazyCacheKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_cache_v1beta1_rules_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LazyCacheKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LazyCacheKey) ProtoMessage() {}
+
+func (x *LazyCacheKey) ProtoReflect() protoreflect.Message {
+	mi := &file_config_cache_v1beta1_rules_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LazyCacheKey.ProtoReflect.Descriptor instead.
+func (*LazyCacheKey) Descriptor() ([]byte, []int) {
+	return file_config_cache_v1beta1_rules_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LazyCacheKey) GetFormat() KeyFormat {
+	if x != nil {
+		return x.Format
+	}
+	return KeyFormat_TEXT
+}
+
+func (x *LazyCacheKey) GetKeySeparator() string {
+	if x != nil {
+		return x.KeySeparator
+	}
+	return ""
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// Describes how the key is build from the query result row
+type EagerCacheKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// +kubebuilder:validation:Enum=TEXT;JSON
+	// Format of the key for the get(key) operation
+	Format KeyFormat `protobuf:"varint,1,opt,name=format,proto3,enum=gingersnap.config.cache.v1beta1.KeyFormat" json:"format,omitempty"`
+	// Separator character in case of plain test key format
+	KeySeparator string `protobuf:"bytes,2,opt,name=key_separator,json=keySeparator,proto3" json:"keySeparator,omitempty"`
+	// Table columns composing the primary key
+	KeyColumns []string `protobuf:"bytes,3,rep,name=key_columns,json=keyColumns,proto3" json:"keyColumns,omitempty"`
+}
+
+func (x *EagerCacheKey) Reset() {
+	*x = EagerCacheKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_cache_v1beta1_rules_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EagerCacheKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EagerCacheKey) ProtoMessage() {}
+
+func (x *EagerCacheKey) ProtoReflect() protoreflect.Message {
+	mi := &file_config_cache_v1beta1_rules_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EagerCacheKey.ProtoReflect.Descriptor instead.
+func (*EagerCacheKey) Descriptor() ([]byte, []int) {
+	return file_config_cache_v1beta1_rules_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EagerCacheKey) GetFormat() KeyFormat {
+	if x != nil {
+		return x.Format
+	}
+	return KeyFormat_TEXT
+}
+
+func (x *EagerCacheKey) GetKeySeparator() string {
+	if x != nil {
+		return x.KeySeparator
+	}
+	return ""
+}
+
+func (x *EagerCacheKey) GetKeyColumns() []string {
+	if x != nil {
+		return x.KeyColumns
+	}
+	return nil
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// Describes how the entry value is build from the query result row
+type Value struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Table columns that will be fetched from the DB (select clause)
+	ValueColumns []string `protobuf:"bytes,1,rep,name=value_columns,json=valueColumns,proto3" json:"valueColumns,omitempty"`
+}
+
+func (x *Value) Reset() {
+	*x = Value{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_cache_v1beta1_rules_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value) ProtoMessage() {}
+
+func (x *Value) ProtoReflect() protoreflect.Message {
+	mi := &file_config_cache_v1beta1_rules_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value.ProtoReflect.Descriptor instead.
+func (*Value) Descriptor() ([]byte, []int) {
+	return file_config_cache_v1beta1_rules_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Value) GetValueColumns() []string {
+	if x != nil {
+		return x.ValueColumns
+	}
+	return nil
+}
+
+// A namespaced reference to a resource
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type NamespacedObjectReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Resource name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Resource namespace
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (x *NamespacedObjectReference) Reset() {
+	*x = NamespacedObjectReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_cache_v1beta1_rules_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamespacedObjectReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespacedObjectReference) ProtoMessage() {}
+
+func (x *NamespacedObjectReference) ProtoReflect() protoreflect.Message {
+	mi := &file_config_cache_v1beta1_rules_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespacedObjectReference.ProtoReflect.Descriptor instead.
+func (*NamespacedObjectReference) Descriptor() ([]byte, []int) {
+	return file_config_cache_v1beta1_rules_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NamespacedObjectReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NamespacedObjectReference) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+var File_config_cache_v1beta1_rules_proto protoreflect.FileDescriptor
+
+var file_config_cache_v1beta1_rules_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x1f, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x22, 0xb2, 0x03, 0x0a, 0x12, 0x45, 0x61, 0x67, 0x65, 0x72, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x57, 0x0a, 0x09, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e,
+	0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x52, 0x65, 0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x40, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2e, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x45, 0x61, 0x67, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4b, 0x65, 0x79, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x67, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x67, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x52, 0x0a, 0x0d, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d,
+	0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x72,
+	0x65, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc3, 0x01, 0x0a, 0x11, 0x4c, 0x61, 0x7a,
+	0x79, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x57,
+	0x0a, 0x09, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3a, 0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x3f, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x69, 0x6e,
+	0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x61, 0x7a,
+	0x79, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x77,
+	0x0a, 0x0c, 0x4c, 0x61, 0x7a, 0x79, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x42,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a,
+	0x2e, 0x67, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x4b, 0x65, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x53, 0x65,
+	0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x99, 0x01, 0x0a, 0x0d, 0x45, 0x61, 0x67, 0x65,
+	0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x42, 0x0a, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x67, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x53, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x65, 0x79, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x65, 0x79, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x73, 0x22, 0x2c, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x73, 0x22, 0x4d, 0x0a, 0x19, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x2a, 0x32, 0x0a, 0x0c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x09, 0x0a,
+	0x05, 0x4e, 0x45, 0x56, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x4c, 0x57, 0x41,
+	0x59, 0x53, 0x10, 0x02, 0x2a, 0x1f, 0x0a, 0x09, 0x4b, 0x65, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x45, 0x58, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4a,
+	0x53, 0x4f, 0x4e, 0x10, 0x01, 0x42, 0x31, 0x0a, 0x2d, 0x69, 0x6f, 0x2e, 0x67, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x73, 0x6e, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x50, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_config_cache_v1beta1_rules_proto_rawDescOnce sync.Once
+	file_config_cache_v1beta1_rules_proto_rawDescData = file_config_cache_v1beta1_rules_proto_rawDesc
+)
+
+func file_config_cache_v1beta1_rules_proto_rawDescGZIP() []byte {
+	file_config_cache_v1beta1_rules_proto_rawDescOnce.Do(func() {
+		file_config_cache_v1beta1_rules_proto_rawDescData = protoimpl.X.CompressGZIP(file_config_cache_v1beta1_rules_proto_rawDescData)
+	})
+	return file_config_cache_v1beta1_rules_proto_rawDescData
+}
+
+var file_config_cache_v1beta1_rules_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_config_cache_v1beta1_rules_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_config_cache_v1beta1_rules_proto_goTypes = []interface{}{
+	(SnapshotMode)(0),                 // 0: gingersnap.config.cache.v1beta1.SnapshotMode
+	(KeyFormat)(0),                    // 1: gingersnap.config.cache.v1beta1.KeyFormat
+	(*EagerCacheRuleSpec)(nil),        // 2: gingersnap.config.cache.v1beta1.EagerCacheRuleSpec
+	(*LazyCacheRuleSpec)(nil),         // 3: gingersnap.config.cache.v1beta1.LazyCacheRuleSpec
+	(*LazyCacheKey)(nil),              // 4: gingersnap.config.cache.v1beta1.LazyCacheKey
+	(*EagerCacheKey)(nil),             // 5: gingersnap.config.cache.v1beta1.EagerCacheKey
+	(*Value)(nil),                     // 6: gingersnap.config.cache.v1beta1.Value
+	(*NamespacedObjectReference)(nil), // 7: gingersnap.config.cache.v1beta1.NamespacedObjectReference
+}
+var file_config_cache_v1beta1_rules_proto_depIdxs = []int32{
+	7, // 0: gingersnap.config.cache.v1beta1.EagerCacheRuleSpec.cache_ref:type_name -> gingersnap.config.cache.v1beta1.NamespacedObjectReference
+	5, // 1: gingersnap.config.cache.v1beta1.EagerCacheRuleSpec.key:type_name -> gingersnap.config.cache.v1beta1.EagerCacheKey
+	6, // 2: gingersnap.config.cache.v1beta1.EagerCacheRuleSpec.value:type_name -> gingersnap.config.cache.v1beta1.Value
+	0, // 3: gingersnap.config.cache.v1beta1.EagerCacheRuleSpec.snapshot_mode:type_name -> gingersnap.config.cache.v1beta1.SnapshotMode
+	7, // 4: gingersnap.config.cache.v1beta1.LazyCacheRuleSpec.cache_ref:type_name -> gingersnap.config.cache.v1beta1.NamespacedObjectReference
+	4, // 5: gingersnap.config.cache.v1beta1.LazyCacheRuleSpec.key:type_name -> gingersnap.config.cache.v1beta1.LazyCacheKey
+	1, // 6: gingersnap.config.cache.v1beta1.LazyCacheKey.format:type_name -> gingersnap.config.cache.v1beta1.KeyFormat
+	1, // 7: gingersnap.config.cache.v1beta1.EagerCacheKey.format:type_name -> gingersnap.config.cache.v1beta1.KeyFormat
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_config_cache_v1beta1_rules_proto_init() }
+func file_config_cache_v1beta1_rules_proto_init() {
+	if File_config_cache_v1beta1_rules_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_config_cache_v1beta1_rules_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EagerCacheRuleSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_cache_v1beta1_rules_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LazyCacheRuleSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_cache_v1beta1_rules_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LazyCacheKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_cache_v1beta1_rules_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EagerCacheKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_cache_v1beta1_rules_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Value); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_cache_v1beta1_rules_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamespacedObjectReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_config_cache_v1beta1_rules_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_config_cache_v1beta1_rules_proto_goTypes,
+		DependencyIndexes: file_config_cache_v1beta1_rules_proto_depIdxs,
+		EnumInfos:         file_config_cache_v1beta1_rules_proto_enumTypes,
+		MessageInfos:      file_config_cache_v1beta1_rules_proto_msgTypes,
+	}.Build()
+	File_config_cache_v1beta1_rules_proto = out.File
+	file_config_cache_v1beta1_rules_proto_rawDesc = nil
+	file_config_cache_v1beta1_rules_proto_goTypes = nil
+	file_config_cache_v1beta1_rules_proto_depIdxs = nil
+}
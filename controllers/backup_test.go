@@ -0,0 +1,274 @@
+package controllers
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"time"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	"github.com/gingersnap-project/operator/pkg/backup"
+	backupfake "github.com/gingersnap-project/operator/pkg/backup/fake"
+	"github.com/gingersnap-project/operator/pkg/cachemanager"
+	"github.com/gingersnap-project/operator/pkg/cachemanager/fake"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Backup and restore", func() {
+
+	const namespace = "default"
+
+	var ctx context.Context
+	var s3 *backupfake.S3Server
+	var source, target *fake.Server
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		s3 = backupfake.NewS3Server("access")
+		source = fake.NewServer("us-east", "eu-west")
+		source.Put("us-east", "1", `{"name":"a"}`)
+		source.Put("us-east", "2", `{"name":"b"}`)
+		source.Put("eu-west", "3", `{"name":"c"}`)
+		target = fake.NewServer("us-east")
+	})
+
+	AfterEach(func() {
+		s3.Close()
+		source.Close()
+		target.Close()
+	})
+
+	// storage returns the location of an archive in the fake S3 server, creating the credentials Secret if necessary
+	storage := func(key string) v1alpha1.BackupStorage {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "s3-credentials", Namespace: namespace},
+			StringData: map[string]string{backup.AccessKeyIDKey: "access", backup.SecretAccessKeyKey: "secret"},
+		}
+		if err := k8sClient.Create(ctx, secret); !apierrors.IsAlreadyExists(err) {
+			Expect(err).NotTo(HaveOccurred())
+		}
+		return v1alpha1.BackupStorage{S3: &v1alpha1.S3Storage{
+			Endpoint:          s3.URL,
+			Bucket:            "backups",
+			Key:               key,
+			CredentialsSecret: secret.Name,
+		}}
+	}
+
+	// createCache creates a CLUSTER Cache whose cache-manager pod is served by the fake server
+	createCache := func(name string) *v1alpha1.Cache {
+		cache := &v1alpha1.Cache{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: v1alpha1.CacheSpec{
+				Deployment: &v1alpha1.CacheDeploymentSpec{Type: v1alpha1.CacheDeploymentType_CLUSTER},
+			},
+		}
+		Expect(k8sClient.Create(ctx, cache)).To(Succeed())
+
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name + "-0",
+				Namespace: namespace,
+				Labels:    map[string]string{"cache": name},
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "cache-manager", Image: "cache-manager"}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, pod)).To(Succeed())
+		pod.Status = corev1.PodStatus{
+			PodIP:      "127.0.0.1",
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+		}
+		Expect(k8sClient.Status().Update(ctx, pod)).To(Succeed())
+
+		deploymentType := v1alpha1.CacheDeploymentType_CLUSTER
+		cache.Status.DeploymentType = &deploymentType
+		cache.Status.Selector = "cache=" + name
+		Expect(k8sClient.Status().Update(ctx, cache)).To(Succeed())
+		return cache
+	}
+
+	setReady := func(cache *v1alpha1.Cache, rules ...string) {
+		cache.SetCondition(v1alpha1.CacheCondition{Type: v1alpha1.CacheConditionReady, Status: metav1.ConditionTrue})
+		cache.Status.AttachedRules = nil
+		for _, rule := range rules {
+			cache.Status.AttachedRules = append(cache.Status.AttachedRules, v1alpha1.AttachedRuleStatus{
+				Kind:      v1alpha1.KindLazyCacheRule,
+				Namespace: namespace,
+				Name:      rule,
+				Ready:     true,
+			})
+		}
+		Expect(k8sClient.Status().Update(ctx, cache)).To(Succeed())
+	}
+
+	reconcile := func(r interface {
+		Reconcile(context.Context, ctrl.Request) (ctrl.Result, error)
+	}, name string) ctrl.Result {
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}})
+		Expect(err).NotTo(HaveOccurred())
+		return result
+	}
+
+	port := func(s *fake.Server) *cachemanager.Client {
+		_, p := s.Host()
+		return &cachemanager.Client{Port: p}
+	}
+
+	// finished waits for the transfer running in the background to complete or fail
+	finished := func(obj client.Object, phase func() v1alpha1.TransferPhase) {
+		Eventually(func() bool {
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
+			return phase().Finished()
+		}, 10*time.Second, 50*time.Millisecond).Should(BeTrue())
+	}
+
+	It("should export the entries of a Cache and restore them into a new Cache", func() {
+		reconciler := &Reconciler{Client: k8sClient, Scheme: scheme.Scheme}
+		backupReconciler := &CacheBackupReconciler{Reconciler: reconciler, CacheManager: port(source)}
+		restoreReconciler := &CacheRestoreReconciler{Reconciler: reconciler, CacheManager: port(target)}
+
+		sourceCache := createCache("backup-source")
+		cacheBackup := &v1alpha1.CacheBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: namespace},
+			Spec: v1alpha1.CacheBackupSpec{
+				Cache:   sourceCache.Name,
+				Storage: storage(""),
+			},
+		}
+		Expect(k8sClient.Create(ctx, cacheBackup)).To(Succeed())
+
+		By("waiting for the Cache to become Ready")
+		Expect(reconcile(backupReconciler, cacheBackup.Name).RequeueAfter).To(Equal(transferPendingDelay))
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(cacheBackup), cacheBackup)).To(Succeed())
+		Expect(cacheBackup.Status.Phase).To(Equal(v1alpha1.TransferPending))
+		Expect(cacheBackup.Status.Message).To(Equal("Waiting for Cache 'default/backup-source' to become Ready"))
+
+		By("exporting the entries of every attached rule")
+		setReady(sourceCache, "us-east", "eu-west")
+		reconcile(backupReconciler, cacheBackup.Name)
+		finished(cacheBackup, func() v1alpha1.TransferPhase { return cacheBackup.Status.Phase })
+		status := cacheBackup.Status
+		Expect(status.Phase).To(Equal(v1alpha1.TransferCompleted), status.Message)
+		Expect(status.Rules).To(Equal([]string{"eu-west", "us-east"}))
+		Expect(*status.Progress).To(Equal(v1alpha1.TransferProgress{Rules: 2, TotalRules: 2, Entries: 3}))
+		Expect(status.Storage.S3.Key).To(Equal("default/backup.jsonl.gz"))
+		Expect(status.Checksum).To(HavePrefix("sha256:"))
+		Expect(status.CompletionTime).NotTo(BeNil())
+		archive, ok := s3.Object("backups", "default/backup.jsonl.gz")
+		Expect(ok).To(BeTrue())
+		Expect(status.Size).To(Equal(int64(len(archive))))
+
+		By("ignoring the completed backup")
+		Expect(reconcile(backupReconciler, cacheBackup.Name)).To(Equal(ctrl.Result{}))
+
+		targetCache := createCache("backup-target")
+		setReady(targetCache)
+		restore := &v1alpha1.CacheRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "restore", Namespace: namespace},
+			Spec: v1alpha1.CacheRestoreSpec{
+				Cache:  targetCache.Name,
+				Backup: cacheBackup.Name,
+				Rules:  []string{"us-east"},
+			},
+		}
+		Expect(k8sClient.Create(ctx, restore)).To(Succeed())
+
+		By("waiting for the rules to be attached to the new Cache")
+		Expect(reconcile(restoreReconciler, restore.Name).RequeueAfter).To(Equal(transferPendingDelay))
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(restore), restore)).To(Succeed())
+		Expect(restore.Status.Phase).To(Equal(v1alpha1.TransferPending))
+		Expect(restore.Status.Message).To(Equal("Waiting for the rules 'us-east' to be attached to the Cache and Ready"))
+
+		By("importing the entries of the restored rules")
+		setReady(targetCache, "us-east")
+		reconcile(restoreReconciler, restore.Name)
+		finished(restore, func() v1alpha1.TransferPhase { return restore.Status.Phase })
+		Expect(restore.Status.Phase).To(Equal(v1alpha1.TransferCompleted), restore.Status.Message)
+		Expect(*restore.Status.Progress).To(Equal(v1alpha1.TransferProgress{Rules: 1, TotalRules: 1, Entries: 2}))
+		Expect(restore.Status.Checksum).To(Equal(status.Checksum))
+		Expect(target.Entries("us-east")).To(Equal(source.Entries("us-east")))
+	})
+
+	It("should record the result of a backup whose resource is updated during the export", func() {
+		// The cache-manager requests are held until the CacheBackup has been updated by another writer
+		release := make(chan struct{})
+		held := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+			source.Config.Handler.ServeHTTP(w, r)
+		}))
+		defer held.Close()
+		_, p, _ := net.SplitHostPort(held.Listener.Addr().String())
+		heldPort, _ := strconv.Atoi(p)
+
+		reconciler := &Reconciler{Client: k8sClient, Scheme: scheme.Scheme}
+		backupReconciler := &CacheBackupReconciler{Reconciler: reconciler, CacheManager: &cachemanager.Client{Port: heldPort}}
+
+		sourceCache := createCache("backup-conflict")
+		setReady(sourceCache, "us-east")
+		cacheBackup := &v1alpha1.CacheBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: "backup-conflict", Namespace: namespace},
+			Spec: v1alpha1.CacheBackupSpec{
+				Cache:   sourceCache.Name,
+				Storage: storage("default/conflict.jsonl.gz"),
+			},
+		}
+		Expect(k8sClient.Create(ctx, cacheBackup)).To(Succeed())
+
+		reconcile(backupReconciler, cacheBackup.Name)
+		Expect(backupReconciler.transfers.started(cacheBackup)).To(BeTrue())
+
+		By("updating the CacheBackup whilst the entries are exported")
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(cacheBackup), cacheBackup)).To(Succeed())
+		cacheBackup.Labels = map[string]string{"updated": "true"}
+		Expect(k8sClient.Update(ctx, cacheBackup)).To(Succeed())
+		close(release)
+
+		finished(cacheBackup, func() v1alpha1.TransferPhase { return cacheBackup.Status.Phase })
+		Expect(cacheBackup.Status.Phase).To(Equal(v1alpha1.TransferCompleted), cacheBackup.Status.Message)
+		Expect(*cacheBackup.Status.Progress).To(Equal(v1alpha1.TransferProgress{Rules: 1, TotalRules: 1, Entries: 2}))
+		Expect(cacheBackup.Labels).To(HaveKeyWithValue("updated", "true"))
+
+		By("forgetting the finished transfer")
+		Eventually(func() bool {
+			return backupReconciler.transfers.started(cacheBackup)
+		}, 10*time.Second, 50*time.Millisecond).Should(BeFalse())
+	})
+
+	It("should fail to restore an invalid archive", func() {
+		reconciler := &Reconciler{Client: k8sClient, Scheme: scheme.Scheme}
+		restoreReconciler := &CacheRestoreReconciler{Reconciler: reconciler, CacheManager: port(target)}
+
+		targetCache := createCache("restore-invalid")
+		setReady(targetCache, "us-east")
+
+		corrupt := storage("default/corrupt.jsonl.gz")
+		s3.PutObject("backups", "default/corrupt.jsonl.gz", []byte("corrupt"))
+
+		restore := &v1alpha1.CacheRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "restore-corrupt", Namespace: namespace},
+			Spec: v1alpha1.CacheRestoreSpec{
+				Cache:   targetCache.Name,
+				Storage: &corrupt,
+			},
+		}
+		Expect(k8sClient.Create(ctx, restore)).To(Succeed())
+
+		reconcile(restoreReconciler, restore.Name)
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(restore), restore)).To(Succeed())
+		Expect(restore.Status.Phase).To(Equal(v1alpha1.TransferFailed))
+		Expect(restore.Status.Message).To(ContainSubstring("unable to read archive"))
+		Expect(target.Entries("us-east")).To(BeEmpty())
+	})
+})
@@ -6,36 +6,64 @@ import (
 	"strings"
 
 	"github.com/gingersnap-project/operator/api/v1alpha1"
+	"github.com/gingersnap-project/operator/pkg/config"
 	"github.com/gingersnap-project/operator/pkg/reconcile/cache"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 // CacheReconciler reconciles a Cache object
 type CacheReconciler struct {
 	*Reconciler
+	// MaxConcurrentReconciles the maximum number of concurrent Reconciles, 1 if not set
+	MaxConcurrentReconciles int
 }
 
-//+kubebuilder:rbac:groups=gingersnap-project.io,namespace=gingersnap-operator-system,resources=caches,verbs=create;delete;get;list;patch;update;watch
-//+kubebuilder:rbac:groups=gingersnap-project.io,namespace=gingersnap-operator-system,resources=caches/status,verbs=get;patch;update
-//+kubebuilder:rbac:groups=gingersnap-project.io,namespace=gingersnap-operator-system,resources=caches/finalizers,verbs=update
+//+kubebuilder:rbac:groups=gingersnap-project.io,resources=caches,verbs=create;delete;get;list;patch;update;watch
+//+kubebuilder:rbac:groups=gingersnap-project.io,resources=caches/status,verbs=get;patch;update
+//+kubebuilder:rbac:groups=gingersnap-project.io,resources=caches/finalizers,verbs=update
 
-// +kubebuilder:rbac:groups=apps,namespace=gingersnap-operator-system,resources=daemonsets,verbs=create;delete;deletecollection;get;list;patch;update;watch
-// +kubebuilder:rbac:groups=apps,namespace=gingersnap-operator-system,resources=deployments,verbs=create;delete;get;list;patch;update
-// +kubebuilder:rbac:groups=core,namespace=gingersnap-operator-system,resources=secrets;services;configmaps,verbs=create;delete;deletecollection;get;list;patch;update;watch
-// +kubebuilder:rbac:groups=core,namespace=gingersnap-operator-system,resources=serviceaccounts,verbs=create;patch
-// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,namespace=gingersnap-operator-system,resources=roles;rolebindings,verbs=create;patch;
+// +kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=create;delete;deletecollection;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=core,resources=secrets;services;configmaps,verbs=create;delete;deletecollection;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=create;get;patch
+// +kubebuilder:rbac:groups=core,resources=serviceaccounts/token,verbs=create
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=delete;get;list;watch
+// +kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=delete;list;watch
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles;rolebindings,verbs=create;get;patch;
 
-// +kubebuilder:rbac:groups=monitoring.coreos.com,namespace=gingersnap-operator-system,resources=servicemonitors,verbs=create;delete;get;list;patch;update;watch
-// +kubebuilder:rbac:groups=servicebinding.io,namespace=gingersnap-operator-system,resources=servicebindings,verbs=create;get;list;patch;watch
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=servicebinding.io,resources=servicebindings,verbs=create;delete;get;list;patch;watch
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
 
 // Reconcile the Cache resource
 func (r *CacheReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	reqLogger := log.FromContext(ctx)
 
+	// Resources are only reconciled once the operator configuration, e.g. the field manager, has been loaded
+	if !r.OperatorConfig.Loaded() {
+		return ctrl.Result{RequeueAfter: configPendingDelay}, nil
+	}
+
+	// The Cache is reconciled by the replica owning its shard
+	if !r.Shards.OwnsCache(req.Namespace, req.Name) {
+		return ctrl.Result{}, nil
+	}
+
 	instance := &v1alpha1.Cache{}
 	if err := r.Get(ctx, req.NamespacedName, instance); err != nil {
 		if errors.IsNotFound(err) {
@@ -52,14 +80,23 @@ func (r *CacheReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		return ctrl.Result{}, nil
 	}
 
+	if selected, err := r.NamespaceSelected(ctx, instance.Namespace); err != nil || !selected {
+		return ctrl.Result{}, err
+	}
+
 	ctxProvider := cache.NewContextProvider(
 		r.NewPipelineCtx(ctx, reqLogger, instance),
 	)
 
-	retry, delay, err := cache.PipelineBuilder(instance).
+	p, err := cache.PipelineBuilder().
+		WithBackoff(r.OperatorConfig.Backoff()).
 		WithContextProvider(ctxProvider).
-		Build().
-		Process(instance)
+		Build()
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to build %s pipeline: %w", v1alpha1.KindCache, err)
+	}
+
+	retry, delay, err := p.Process(instance)
 
 	reqLogger.Info("Done", "requeue", retry, "requeueAfter", delay, "error", err)
 	return ctrl.Result{Requeue: retry, RequeueAfter: delay}, err
@@ -74,11 +111,112 @@ func (r *CacheReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	if err := r.InitSupportedTypes(mgr); err != nil {
 		return err
 	}
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&v1alpha1.Cache{}).
+	if err := r.InitServiceAccounts(mgr); err != nil {
+		return err
+	}
+	watchLogger := ctrl.Log.WithName("cache-watches-log")
+	b := ctrl.NewControllerManagedBy(mgr).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		For(&v1alpha1.Cache{}, ignoreRetryAttempts).
 		Owns(&corev1.ConfigMap{}).
 		Owns(&corev1.Secret{}).
 		Owns(&appsv1.DaemonSet{}).
 		Owns(&appsv1.Deployment{}).
-		Complete(r)
+		Owns(&appsv1.StatefulSet{}).
+		// Data source credential Secrets are not owned by the Cache, but a change must roll out the cache-manager pods
+		Watches(
+			&source.Kind{
+				Type: &corev1.Secret{},
+			},
+			handler.EnqueueRequestsFromMapFunc(
+				func(a client.Object) []reconcile.Request {
+					var requests []reconcile.Request
+					list := &v1alpha1.CacheList{}
+					if err := r.Client.List(context.Background(), list, client.InNamespace(a.GetNamespace())); err != nil {
+						watchLogger.Error(err, "failed to list Caches", "namespace", a.GetNamespace())
+					}
+
+					for i := range list.Items {
+						item := &list.Items[i]
+						if item.Spec.DataSource == nil {
+							continue
+						}
+						if item.DataSourceSecret() == a.GetName() ||
+							(item.Status.Credentials != nil && item.Status.Credentials.SecretName == a.GetName()) {
+							requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: item.GetNamespace(), Name: item.GetName()}})
+						}
+					}
+					return requests
+				},
+			),
+		).
+		// Namespace labels determine whether the Caches in a namespace are selected by the NamespaceSelector
+		Watches(
+			&source.Kind{
+				Type: &corev1.Namespace{},
+			},
+			handler.EnqueueRequestsFromMapFunc(
+				func(a client.Object) []reconcile.Request {
+					var requests []reconcile.Request
+					list := &v1alpha1.CacheList{}
+					if err := r.Client.List(context.Background(), list, client.InNamespace(a.GetName())); err != nil {
+						watchLogger.Error(err, "failed to list Caches", "namespace", a.GetName())
+					}
+
+					for i := range list.Items {
+						item := &list.Items[i]
+						requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: item.GetNamespace(), Name: item.GetName()}})
+					}
+					return requests
+				},
+			),
+			builder.WithPredicates(predicate.LabelChangedPredicate{}),
+		).
+		// The Cache status aggregates the readiness of its attached rules
+		Watches(&source.Kind{Type: &v1alpha1.EagerCacheRule{}}, handler.EnqueueRequestsFromMapFunc(enqueueRuleCache), ruleAttachmentChanged).
+		Watches(&source.Kind{Type: &v1alpha1.LazyCacheRule{}}, handler.EnqueueRequestsFromMapFunc(enqueueRuleCache), ruleAttachmentChanged)
+	newList := func() client.ObjectList { return &v1alpha1.CacheList{} }
+	b = r.watchConfig(b, newList, config.ImagesChanged)
+	return r.watchShards(b, newList).Complete(r)
+}
+
+// ruleAttachmentChanged filters the rule update events to those which change the rule's readiness, the Cache it
+// references or mark it for deletion, as only these are reflected in the status of the Cache. The map function is
+// called with both the old and new rule, so a Cache that a rule is detached from is also enqueued
+var ruleAttachmentChanged = builder.WithPredicates(predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		if e.ObjectOld == nil || e.ObjectNew == nil {
+			return true
+		}
+		oldRef, oldReady := ruleAttachment(e.ObjectOld)
+		newRef, newReady := ruleAttachment(e.ObjectNew)
+		return oldReady != newReady ||
+			oldRef != newRef ||
+			e.ObjectOld.GetDeletionTimestamp().IsZero() != e.ObjectNew.GetDeletionTimestamp().IsZero()
+	},
+})
+
+// ruleAttachment returns the Cache referenced by a rule and whether the rule is Ready
+func ruleAttachment(a client.Object) (ref types.NamespacedName, ready bool) {
+	var cacheRef *v1alpha1.NamespacedObjectReference
+	switch r := a.(type) {
+	case *v1alpha1.EagerCacheRule:
+		cacheRef = r.Spec.CacheRef
+		ready = r.Condition(v1alpha1.EagerCacheRuleConditionReady).Status == metav1.ConditionTrue
+	case *v1alpha1.LazyCacheRule:
+		cacheRef = r.Spec.CacheRef
+		ready = r.Condition(v1alpha1.LazyCacheRuleConditionReady).Status == metav1.ConditionTrue
+	}
+	if cacheRef != nil {
+		ref = types.NamespacedName{Namespace: cacheRef.Namespace, Name: cacheRef.Name}
+	}
+	return
+}
+
+// enqueueRuleCache maps a rule to the Cache that it references
+func enqueueRuleCache(a client.Object) []reconcile.Request {
+	if ref, _ := ruleAttachment(a); ref.Name != "" {
+		return []reconcile.Request{{NamespacedName: ref}}
+	}
+	return nil
 }
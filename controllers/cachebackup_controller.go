@@ -0,0 +1,295 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	"github.com/gingersnap-project/operator/pkg/backup"
+	"github.com/gingersnap-project/operator/pkg/cachemanager"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// transferPendingDelay the delay before a pending backup or restore is retried
+const transferPendingDelay = 10 * time.Second
+
+// CacheBackupReconciler exports the entries of a Cache's rules to a backup archive via the cache-manager REST API
+type CacheBackupReconciler struct {
+	*Reconciler
+	// CacheManager the client of the cache-manager REST API, a client with the default configuration is used if nil
+	CacheManager *cachemanager.Client
+	transfers    transfers
+}
+
+//+kubebuilder:rbac:groups=gingersnap-project.io,resources=cachebackups,verbs=get;list;watch
+//+kubebuilder:rbac:groups=gingersnap-project.io,resources=cachebackups/status,verbs=get;patch;update
+
+// Reconcile CacheBackup resources. The entries are exported in the background once the Cache is Ready, the progress of
+// the backup being reported in its status. A backup interrupted by a restart of the operator, or by its Cache being
+// assigned to another replica, is started again
+func (r *CacheBackupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	reqLogger := log.FromContext(ctx)
+
+	instance := &v1alpha1.CacheBackup{}
+	if err := r.Get(ctx, req.NamespacedName, instance); err != nil {
+		if errors.IsNotFound(err) {
+			r.transfers.stop(req.NamespacedName)
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("unable to fetch CacheBackup CR %w", err)
+	}
+
+	if instance.Status.Phase.Finished() {
+		return ctrl.Result{}, nil
+	}
+
+	// The backup is performed by the replica that owns the Cache
+	if !r.Shards.OwnsCache(instance.Namespace, instance.Spec.Cache) {
+		r.transfers.stop(req.NamespacedName)
+		return ctrl.Result{}, nil
+	}
+	if r.transfers.started(instance) {
+		return ctrl.Result{}, nil
+	}
+	if selected, err := r.NamespaceSelected(ctx, instance.Namespace); err != nil || !selected {
+		return ctrl.Result{}, err
+	}
+
+	cache, hosts, msg, err := r.transferTarget(ctx, instance.CacheService())
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if msg != "" {
+		return r.backupPending(ctx, instance, msg)
+	}
+
+	rules := instance.Spec.Rules
+	if len(rules) == 0 {
+		rules = attachedRules(cache, false)
+	}
+	if len(rules) == 0 {
+		return r.backupPending(ctx, instance, "No rules are attached to the Cache")
+	}
+
+	storage := instance.ArchiveStorage()
+	store, err := r.store(ctx, storage, instance.Namespace)
+	if err != nil {
+		return r.backupFailed(ctx, instance, err)
+	}
+
+	reqLogger.Info("Exporting cache entries", "rules", rules, "storage", storage.String())
+	now := metav1.Now()
+	instance.Status = v1alpha1.CacheBackupStatus{
+		Phase:     v1alpha1.TransferInProgress,
+		Message:   fmt.Sprintf("Exporting the entries of %d rules to %s", len(rules), storage),
+		Rules:     rules,
+		Progress:  &v1alpha1.TransferProgress{TotalRules: int32(len(rules))},
+		Storage:   storage,
+		StartTime: &now,
+	}
+	if err := r.Status().Update(ctx, instance); err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to update CacheBackup status: %w", err)
+	}
+
+	r.transfers.start(ctx, instance, func(ctx context.Context) {
+		r.backup(ctx, instance, store, cache, hosts)
+	})
+	return ctrl.Result{}, nil
+}
+
+// backup exports the entries of the rules listed in the status of the CacheBackup, updating its status with the
+// progress and result. The status is left untouched if the backup is stopped
+func (r *CacheBackupReconciler) backup(ctx context.Context, instance *v1alpha1.CacheBackup, store backup.Store, cache *v1alpha1.Cache, hosts []string) {
+	reqLogger := log.FromContext(ctx)
+	// The status is tracked separately from instance, which is refreshed whenever the status is recorded
+	status := instance.Status.DeepCopy()
+	setStatus := func() { status.DeepCopyInto(&instance.Status) }
+	archive, err := r.export(ctx, store, cache, hosts, status.Rules, func(p backup.Progress) {
+		status.Progress.Rules = p.Rules
+		status.Progress.Entries = p.Entries
+		if err := r.updateTransferStatus(ctx, instance, setStatus); err != nil && ctx.Err() == nil {
+			reqLogger.Error(err, "unable to update CacheBackup progress")
+		}
+	})
+	if ctx.Err() != nil {
+		return
+	}
+
+	completed := metav1.Now()
+	status.CompletionTime = &completed
+	if err != nil {
+		reqLogger.Error(err, "CacheBackup failed")
+		status.Phase = v1alpha1.TransferFailed
+		status.Message = err.Error()
+	} else {
+		status.Phase = v1alpha1.TransferCompleted
+		status.Message = fmt.Sprintf("Exported %d entries to %s", archive.Entries, status.Storage)
+		status.Progress.Rules = int32(len(status.Rules))
+		status.Progress.Entries = archive.Entries
+		status.Size = archive.Size()
+		status.Checksum = archive.Checksum()
+	}
+	if err := r.updateTransferStatus(ctx, instance, setStatus); err != nil {
+		reqLogger.Error(err, "unable to update CacheBackup status, the backup is repeated on the next reconciliation")
+	}
+}
+
+// export writes the entries of the rules to the archive of the store, the archive is discarded if the export fails
+func (r *CacheBackupReconciler) export(ctx context.Context, store backup.Store, cache *v1alpha1.Cache, hosts, rules []string, progress func(backup.Progress)) (*backup.Writer, error) {
+	w, err := store.Writer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	archive, err := backup.NewWriter(w, backup.Header{Cache: cache.CacheService().String(), Rules: rules})
+	if err == nil {
+		err = backup.Export(ctx, cacheManagerClient(r.CacheManager), hosts, rules, archive, progress)
+	}
+	if err == nil {
+		err = archive.Close()
+	}
+	if err != nil {
+		w.Abort()
+		return nil, err
+	}
+	return archive, w.Commit()
+}
+
+// cacheManagerClient returns c, or a client with the default configuration if nil
+func cacheManagerClient(c *cachemanager.Client) *cachemanager.Client {
+	if c == nil {
+		return &cachemanager.Client{}
+	}
+	return c
+}
+
+func (r *CacheBackupReconciler) backupPending(ctx context.Context, instance *v1alpha1.CacheBackup, msg string) (ctrl.Result, error) {
+	if instance.Status.Phase != v1alpha1.TransferPending || instance.Status.Message != msg {
+		instance.Status.Phase = v1alpha1.TransferPending
+		instance.Status.Message = msg
+		if err := r.Status().Update(ctx, instance); err != nil {
+			return ctrl.Result{}, fmt.Errorf("unable to update CacheBackup status: %w", err)
+		}
+	}
+	return ctrl.Result{RequeueAfter: transferPendingDelay}, nil
+}
+
+func (r *CacheBackupReconciler) backupFailed(ctx context.Context, instance *v1alpha1.CacheBackup, cause error) (ctrl.Result, error) {
+	log.FromContext(ctx).Error(cause, "CacheBackup failed")
+	now := metav1.Now()
+	instance.Status.Phase = v1alpha1.TransferFailed
+	instance.Status.Message = cause.Error()
+	instance.Status.CompletionTime = &now
+	if err := r.Status().Update(ctx, instance); err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to update CacheBackup status: %w", err)
+	}
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CacheBackupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.CacheBackup{})
+	return r.watchShards(b, func() client.ObjectList { return &v1alpha1.CacheBackupList{} }).Complete(r)
+}
+
+// transferTarget returns the Cache and the hosts of its cache-manager pods that entries are transferred to or from.
+// The entries of a CLUSTER Cache are distributed across its pods, so a single host is returned, whereas every pod of a
+// LOCAL Cache caches its own entries. If the Cache is not ready for a transfer, a message explaining why is returned
+func (r *Reconciler) transferTarget(ctx context.Context, cacheService v1alpha1.CacheService) (*v1alpha1.Cache, []string, string, error) {
+	cache := &v1alpha1.Cache{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: cacheService.Namespace, Name: cacheService.Name}, cache); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil, fmt.Sprintf("Cache '%s' not found", cacheService), nil
+		}
+		return nil, nil, "", fmt.Errorf("unable to load Cache '%s': %w", cacheService, err)
+	}
+	if cache.Condition(v1alpha1.CacheConditionReady).Status != metav1.ConditionTrue {
+		return nil, nil, fmt.Sprintf("Waiting for Cache '%s' to become Ready", cacheService), nil
+	}
+
+	selector, err := labels.Parse(cache.Status.Selector)
+	if err != nil || selector.Empty() {
+		return nil, nil, fmt.Sprintf("Waiting for the cache-manager pods of Cache '%s'", cacheService), nil
+	}
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(cache.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, nil, "", fmt.Errorf("unable to list cache-manager pods: %w", err)
+	}
+
+	var hosts []string
+	for _, pod := range pods.Items {
+		if pod.Status.PodIP != "" && podReady(&pod) {
+			hosts = append(hosts, pod.Status.PodIP)
+		}
+	}
+	if len(hosts) == 0 {
+		return nil, nil, fmt.Sprintf("Waiting for the cache-manager pods of Cache '%s' to become Ready", cacheService), nil
+	}
+	sort.Strings(hosts)
+	if cache.ActiveDeploymentType() == v1alpha1.CacheDeploymentType_CLUSTER {
+		hosts = hosts[:1]
+	}
+	return cache, hosts, "", nil
+}
+
+// updateTransferStatus records the status of a resource whose transfer runs in the background. The status is set on
+// the latest revision of the resource, retrying on conflicts with the reconciliation of the resource, and is not
+// recorded if the resource has since been replaced
+func (r *Reconciler) updateTransferStatus(ctx context.Context, obj client.Object, setStatus func()) error {
+	uid := obj.GetUID()
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			return err
+		}
+		if obj.GetUID() != uid {
+			return nil
+		}
+		setStatus()
+		return r.Status().Update(ctx, obj)
+	})
+}
+
+// store returns the Store of the archive, loading the S3 credentials Secret from the namespace of the resource
+func (r *Reconciler) store(ctx context.Context, storage *v1alpha1.BackupStorage, namespace string) (backup.Store, error) {
+	var credentials *corev1.Secret
+	if s3 := storage.S3; s3 != nil {
+		credentials = &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: s3.CredentialsSecret}, credentials); err != nil {
+			return nil, fmt.Errorf("unable to load S3 credentials Secret '%s': %w", s3.CredentialsSecret, err)
+		}
+	}
+	return backup.NewStore(storage, credentials)
+}
+
+// attachedRules returns the sorted names of the rules attached to the Cache, optionally only those that are Ready. The
+// names are those of the rules' cache-manager configuration, see v1alpha1.CacheService.RuleKey
+func attachedRules(cache *v1alpha1.Cache, ready bool) []string {
+	var rules []string
+	cacheService := cache.CacheService()
+	for _, rule := range cache.Status.AttachedRules {
+		if !ready || rule.Ready {
+			rules = append(rules, cacheService.RuleKey(rule.Namespace, rule.Name))
+		}
+	}
+	sort.Strings(rules)
+	return rules
+}
+
+func podReady(pod *corev1.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,261 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	"github.com/gingersnap-project/operator/pkg/backup"
+	"github.com/gingersnap-project/operator/pkg/cachemanager"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// CacheRestoreReconciler imports the entries of a backup archive into a Cache via the cache-manager REST API
+type CacheRestoreReconciler struct {
+	*Reconciler
+	// CacheManager the client of the cache-manager REST API, a client with the default configuration is used if nil
+	CacheManager *cachemanager.Client
+	transfers    transfers
+}
+
+//+kubebuilder:rbac:groups=gingersnap-project.io,resources=cacherestores,verbs=get;list;watch
+//+kubebuilder:rbac:groups=gingersnap-project.io,resources=cacherestores/status,verbs=get;patch;update
+
+// Reconcile CacheRestore resources. The restore waits for the Cache to become Ready with the rules of the archive
+// attached, so that a new Cache can be restored as soon as it has been provisioned. The archive is verified before the
+// entries are imported in the background, the progress of the restore being reported in its status
+func (r *CacheRestoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	reqLogger := log.FromContext(ctx)
+
+	instance := &v1alpha1.CacheRestore{}
+	if err := r.Get(ctx, req.NamespacedName, instance); err != nil {
+		if errors.IsNotFound(err) {
+			r.transfers.stop(req.NamespacedName)
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("unable to fetch CacheRestore CR %w", err)
+	}
+
+	if instance.Status.Phase.Finished() {
+		return ctrl.Result{}, nil
+	}
+
+	// The restore is performed by the replica that owns the Cache
+	if !r.Shards.OwnsCache(instance.Namespace, instance.Spec.Cache) {
+		r.transfers.stop(req.NamespacedName)
+		return ctrl.Result{}, nil
+	}
+	if r.transfers.started(instance) {
+		return ctrl.Result{}, nil
+	}
+	if selected, err := r.NamespaceSelected(ctx, instance.Namespace); err != nil || !selected {
+		return ctrl.Result{}, err
+	}
+
+	storage, checksum, msg, err := r.source(ctx, instance)
+	if err != nil {
+		return r.restoreFailed(ctx, instance, err)
+	}
+	if msg != "" {
+		return r.restorePending(ctx, instance, msg)
+	}
+
+	cache, hosts, msg, err := r.transferTarget(ctx, instance.CacheService())
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if msg != "" {
+		return r.restorePending(ctx, instance, msg)
+	}
+
+	store, err := r.store(ctx, storage, instance.Namespace)
+	if err != nil {
+		return r.restoreFailed(ctx, instance, err)
+	}
+	header, err := readHeader(ctx, store)
+	if err != nil {
+		return r.restoreFailed(ctx, instance, err)
+	}
+
+	rules := instance.Spec.Rules
+	if len(rules) == 0 {
+		rules = header.Rules
+	}
+	if missing := missingRules(header.Rules, rules); len(missing) > 0 {
+		return r.restoreFailed(ctx, instance, fmt.Errorf("the archive does not contain the rules '%s'", strings.Join(missing, "', '")))
+	}
+	// cache-manager only accepts the entries of rules that have been defined
+	if missing := missingRules(attachedRules(cache, true), rules); len(missing) > 0 {
+		return r.restorePending(ctx, instance, fmt.Sprintf("Waiting for the rules '%s' to be attached to the Cache and Ready", strings.Join(missing, "', '")))
+	}
+
+	if checksum != "" {
+		if _, err := backup.Verify(ctx, store, checksum); err != nil {
+			return r.restoreFailed(ctx, instance, err)
+		}
+	}
+
+	reqLogger.Info("Importing cache entries", "rules", rules, "storage", storage.String())
+	now := metav1.Now()
+	instance.Status = v1alpha1.CacheRestoreStatus{
+		Phase:     v1alpha1.TransferInProgress,
+		Message:   fmt.Sprintf("Importing the entries of %d rules from %s", len(rules), storage),
+		Rules:     rules,
+		Progress:  &v1alpha1.TransferProgress{TotalRules: int32(len(rules))},
+		StartTime: &now,
+	}
+	if err := r.Status().Update(ctx, instance); err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to update CacheRestore status: %w", err)
+	}
+
+	r.transfers.start(ctx, instance, func(ctx context.Context) {
+		r.restore(ctx, instance, store, storage, hosts)
+	})
+	return ctrl.Result{}, nil
+}
+
+// restore imports the entries of the rules listed in the status of the CacheRestore, updating its status with the
+// progress and result. The status is left untouched if the restore is stopped
+func (r *CacheRestoreReconciler) restore(ctx context.Context, instance *v1alpha1.CacheRestore, store backup.Store, storage *v1alpha1.BackupStorage, hosts []string) {
+	reqLogger := log.FromContext(ctx)
+	// The status is tracked separately from instance, which is refreshed whenever the status is recorded
+	status := instance.Status.DeepCopy()
+	setStatus := func() { status.DeepCopyInto(&instance.Status) }
+	archive, err := r.importArchive(ctx, store, hosts, status.Rules, func(p backup.Progress) {
+		status.Progress.Rules = p.Rules
+		status.Progress.Entries = p.Entries
+		if err := r.updateTransferStatus(ctx, instance, setStatus); err != nil && ctx.Err() == nil {
+			reqLogger.Error(err, "unable to update CacheRestore progress")
+		}
+	})
+	if ctx.Err() != nil {
+		return
+	}
+
+	completed := metav1.Now()
+	status.CompletionTime = &completed
+	if err != nil {
+		reqLogger.Error(err, "CacheRestore failed")
+		status.Phase = v1alpha1.TransferFailed
+		status.Message = err.Error()
+	} else {
+		status.Phase = v1alpha1.TransferCompleted
+		status.Message = fmt.Sprintf("Imported %d entries from %s", status.Progress.Entries, storage)
+		status.Size = archive.Size()
+		status.Checksum = archive.Checksum()
+	}
+	if err := r.updateTransferStatus(ctx, instance, setStatus); err != nil {
+		reqLogger.Error(err, "unable to update CacheRestore status, the restore is repeated on the next reconciliation")
+	}
+}
+
+// source returns the location and expected checksum of the archive. If the archive of the CacheBackup is not yet
+// available, a message explaining why is returned
+func (r *CacheRestoreReconciler) source(ctx context.Context, instance *v1alpha1.CacheRestore) (*v1alpha1.BackupStorage, string, string, error) {
+	if instance.Spec.Storage != nil {
+		return instance.Spec.Storage, instance.Spec.Checksum, "", nil
+	}
+
+	cacheBackup := &v1alpha1.CacheBackup{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: instance.Spec.Backup}, cacheBackup); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, "", fmt.Sprintf("CacheBackup '%s' not found", instance.Spec.Backup), nil
+		}
+		return nil, "", "", fmt.Errorf("unable to load CacheBackup '%s': %w", instance.Spec.Backup, err)
+	}
+	switch cacheBackup.Status.Phase {
+	case v1alpha1.TransferCompleted:
+	case v1alpha1.TransferFailed:
+		return nil, "", "", fmt.Errorf("CacheBackup '%s' failed: %s", instance.Spec.Backup, cacheBackup.Status.Message)
+	default:
+		return nil, "", fmt.Sprintf("Waiting for CacheBackup '%s' to complete", instance.Spec.Backup), nil
+	}
+
+	checksum := instance.Spec.Checksum
+	if checksum == "" {
+		checksum = cacheBackup.Status.Checksum
+	}
+	return cacheBackup.Status.Storage, checksum, "", nil
+}
+
+// importArchive writes the entries of the rules to every host, returning the archive once all its entries have been read
+func (r *CacheRestoreReconciler) importArchive(ctx context.Context, store backup.Store, hosts, rules []string, progress func(backup.Progress)) (*backup.Reader, error) {
+	reader, err := store.Reader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	archive, err := backup.NewReader(reader)
+	if err != nil {
+		return nil, err
+	}
+	return archive, backup.Import(ctx, cacheManagerClient(r.CacheManager), hosts, rules, archive, progress)
+}
+
+func (r *CacheRestoreReconciler) restorePending(ctx context.Context, instance *v1alpha1.CacheRestore, msg string) (ctrl.Result, error) {
+	if instance.Status.Phase != v1alpha1.TransferPending || instance.Status.Message != msg {
+		instance.Status.Phase = v1alpha1.TransferPending
+		instance.Status.Message = msg
+		if err := r.Status().Update(ctx, instance); err != nil {
+			return ctrl.Result{}, fmt.Errorf("unable to update CacheRestore status: %w", err)
+		}
+	}
+	return ctrl.Result{RequeueAfter: transferPendingDelay}, nil
+}
+
+func (r *CacheRestoreReconciler) restoreFailed(ctx context.Context, instance *v1alpha1.CacheRestore, cause error) (ctrl.Result, error) {
+	log.FromContext(ctx).Error(cause, "CacheRestore failed")
+	now := metav1.Now()
+	instance.Status.Phase = v1alpha1.TransferFailed
+	instance.Status.Message = cause.Error()
+	instance.Status.CompletionTime = &now
+	if err := r.Status().Update(ctx, instance); err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to update CacheRestore status: %w", err)
+	}
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CacheRestoreReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.CacheRestore{})
+	return r.watchShards(b, func() client.ObjectList { return &v1alpha1.CacheRestoreList{} }).Complete(r)
+}
+
+// readHeader returns the header of the archive of the store
+func readHeader(ctx context.Context, store backup.Store) (*backup.Header, error) {
+	reader, err := store.Reader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	archive, err := backup.NewReader(reader)
+	if err != nil {
+		return nil, err
+	}
+	header := archive.Header()
+	return &header, nil
+}
+
+// missingRules returns the rules that are not contained in available
+func missingRules(available, rules []string) []string {
+	contained := make(map[string]struct{}, len(available))
+	for _, rule := range available {
+		contained[rule] = struct{}{}
+	}
+	var missing []string
+	for _, rule := range rules {
+		if _, ok := contained[rule]; !ok {
+			missing = append(missing, rule)
+		}
+	}
+	return missing
+}
@@ -5,17 +5,22 @@ import (
 	"fmt"
 
 	"github.com/gingersnap-project/operator/api/v1alpha1"
+	"github.com/gingersnap-project/operator/pkg/config"
+	"github.com/gingersnap-project/operator/pkg/reconcile/meta"
 	"github.com/gingersnap-project/operator/pkg/reconcile/pipeline"
 	"github.com/gingersnap-project/operator/pkg/reconcile/rule"
 	"github.com/gingersnap-project/operator/pkg/reconcile/rule/eager"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
@@ -25,16 +30,29 @@ import (
 // EagerCacheRuleReconciler reconciles a EagerCacheRule object
 type EagerCacheRuleReconciler struct {
 	*Reconciler
+	// MaxConcurrentReconciles the maximum number of concurrent Reconciles, 1 if not set
+	MaxConcurrentReconciles int
 }
 
-//+kubebuilder:rbac:groups=gingersnap-project.io,namespace=gingersnap-operator-system,resources=eagercacherules,verbs=get;list;watch;create;update;patch;delete
-//+kubebuilder:rbac:groups=gingersnap-project.io,namespace=gingersnap-operator-system,resources=eagercacherules/status,verbs=get;update;patch
-//+kubebuilder:rbac:groups=gingersnap-project.io,namespace=gingersnap-operator-system,resources=eagercacherules/finalizers,verbs=update
+//+kubebuilder:rbac:groups=gingersnap-project.io,resources=eagercacherules,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=gingersnap-project.io,resources=eagercacherules/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=gingersnap-project.io,resources=eagercacherules/finalizers,verbs=update
+//+kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
+//+kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=create;get;list;update;watch
+//+kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+//+kubebuilder:rbac:groups=batch,resources=jobs,verbs=create;delete;get;list;patch;watch
+//+kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=create;delete;get;list;patch;watch
+//+kubebuilder:rbac:groups=keda.sh,resources=scaledobjects,verbs=create;delete;get;list;patch;watch
 
 // Reconcile EagerCacheRule resources
 func (r *EagerCacheRuleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	reqLogger := log.FromContext(ctx)
 
+	// Resources are only reconciled once the operator configuration, e.g. the field manager, has been loaded
+	if !r.OperatorConfig.Loaded() {
+		return ctrl.Result{RequeueAfter: configPendingDelay}, nil
+	}
+
 	instance := &v1alpha1.EagerCacheRule{}
 	if err := r.Get(ctx, req.NamespacedName, instance); err != nil {
 		if errors.IsNotFound(err) {
@@ -45,6 +63,18 @@ func (r *EagerCacheRuleReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, fmt.Errorf("unable to fetch LazyCacheRule CR %w", err)
 	}
 
+	// Rules are reconciled by the replica owning the shard of their Cache
+	if !r.OwnsShard(instance) {
+		return ctrl.Result{}, nil
+	}
+
+	// Rules marked for deletion are always processed so that their finalizer is removed
+	if instance.GetDeletionTimestamp() == nil {
+		if selected, err := r.NamespaceSelected(ctx, instance.Namespace); err != nil || !selected {
+			return ctrl.Result{}, err
+		}
+	}
+
 	var pipelineBuilder *pipeline.Builder
 	if instance.GetDeletionTimestamp() != nil {
 		pipelineBuilder = eager.DeletePipelineBuilder()
@@ -52,14 +82,19 @@ func (r *EagerCacheRuleReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		pipelineBuilder = eager.PipelineBuilder()
 	}
 
-	retry, delay, err := pipelineBuilder.
+	p, err := pipelineBuilder.
+		WithBackoff(r.OperatorConfig.Backoff()).
 		WithContextProvider(
 			rule.NewContextProvider(
 				r.NewPipelineCtx(ctx, reqLogger, instance),
 			),
 		).
-		Build().
-		Process(instance)
+		Build()
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to build %s pipeline: %w", v1alpha1.KindEagerCacheRule, err)
+	}
+
+	retry, delay, err := p.Process(instance)
 
 	reqLogger.Info("Done", "requeue", retry, "requeueAfter", delay, "error", err)
 	return ctrl.Result{Requeue: retry, RequeueAfter: delay}, err
@@ -68,9 +103,26 @@ func (r *EagerCacheRuleReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 // SetupWithManager sets up the controller with the Manager.
 func (r *EagerCacheRuleReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
 	watchLogger := ctrl.Log.WithName("eager-watches-log")
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&gingersnapprojectv1alpha1.EagerCacheRule{}).
+	b := ctrl.NewControllerManagedBy(mgr).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		For(&gingersnapprojectv1alpha1.EagerCacheRule{}, ignoreStatusUpdates).
 		Owns(&corev1.ConfigMap{}).
+		// CDC preflight Jobs are created in the Cache namespace, so they are associated with their rule by label
+		Watches(
+			&source.Kind{
+				Type: &batchv1.Job{},
+			},
+			handler.EnqueueRequestsFromMapFunc(
+				func(a client.Object) []reconcile.Request {
+					labels := a.GetLabels()
+					name, ok := labels[meta.LabelRule]
+					if !ok {
+						return nil
+					}
+					return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: labels[meta.LabelRuleNamespace], Name: name}}}
+				},
+			),
+		).
 		Watches(
 			&source.Kind{
 				Type: &v1alpha1.Cache{},
@@ -80,13 +132,7 @@ func (r *EagerCacheRuleReconciler) SetupWithManager(ctx context.Context, mgr ctr
 					var requests []reconcile.Request
 					cache := a.(*v1alpha1.Cache)
 					list := &v1alpha1.EagerCacheRuleList{}
-					listOpts := &client.ListOptions{
-						LabelSelector: labels.SelectorFromSet(
-							cache.CacheService().LabelSelector(),
-						),
-					}
-
-					if err := r.Client.List(ctx, list, listOpts); err != nil {
+					if err := r.Client.List(ctx, list, cache.CacheService().MatchingRules()); err != nil {
 						watchLogger.Error(err, "failed to list Caches")
 					}
 
@@ -98,5 +144,29 @@ func (r *EagerCacheRuleReconciler) SetupWithManager(ctx context.Context, mgr ctr
 				},
 			),
 		).
-		Complete(r)
+		// Namespace labels may grant or revoke access to a Cache via spec.allowedRuleNamespaces
+		Watches(
+			&source.Kind{
+				Type: &corev1.Namespace{},
+			},
+			handler.EnqueueRequestsFromMapFunc(
+				func(a client.Object) []reconcile.Request {
+					var requests []reconcile.Request
+					list := &v1alpha1.EagerCacheRuleList{}
+					if err := r.Client.List(ctx, list, client.InNamespace(a.GetName())); err != nil {
+						watchLogger.Error(err, "failed to list EagerCacheRules", "namespace", a.GetName())
+					}
+
+					for i := range list.Items {
+						item := &list.Items[i]
+						requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: item.GetNamespace(), Name: item.GetName()}})
+					}
+					return requests
+				},
+			),
+			builder.WithPredicates(predicate.LabelChangedPredicate{}),
+		)
+	newList := func() client.ObjectList { return &v1alpha1.EagerCacheRuleList{} }
+	b = r.watchConfig(b, newList, config.ImagesChanged)
+	return r.watchShards(b, newList).Complete(r)
 }
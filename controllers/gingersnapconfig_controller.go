@@ -0,0 +1,189 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	"github.com/gingersnap-project/operator/pkg/kubernetes"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// GingersnapConfigReconciler reloads the operator configuration whenever the cluster-wide GingersnapConfig changes and
+// reports the resources defaulted by each GingersnapConfig in its status
+type GingersnapConfigReconciler struct {
+	*Reconciler
+}
+
+//+kubebuilder:rbac:groups=gingersnap-project.io,resources=gingersnapconfigs,verbs=get;list;watch
+//+kubebuilder:rbac:groups=gingersnap-project.io,resources=gingersnapconfigs/status,verbs=get;patch;update
+
+// Reconcile GingersnapConfig resources
+func (r *GingersnapConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	reqLogger := log.FromContext(ctx)
+	if req.Name != v1alpha1.GingersnapConfigName {
+		// Only the GingersnapConfig with the reserved name is honoured
+		return ctrl.Result{}, nil
+	}
+
+	instance := &v1alpha1.GingersnapConfig{}
+	if err := r.Get(ctx, req.NamespacedName, instance); err != nil {
+		if errors.IsNotFound(err) {
+			if req.Namespace == kubernetes.OperatorNamespace() {
+				reqLogger.Info("Cluster-wide GingersnapConfig not found, restoring operator defaults")
+				r.OperatorConfig.Update(nil)
+			}
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("unable to fetch GingersnapConfig CR %w", err)
+	}
+
+	if instance.ClusterWide() {
+		reqLogger.Info("Reloading operator configuration")
+		r.OperatorConfig.Update(instance.Spec.Operator)
+		if spec := instance.Spec.Operator; spec != nil && spec.FieldManager != "" && spec.FieldManager != r.OperatorConfig.FieldManager() {
+			reqLogger.Info("The field manager is only changed once the operator is restarted", "fieldManager", r.OperatorConfig.FieldManager())
+		}
+	}
+
+	// Every replica reloads the operator configuration, but only the coordinator records the status
+	if !r.Shards.Coordinator() {
+		return ctrl.Result{}, nil
+	}
+
+	appliedTo, err := r.appliedTo(ctx, instance)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !equality.Semantic.DeepEqual(appliedTo, instance.Status.AppliedTo) {
+		instance.Status.AppliedTo = appliedTo
+		if err := r.Status().Update(ctx, instance); err != nil {
+			return ctrl.Result{}, fmt.Errorf("unable to update GingersnapConfig status: %w", err)
+		}
+	}
+	return ctrl.Result{}, nil
+}
+
+// appliedTo returns the resources that record defaults merged from the GingersnapConfig, sorted by kind, namespace and
+// name
+func (r *GingersnapConfigReconciler) appliedTo(ctx context.Context, config *v1alpha1.GingersnapConfig) ([]v1alpha1.AppliedDefaultsStatus, error) {
+	var opts []client.ListOption
+	if !config.ClusterWide() {
+		opts = append(opts, client.InNamespace(config.Namespace))
+	}
+
+	caches := &v1alpha1.CacheList{}
+	if err := r.List(ctx, caches, opts...); err != nil {
+		return nil, fmt.Errorf("unable to list Caches: %w", err)
+	}
+	eagerRules := &v1alpha1.EagerCacheRuleList{}
+	if err := r.List(ctx, eagerRules, opts...); err != nil {
+		return nil, fmt.Errorf("unable to list EagerCacheRules: %w", err)
+	}
+
+	source := fmt.Sprintf("%s/%s", config.Namespace, config.Name)
+	var appliedTo []v1alpha1.AppliedDefaultsStatus
+	add := func(kind string, obj client.Object) {
+		if fields := v1alpha1.ParseAppliedDefaults(obj.GetAnnotations()).Fields(source); len(fields) > 0 {
+			appliedTo = append(appliedTo, v1alpha1.AppliedDefaultsStatus{
+				Kind:      kind,
+				Namespace: obj.GetNamespace(),
+				Name:      obj.GetName(),
+				Fields:    fields,
+			})
+		}
+	}
+	for i := range caches.Items {
+		add(v1alpha1.KindCache, &caches.Items[i])
+	}
+	for i := range eagerRules.Items {
+		add(v1alpha1.KindEagerCacheRule, &eagerRules.Items[i])
+	}
+
+	sort.Slice(appliedTo, func(i, j int) bool {
+		if appliedTo[i].Kind != appliedTo[j].Kind {
+			return appliedTo[i].Kind < appliedTo[j].Kind
+		}
+		if appliedTo[i].Namespace != appliedTo[j].Namespace {
+			return appliedTo[i].Namespace < appliedTo[j].Namespace
+		}
+		return appliedTo[i].Name < appliedTo[j].Name
+	})
+	return appliedTo, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *GingersnapConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// The GingersnapConfigs whose defaults were merged into a resource are recorded in its annotations
+	enqueueConfigs := handler.EnqueueRequestsFromMapFunc(
+		func(a client.Object) []reconcile.Request {
+			var requests []reconcile.Request
+			seen := map[string]struct{}{}
+			for _, config := range v1alpha1.ParseAppliedDefaults(a.GetAnnotations()) {
+				if _, ok := seen[config]; ok {
+					continue
+				}
+				seen[config] = struct{}{}
+				if namespace, name, ok := strings.Cut(config, "/"); ok {
+					requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}})
+				}
+			}
+			return requests
+		},
+	)
+
+	// The cluster-wide GingersnapConfig is reconciled on startup, so that the operator configuration is loaded even if
+	// the resource does not exist
+	initial := make(chan event.GenericEvent, 1)
+	if namespace := kubernetes.OperatorNamespace(); namespace != "" {
+		initial <- event.GenericEvent{Object: &v1alpha1.GingersnapConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.GingersnapConfigName, Namespace: namespace},
+		}}
+	} else {
+		r.OperatorConfig.Update(nil)
+	}
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.GingersnapConfig{}).
+		Watches(&source.Channel{Source: initial}, &handler.EnqueueRequestForObject{}).
+		Watches(&source.Kind{Type: &v1alpha1.Cache{}}, enqueueConfigs).
+		Watches(&source.Kind{Type: &v1alpha1.EagerCacheRule{}}, enqueueConfigs)
+
+	// The status of every GingersnapConfig must be recorded by a replica that becomes the coordinator
+	if r.Shards.Enabled() {
+		watchLogger := ctrl.Log.WithName("gingersnapconfig-watches-log")
+		b = b.Watches(
+			&source.Channel{Source: r.Shards.Subscribe()},
+			handler.EnqueueRequestsFromMapFunc(
+				func(_ client.Object) []reconcile.Request {
+					if !r.Shards.Coordinator() {
+						return nil
+					}
+					list := &v1alpha1.GingersnapConfigList{}
+					if err := r.List(context.Background(), list); err != nil {
+						watchLogger.Error(err, "failed to list GingersnapConfigs")
+						return nil
+					}
+					var requests []reconcile.Request
+					for i := range list.Items {
+						requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&list.Items[i])})
+					}
+					return requests
+				},
+			),
+		)
+	}
+	return b.Complete(r)
+}
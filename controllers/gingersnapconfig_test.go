@@ -0,0 +1,136 @@
+package controllers
+
+import (
+	"context"
+	"os"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	"github.com/gingersnap-project/operator/pkg/config"
+	"github.com/gingersnap-project/operator/pkg/images"
+	"github.com/gingersnap-project/operator/pkg/kubernetes"
+	kubernetesclient "github.com/gingersnap-project/operator/pkg/kubernetes/client"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+var _ = Describe("GingersnapConfig", func() {
+
+	const namespace = "gingersnap-config"
+
+	var ctx context.Context
+	var operatorConfig *config.Operator
+	var reconciler *Reconciler
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		Expect(os.Setenv(kubernetes.OperatorNamespaceEnvVar, namespace)).To(Succeed())
+
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}
+		if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(ns), ns); err != nil {
+			Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+		}
+
+		operatorConfig = &config.Operator{}
+		reconciler = &Reconciler{Client: k8sClient, Scheme: scheme.Scheme, OperatorConfig: operatorConfig}
+	})
+
+	AfterEach(func() {
+		Expect(os.Unsetenv(kubernetes.OperatorNamespaceEnvVar)).To(Succeed())
+		Expect(client.IgnoreNotFound(k8sClient.DeleteAllOf(ctx, &v1alpha1.GingersnapConfig{}, client.InNamespace(namespace)))).To(Succeed())
+		Expect(client.IgnoreNotFound(k8sClient.DeleteAllOf(ctx, &v1alpha1.Cache{}, client.InNamespace(namespace)))).To(Succeed())
+		images.Override(nil)
+	})
+
+	clusterWide := types.NamespacedName{Namespace: namespace, Name: v1alpha1.GingersnapConfigName}
+
+	reconcile := func() {
+		r := &GingersnapConfigReconciler{Reconciler: reconciler}
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: clusterWide})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	It("should defer reconciliations until the configuration has been loaded", func() {
+		cacheReconciler := &CacheReconciler{Reconciler: reconciler}
+		result, err := cacheReconciler.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: "cache"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(configPendingDelay))
+
+		By("loading the defaults when the cluster-wide GingersnapConfig does not exist")
+		reconcile()
+		Expect(operatorConfig.Loaded()).To(BeTrue())
+		Expect(operatorConfig.FieldManager()).To(Equal(kubernetesclient.DefaultFieldManager))
+
+		result, err = cacheReconciler.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: "cache"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{}))
+	})
+
+	It("should reload the configuration and keep the field manager of the first load", func() {
+		instance := &v1alpha1.GingersnapConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: clusterWide.Name, Namespace: clusterWide.Namespace},
+			Spec: v1alpha1.GingersnapConfigSpec{
+				Operator: &v1alpha1.OperatorConfig{FieldManager: "first"},
+			},
+		}
+		Expect(k8sClient.Create(ctx, instance)).To(Succeed())
+		imagesChanged := operatorConfig.Subscribe(config.ImagesChanged)
+
+		reconcile()
+		Expect(operatorConfig.FieldManager()).To(Equal("first"))
+		Expect(imagesChanged).NotTo(Receive())
+
+		instance.Spec.Operator = &v1alpha1.OperatorConfig{
+			FieldManager: "second",
+			Images:       &v1alpha1.ImagesConfig{DBSyncer: "db-syncer:custom"},
+		}
+		Expect(k8sClient.Update(ctx, instance)).To(Succeed())
+		reconcile()
+		Expect(operatorConfig.FieldManager()).To(Equal("first"))
+		Expect(images.DBSyncer()).To(Equal("db-syncer:custom"))
+		Expect(imagesChanged).To(Receive())
+	})
+
+	It("should enqueue the resources affected by a configuration change", func() {
+		for _, name := range []string{"idle", "retrying"} {
+			cache := &v1alpha1.Cache{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+				Spec: v1alpha1.CacheSpec{
+					Deployment: &v1alpha1.CacheDeploymentSpec{Type: v1alpha1.CacheDeploymentType_LOCAL},
+				},
+			}
+			Expect(k8sClient.Create(ctx, cache)).To(Succeed())
+			if name == "retrying" {
+				cache.SetRetryAttempts(2)
+				Expect(k8sClient.Status().Update(ctx, cache)).To(Succeed())
+			}
+		}
+
+		// enqueued returns the Caches of the namespace enqueued by the handler
+		newList := func() client.ObjectList { return &v1alpha1.CacheList{} }
+		enqueued := func(filter func(client.Object) bool) []string {
+			queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+			defer queue.ShutDown()
+			reconciler.enqueueOwned("test", newList, filter).Generic(event.GenericEvent{Object: &v1alpha1.GingersnapConfig{}}, queue)
+
+			var names []string
+			for queue.Len() > 0 {
+				item, _ := queue.Get()
+				if req := item.(ctrl.Request); req.Namespace == namespace {
+					names = append(names, req.Name)
+				}
+				queue.Done(item)
+			}
+			return names
+		}
+		Expect(enqueued(nil)).To(ConsistOf("idle", "retrying"))
+		Expect(enqueued(retrying)).To(ConsistOf("retrying"))
+	})
+})
@@ -10,12 +10,14 @@ import (
 	"github.com/gingersnap-project/operator/pkg/reconcile/rule/lazy"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
@@ -25,16 +27,24 @@ import (
 // LazyCacheRuleReconciler reconciles a LazyCacheRule object
 type LazyCacheRuleReconciler struct {
 	*Reconciler
+	// MaxConcurrentReconciles the maximum number of concurrent Reconciles, 1 if not set
+	MaxConcurrentReconciles int
 }
 
-//+kubebuilder:rbac:groups=gingersnap-project.io,namespace=gingersnap-operator-system,resources=lazycacherules,verbs=get;list;watch;create;update;patch;delete
-//+kubebuilder:rbac:groups=gingersnap-project.io,namespace=gingersnap-operator-system,resources=lazycacherules/status,verbs=get;update;patch
-//+kubebuilder:rbac:groups=gingersnap-project.io,namespace=gingersnap-operator-system,resources=lazycacherules/finalizers,verbs=update
+//+kubebuilder:rbac:groups=gingersnap-project.io,resources=lazycacherules,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=gingersnap-project.io,resources=lazycacherules/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=gingersnap-project.io,resources=lazycacherules/finalizers,verbs=update
+//+kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
 
 // Reconcile LazyCacheRule resources
 func (r *LazyCacheRuleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	reqLogger := log.FromContext(ctx)
 
+	// Resources are only reconciled once the operator configuration, e.g. the field manager, has been loaded
+	if !r.OperatorConfig.Loaded() {
+		return ctrl.Result{RequeueAfter: configPendingDelay}, nil
+	}
+
 	instance := &v1alpha1.LazyCacheRule{}
 	if err := r.Get(ctx, req.NamespacedName, instance); err != nil {
 		if errors.IsNotFound(err) {
@@ -45,6 +55,18 @@ func (r *LazyCacheRuleReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		return ctrl.Result{}, fmt.Errorf("unable to fetch LazyCacheRule CR %w", err)
 	}
 
+	// Rules are reconciled by the replica owning the shard of their Cache
+	if !r.OwnsShard(instance) {
+		return ctrl.Result{}, nil
+	}
+
+	// Rules marked for deletion are always processed so that their finalizer is removed
+	if instance.GetDeletionTimestamp() == nil {
+		if selected, err := r.NamespaceSelected(ctx, instance.Namespace); err != nil || !selected {
+			return ctrl.Result{}, err
+		}
+	}
+
 	var pipelineBuilder *pipeline.Builder
 	if instance.GetDeletionTimestamp() != nil {
 		pipelineBuilder = lazy.DeletePipelineBuilder()
@@ -52,14 +74,19 @@ func (r *LazyCacheRuleReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		pipelineBuilder = lazy.PipelineBuilder()
 	}
 
-	retry, delay, err := pipelineBuilder.
+	p, err := pipelineBuilder.
+		WithBackoff(r.OperatorConfig.Backoff()).
 		WithContextProvider(
 			rule.NewContextProvider(
 				r.NewPipelineCtx(ctx, reqLogger, instance),
 			),
 		).
-		Build().
-		Process(instance)
+		Build()
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to build %s pipeline: %w", v1alpha1.KindLazyCacheRule, err)
+	}
+
+	retry, delay, err := p.Process(instance)
 
 	reqLogger.Info("Done", "requeue", retry, "requeueAfter", delay, "error", err)
 	return ctrl.Result{Requeue: retry, RequeueAfter: delay}, err
@@ -68,8 +95,9 @@ func (r *LazyCacheRuleReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 // SetupWithManager sets up the controller with the Manager.
 func (r *LazyCacheRuleReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
 	watchLogger := ctrl.Log.WithName("lazy-watches-log")
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&gingersnapv1alpha1.LazyCacheRule{}).
+	b := ctrl.NewControllerManagedBy(mgr).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		For(&gingersnapv1alpha1.LazyCacheRule{}, ignoreRetryAttempts).
 		Owns(&corev1.ConfigMap{}).
 		Watches(
 			&source.Kind{
@@ -80,13 +108,7 @@ func (r *LazyCacheRuleReconciler) SetupWithManager(ctx context.Context, mgr ctrl
 					var requests []reconcile.Request
 					cache := a.(*v1alpha1.Cache)
 					list := &v1alpha1.LazyCacheRuleList{}
-					listOpts := &client.ListOptions{
-						LabelSelector: labels.SelectorFromSet(
-							cache.CacheService().LabelSelector(),
-						),
-					}
-
-					if err := r.Client.List(ctx, list, listOpts); err != nil {
+					if err := r.Client.List(ctx, list, cache.CacheService().MatchingRules()); err != nil {
 						watchLogger.Error(err, "failed to list Caches")
 					}
 
@@ -98,5 +120,29 @@ func (r *LazyCacheRuleReconciler) SetupWithManager(ctx context.Context, mgr ctrl
 				},
 			),
 		).
-		Complete(r)
+		// Namespace labels may grant or revoke access to a Cache via spec.allowedRuleNamespaces
+		Watches(
+			&source.Kind{
+				Type: &corev1.Namespace{},
+			},
+			handler.EnqueueRequestsFromMapFunc(
+				func(a client.Object) []reconcile.Request {
+					var requests []reconcile.Request
+					list := &v1alpha1.LazyCacheRuleList{}
+					if err := r.Client.List(ctx, list, client.InNamespace(a.GetName())); err != nil {
+						watchLogger.Error(err, "failed to list LazyCacheRules", "namespace", a.GetName())
+					}
+
+					for i := range list.Items {
+						item := &list.Items[i]
+						requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: item.GetNamespace(), Name: item.GetName()}})
+					}
+					return requests
+				},
+			),
+			builder.WithPredicates(predicate.LabelChangedPredicate{}),
+		)
+	newList := func() client.ObjectList { return &v1alpha1.LazyCacheRuleList{} }
+	b = r.watchConfig(b, newList, 0)
+	return r.watchShards(b, newList).Complete(r)
 }
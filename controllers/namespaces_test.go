@@ -0,0 +1,157 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	kubeClient "github.com/gingersnap-project/operator/pkg/kubernetes/client"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Watched namespaces", func() {
+
+	const cacheNamespace = "watch-cache"
+	ruleNamespaces := []string{cacheNamespace, "watch-rules", "watch-other"}
+	cacheService := v1alpha1.CacheService{Name: "cache", Namespace: cacheNamespace}
+
+	var ctx context.Context
+	var cancel context.CancelFunc
+
+	createIfAbsent := func(obj client.Object) {
+		if err := k8sClient.Create(ctx, obj); !apierrors.IsAlreadyExists(err) {
+			Expect(err).NotTo(HaveOccurred())
+		}
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		for _, ns := range ruleNamespaces {
+			namespace := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   ns,
+					Labels: map[string]string{"gingersnap": ns},
+				},
+			}
+			createIfAbsent(namespace)
+
+			rule := &v1alpha1.EagerCacheRule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "rule",
+					Namespace: ns,
+				},
+				Spec: v1alpha1.EagerCacheRuleSpec{
+					CacheRef: &v1alpha1.NamespacedObjectReference{
+						Name:      cacheService.Name,
+						Namespace: cacheService.Namespace,
+					},
+				},
+			}
+			createIfAbsent(rule)
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	// newReconciler returns a Reconciler whose client reads from a cache of the watched namespaces, configured as in
+	// main.go
+	newReconciler := func(watchNamespaces []string, selector labels.Selector) *Reconciler {
+		opts := cache.Options{Scheme: scheme.Scheme}
+		newCache := cache.New
+		switch len(watchNamespaces) {
+		case 0:
+		case 1:
+			opts.Namespace = watchNamespaces[0]
+		default:
+			newCache = cache.MultiNamespacedCacheBuilder(watchNamespaces)
+		}
+		informers, err := newCache(cfg, opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(v1alpha1.SetupCacheRefIndexes(ctx, informers)).To(Succeed())
+		go func() {
+			defer GinkgoRecover()
+			Expect(informers.Start(ctx)).To(Succeed())
+		}()
+		Expect(informers.WaitForCacheSync(ctx)).To(BeTrue())
+
+		cachedClient, err := client.NewDelegatingClient(client.NewDelegatingClientInput{
+			CacheReader: informers,
+			Client:      k8sClient,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return &Reconciler{
+			Client:            cachedClient,
+			Scheme:            scheme.Scheme,
+			WatchNamespaces:   watchNamespaces,
+			NamespaceSelector: selector,
+		}
+	}
+
+	// attachedRules lists the rules of the Cache as the Cache status and db-syncer removal handlers do
+	attachedRules := func(r *Reconciler) []string {
+		c := &kubeClient.Runtime{
+			Client:          r.Client,
+			Ctx:             ctx,
+			Namespace:       cacheNamespace,
+			Scheme:          r.Scheme,
+			WatchNamespaces: r.WatchNamespaces,
+		}
+		list := &v1alpha1.EagerCacheRuleList{}
+		Expect(c.List(nil, list, kubeClient.AllNamespaces, kubeClient.MatchingFields(cacheService.MatchingRules()))).To(Succeed())
+
+		var namespaces []string
+		for i := range list.Items {
+			namespaces = append(namespaces, list.Items[i].Namespace)
+		}
+		return namespaces
+	}
+
+	selected := func(r *Reconciler, namespace string) bool {
+		selected, err := r.NamespaceSelected(ctx, namespace)
+		Expect(err).NotTo(HaveOccurred())
+		return selected
+	}
+
+	It("should only reconcile the resources of a single namespace", func() {
+		r := newReconciler([]string{cacheNamespace}, nil)
+		Expect(attachedRules(r)).To(ConsistOf(cacheNamespace))
+		Expect(selected(r, cacheNamespace)).To(BeTrue())
+		Expect(selected(r, "watch-rules")).To(BeFalse())
+	})
+
+	It("should reconcile the resources of a list of namespaces", func() {
+		r := newReconciler([]string{cacheNamespace, "watch-rules"}, nil)
+		Expect(attachedRules(r)).To(ConsistOf(cacheNamespace, "watch-rules"))
+		Expect(selected(r, cacheNamespace)).To(BeTrue())
+		Expect(selected(r, "watch-rules")).To(BeTrue())
+		Expect(selected(r, "watch-other")).To(BeFalse())
+	})
+
+	It("should reconcile the resources of all namespaces", func() {
+		r := newReconciler(nil, nil)
+		Expect(attachedRules(r)).To(ConsistOf(ruleNamespaces))
+		for _, ns := range ruleNamespaces {
+			Expect(selected(r, ns)).To(BeTrue())
+		}
+	})
+
+	It("should reconcile the resources of namespaces matching the label selector", func() {
+		selector, err := labels.Parse("gingersnap in (watch-cache,watch-other)")
+		Expect(err).NotTo(HaveOccurred())
+
+		r := newReconciler(nil, selector)
+		Expect(selected(r, cacheNamespace)).To(BeTrue())
+		Expect(selected(r, "watch-rules")).To(BeFalse())
+		Expect(selected(r, "watch-other")).To(BeTrue())
+	})
+})
@@ -0,0 +1,36 @@
+package controllers
+
+import (
+	"github.com/gingersnap-project/operator/pkg/reconcile"
+	"github.com/gingersnap-project/operator/pkg/reconcile/cache"
+	"github.com/gingersnap-project/operator/pkg/reconcile/pipeline"
+	"github.com/gingersnap-project/operator/pkg/reconcile/rule/eager"
+	"github.com/gingersnap-project/operator/pkg/reconcile/rule/lazy"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pipelines", func() {
+
+	provider := reconcile.ContextProviderFunc(func(interface{}) (reconcile.Context, error) {
+		return nil, nil
+	})
+
+	It("should declare valid stages", func() {
+		for _, builder := range []*pipeline.Builder{
+			cache.PipelineBuilder(),
+			eager.PipelineBuilder(),
+			eager.DeletePipelineBuilder(),
+			lazy.PipelineBuilder(),
+			lazy.DeletePipelineBuilder(),
+		} {
+			_, err := builder.WithContextProvider(provider).Build()
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	It("should repair labels and load the Cache before applying rule resources", func() {
+		Expect(eager.PipelineBuilder().Stages()[:2]).To(Equal([]string{"RepairLabels", "LoadCache"}))
+		Expect(lazy.PipelineBuilder().Stages()[:2]).To(Equal([]string{"RepairLabels", "LoadCache"}))
+	})
+})
@@ -3,45 +3,116 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	"github.com/gingersnap-project/operator/pkg/config"
+	"github.com/gingersnap-project/operator/pkg/kubernetes"
 	"github.com/gingersnap-project/operator/pkg/kubernetes/client"
 	"github.com/gingersnap-project/operator/pkg/reconcile"
 	"github.com/gingersnap-project/operator/pkg/reconcile/pipeline"
+	"github.com/gingersnap-project/operator/pkg/sharding"
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/discovery"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	ctrlreconcile "sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
+// ignoreRetryAttempts filters the update events caused by a pipeline recording the retry attempts of the resource it
+// reconciles, so that a requeue with backoff is not immediately superseded by a watch event
+var ignoreRetryAttempts = builder.WithPredicates(reconcile.IgnoreRetryAttempts())
+
+// ignoreStatusUpdates filters the update events that only modify the status of the resource, such as a pipeline
+// recording replication progress or retry attempts, so that a status write does not immediately requeue the resource.
+// The generation is also incremented when the resource is marked for deletion
+var ignoreStatusUpdates = builder.WithPredicates(
+	predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{}, predicate.AnnotationChangedPredicate{}),
+)
+
+// configPendingDelay the delay before a resource reconciled before the operator configuration has been loaded is
+// retried
+const configPendingDelay = time.Second
+
 // Reconciler generic struct providing fields common to all reconciler structs
 type Reconciler struct {
 	runtimeClient.Client
 	Scheme *runtime.Scheme
 	record.EventRecorder
-	supportedTypes map[schema.GroupVersionKind]struct{}
+	// OperatorConfig the configuration of the cluster-wide GingersnapConfig, the operator defaults are used if nil
+	OperatorConfig *config.Operator
+	// WatchNamespaces the namespaces watched by the operator, all namespaces if empty
+	WatchNamespaces []string
+	// NamespaceSelector restricts the reconciled resources to those in namespaces with matching labels, the resources
+	// of every watched namespace are reconciled if nil
+	NamespaceSelector labels.Selector
+	// Shards the partitioning of Caches across the operator replicas, every Cache is reconciled if nil
+	Shards          *sharding.Shards
+	serviceAccounts corev1client.ServiceAccountsGetter
+	supportedTypes  map[schema.GroupVersionKind]struct{}
 }
 
 func (r *Reconciler) NewPipelineCtx(ctx context.Context, log logr.Logger, owner runtimeClient.Object) reconcile.Context {
 	return pipeline.NewContext(ctx, log, r.supportedTypes, &client.Runtime{
-		Client:        r.Client,
-		Ctx:           ctx,
-		EventRecorder: r.EventRecorder,
-		Namespace:     owner.GetNamespace(),
-		Owner:         owner,
-		Scheme:        r.Scheme,
+		Client:          r.Client,
+		Ctx:             ctx,
+		EventRecorder:   r.EventRecorder,
+		FieldManager:    r.OperatorConfig.FieldManager(),
+		Log:             log,
+		Namespace:       owner.GetNamespace(),
+		Owner:           owner,
+		Scheme:          r.Scheme,
+		ServiceAccounts: r.serviceAccounts,
+		WatchNamespaces: r.WatchNamespaces,
 	})
 }
 
+// NamespaceSelected returns true if the resources in the namespace should be reconciled according to the
+// WatchNamespaces and NamespaceSelector
+func (r *Reconciler) NamespaceSelected(ctx context.Context, namespace string) (bool, error) {
+	if !kubernetes.IsWatchedNamespace(r.WatchNamespaces, namespace) {
+		return false, nil
+	}
+	if r.NamespaceSelector == nil || r.NamespaceSelector.Empty() {
+		return true, nil
+	}
+	ns := &corev1.Namespace{}
+	if err := r.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		return false, fmt.Errorf("unable to load namespace '%s': %w", namespace, err)
+	}
+	return r.NamespaceSelector.Matches(labels.Set(ns.Labels)), nil
+}
+
+// InitServiceAccounts creates the client used to request the ServiceAccount tokens of reconciled resources, as the
+// controller-runtime client cannot create the TokenRequest subresource
+func (r *Reconciler) InitServiceAccounts(mgr ctrl.Manager) error {
+	serviceAccounts, err := corev1client.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return fmt.Errorf("unable to create ServiceAccount client: %w", err)
+	}
+	r.serviceAccounts = serviceAccounts
+	return nil
+}
+
 func (r *Reconciler) InitSupportedTypes(mgr ctrl.Manager) error {
 	discoveryClient, err := discovery.NewDiscoveryClientForConfig(mgr.GetConfig())
 	if err != nil {
 		return fmt.Errorf("unable to create discovery client to determine supported types: %w", err)
 	}
 
-	types := []schema.GroupVersionKind{reconcile.ServiceMonitorGVK}
+	types := []schema.GroupVersionKind{reconcile.ScaledObjectGVK, reconcile.ServiceMonitorGVK}
 	supportedTypes := make(map[schema.GroupVersionKind]struct{}, len(types))
 	for _, gvk := range types {
 		groupVersion := gvk.GroupVersion().String()
@@ -60,3 +131,88 @@ func (r *Reconciler) InitSupportedTypes(mgr ctrl.Manager) error {
 	r.supportedTypes = supportedTypes
 	return nil
 }
+
+// OwnsShard returns true if the replica owns the shard of the resource, which is determined by the Cache that the
+// resource belongs to
+func (r *Reconciler) OwnsShard(obj runtimeClient.Object) bool {
+	if !r.Shards.Enabled() {
+		return true
+	}
+	key := cacheKey(obj)
+	return r.Shards.OwnsCache(key.Namespace, key.Name)
+}
+
+// watchShards reconciles the resources of the list type owned by the replica whenever it acquires a shard
+func (r *Reconciler) watchShards(b *builder.Builder, newList func() runtimeClient.ObjectList) *builder.Builder {
+	if !r.Shards.Enabled() {
+		return b
+	}
+	return b.Watches(&source.Channel{Source: r.Shards.Subscribe()}, r.enqueueOwned("shard-watches-log", newList, nil))
+}
+
+// watchConfig reconciles the resources of the list type owned by the replica whenever a change to the operator
+// configuration affects them. All resources are reconciled on the given changes, whereas a change to the backoff only
+// reconciles the resources that are being retried
+func (r *Reconciler) watchConfig(b *builder.Builder, newList func() runtimeClient.ObjectList, changes config.Change) *builder.Builder {
+	if r.OperatorConfig == nil {
+		return b
+	}
+	if changes != 0 {
+		b = b.Watches(&source.Channel{Source: r.OperatorConfig.Subscribe(changes)}, r.enqueueOwned("config-watches-log", newList, nil))
+	}
+	return b.Watches(&source.Channel{Source: r.OperatorConfig.Subscribe(config.BackoffChanged)}, r.enqueueOwned("config-watches-log", newList, retrying))
+}
+
+// retrying returns true if the resource is being requeued with backoff
+func retrying(obj runtimeClient.Object) bool {
+	tracker, ok := obj.(reconcile.RetryTracker)
+	return ok && tracker.RetryAttempts() > 0
+}
+
+// enqueueOwned returns a handler enqueuing the resources of the list type owned by the replica that match the filter,
+// every owned resource if filter is nil
+func (r *Reconciler) enqueueOwned(logger string, newList func() runtimeClient.ObjectList, filter func(runtimeClient.Object) bool) handler.EventHandler {
+	watchLogger := ctrl.Log.WithName(logger)
+	return handler.EnqueueRequestsFromMapFunc(
+		func(_ runtimeClient.Object) []ctrlreconcile.Request {
+			list := newList()
+			if err := r.List(context.Background(), list); err != nil {
+				watchLogger.Error(err, "failed to list resources", "type", fmt.Sprintf("%T", list))
+				return nil
+			}
+			items, err := apimeta.ExtractList(list)
+			if err != nil {
+				watchLogger.Error(err, "failed to extract resources", "type", fmt.Sprintf("%T", list))
+				return nil
+			}
+
+			var requests []ctrlreconcile.Request
+			for _, item := range items {
+				if obj := item.(runtimeClient.Object); r.OwnsShard(obj) && (filter == nil || filter(obj)) {
+					requests = append(requests, ctrlreconcile.Request{NamespacedName: runtimeClient.ObjectKeyFromObject(obj)})
+				}
+			}
+			return requests
+		},
+	)
+}
+
+// cacheKey returns the namespaced name of the Cache that the resource belongs to, or the resource's own namespaced
+// name if it does not reference a Cache
+func cacheKey(obj runtimeClient.Object) types.NamespacedName {
+	var ref *v1alpha1.NamespacedObjectReference
+	switch o := obj.(type) {
+	case *v1alpha1.EagerCacheRule:
+		ref = o.Spec.CacheRef
+	case *v1alpha1.LazyCacheRule:
+		ref = o.Spec.CacheRef
+	case *v1alpha1.CacheBackup:
+		return types.NamespacedName{Namespace: o.Namespace, Name: o.Spec.Cache}
+	case *v1alpha1.CacheRestore:
+		return types.NamespacedName{Namespace: o.Namespace, Name: o.Spec.Cache}
+	}
+	if ref == nil {
+		return runtimeClient.ObjectKeyFromObject(obj)
+	}
+	return types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}
+}
@@ -0,0 +1,142 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	"github.com/gingersnap-project/operator/pkg/sharding"
+	"github.com/go-logr/logr"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Resources whose stored objects must be rewritten when the CRD storage version changes
+var storageMigrationResources = []string{"caches", "lazycacherules", "eagercacherules"}
+
+// StorageVersionMigrator rewrites all persisted Gingersnap resources in the current storage version of their CRD and
+// then prunes any obsolete versions from the CRD's status.storedVersions, so that older API versions can safely be
+// removed from the CRD in a later release.
+type StorageVersionMigrator struct {
+	Client runtimeClient.Client
+	Log    logr.Logger
+	// Shards the partitioning of Caches across the operator replicas. Leader election is disabled when sharding is
+	// enabled, so the migration is only performed by the coordinator
+	Shards *sharding.Shards
+	// WatchNamespaces the namespaces watched by the operator, all namespaces if empty. The objects of other namespaces
+	// may be stored in an obsolete version, so the storedVersions of the CRDs are only pruned when all namespaces are
+	// watched
+	WatchNamespaces []string
+}
+
+var _ manager.Runnable = &StorageVersionMigrator{}
+var _ manager.LeaderElectionRunnable = &StorageVersionMigrator{}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions/status,verbs=update
+
+func (m *StorageVersionMigrator) SetupWithManager(mgr ctrl.Manager) error {
+	if m.Log.GetSink() == nil {
+		m.Log = mgr.GetLogger().WithName("storage-version-migrator")
+	}
+	return mgr.Add(m)
+}
+
+func (m *StorageVersionMigrator) NeedLeaderElection() bool {
+	return true
+}
+
+// Start migrates each resource in turn. Failures are logged rather than returned so that an incomplete migration,
+// for example due to missing cluster-wide permissions, does not prevent the operator from starting.
+func (m *StorageVersionMigrator) Start(ctx context.Context) error {
+	if err := m.Shards.WaitForCoordinator(ctx); err != nil {
+		// The context was cancelled before the replica became the coordinator
+		return nil
+	}
+	for _, resource := range storageMigrationResources {
+		if err := m.Migrate(ctx, resource); err != nil {
+			m.Log.Error(err, "unable to migrate storage version", "resource", resource)
+		}
+	}
+	return nil
+}
+
+// Migrate rewrites all objects of the given resource in the CRD storage version
+func (m *StorageVersionMigrator) Migrate(ctx context.Context, resource string) error {
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	crdName := fmt.Sprintf("%s.%s", resource, v1alpha1.Group)
+	if err := m.Client.Get(ctx, runtimeClient.ObjectKey{Name: crdName}, crd); err != nil {
+		return fmt.Errorf("unable to retrieve CRD '%s': %w", crdName, err)
+	}
+
+	var storageVersion string
+	for _, v := range crd.Spec.Versions {
+		if v.Storage {
+			storageVersion = v.Name
+		}
+	}
+
+	if len(crd.Status.StoredVersions) == 1 && crd.Status.StoredVersions[0] == storageVersion {
+		return nil
+	}
+
+	m.Log.Info("migrating resources to storage version", "resource", resource, "storedVersions", crd.Status.StoredVersions, "storageVersion", storageVersion)
+	// Namespaced installations are not permitted to list resources cluster-wide
+	namespaces := m.WatchNamespaces
+	if len(namespaces) == 0 {
+		namespaces = []string{metav1.NamespaceAll}
+	}
+	for _, namespace := range namespaces {
+		if err := m.migrateNamespace(ctx, resource, storageVersion, crd.Spec.Names.ListKind, namespace); err != nil {
+			return err
+		}
+	}
+
+	if len(m.WatchNamespaces) > 0 {
+		m.Log.Info("storage version migration of the watched namespaces complete, storedVersions are only pruned by an operator watching all namespaces",
+			"resource", resource, "storageVersion", storageVersion, "namespaces", m.WatchNamespaces)
+		return nil
+	}
+
+	crd.Status.StoredVersions = []string{storageVersion}
+	if err := m.Client.Status().Update(ctx, crd); err != nil {
+		return fmt.Errorf("unable to update storedVersions of CRD '%s': %w", crdName, err)
+	}
+	m.Log.Info("storage version migration complete", "resource", resource, "storageVersion", storageVersion)
+	return nil
+}
+
+// migrateNamespace rewrites the objects of the given resource in the namespace, all namespaces if empty, in the storage
+// version
+func (m *StorageVersionMigrator) migrateNamespace(ctx context.Context, resource, storageVersion, listKind, namespace string) error {
+	list := &unstructured.UnstructuredList{}
+	list.SetAPIVersion(fmt.Sprintf("%s/%s", v1alpha1.Group, storageVersion))
+	list.SetKind(listKind)
+	if err := m.Client.List(ctx, list, runtimeClient.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("unable to list %s: %w", resource, err)
+	}
+
+	for i := range list.Items {
+		obj := &list.Items[i]
+		// An unmodified update is sufficient for the api-server to re-encode the object in the storage version
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			err := m.Client.Update(ctx, obj)
+			if errors.IsConflict(err) {
+				if getErr := m.Client.Get(ctx, runtimeClient.ObjectKeyFromObject(obj), obj); getErr != nil {
+					return getErr
+				}
+			}
+			return err
+		})
+		if runtimeClient.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("unable to migrate %s '%s/%s': %w", resource, obj.GetNamespace(), obj.GetName(), err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var _ = Describe("StorageVersionMigrator", func() {
+
+	const crdName = "caches.gingersnap-project.io"
+
+	It("should prune obsolete stored versions", func() {
+		ctx := context.Background()
+		cache := &v1alpha1.Cache{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "migrate",
+				Namespace: "default",
+			},
+			Spec: v1alpha1.CacheSpec{
+				DataSource: &v1alpha1.DataSourceSpec{
+					SecretRef: &v1alpha1.LocalObjectReference{Name: "secret"},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, cache)).To(Succeed())
+		defer func() {
+			Expect(k8sClient.Delete(ctx, cache)).To(Succeed())
+		}()
+
+		// Simulate objects having previously been persisted with the v1alpha1 storage version
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: crdName}, crd)).To(Succeed())
+		crd.Status.StoredVersions = []string{"v1alpha1", "v1beta1"}
+		Expect(k8sClient.Status().Update(ctx, crd)).To(Succeed())
+
+		migrator := &StorageVersionMigrator{
+			Client: k8sClient,
+			Log:    logf.Log.WithName("migrator"),
+		}
+		Expect(migrator.Migrate(ctx, "caches")).To(Succeed())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: crdName}, crd)).To(Succeed())
+		Expect(crd.Status.StoredVersions).To(Equal([]string{"v1beta1"}))
+
+		// The object must still be readable in all served versions
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(cache), cache)).To(Succeed())
+		Expect(cache.Spec.DataSource.SecretRef.Name).To(Equal("secret"))
+	})
+
+	It("should only migrate the watched namespaces of a namespaced installation", func() {
+		ctx := context.Background()
+		cache := &v1alpha1.Cache{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "migrate-namespaced",
+				Namespace: "default",
+			},
+			Spec: v1alpha1.CacheSpec{
+				DataSource: &v1alpha1.DataSourceSpec{
+					SecretRef: &v1alpha1.LocalObjectReference{Name: "secret"},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, cache)).To(Succeed())
+		defer func() {
+			Expect(k8sClient.Delete(ctx, cache)).To(Succeed())
+		}()
+
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: crdName}, crd)).To(Succeed())
+		crd.Status.StoredVersions = []string{"v1alpha1", "v1beta1"}
+		Expect(k8sClient.Status().Update(ctx, crd)).To(Succeed())
+
+		migrator := &StorageVersionMigrator{
+			Client:          k8sClient,
+			Log:             logf.Log.WithName("migrator"),
+			WatchNamespaces: []string{"default"},
+		}
+		Expect(migrator.Migrate(ctx, "caches")).To(Succeed())
+
+		// Other namespaces may contain objects stored in v1alpha1, so the stored versions are retained
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: crdName}, crd)).To(Succeed())
+		Expect(crd.Status.StoredVersions).To(Equal([]string{"v1alpha1", "v1beta1"}))
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(cache), cache)).To(Succeed())
+		Expect(cache.Spec.DataSource.SecretRef.Name).To(Equal("secret"))
+	})
+})
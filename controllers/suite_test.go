@@ -6,6 +6,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -16,6 +17,7 @@ import (
 
 	gingersnapprojectv1alpha1 "github.com/gingersnap-project/operator/api/v1alpha1"
 	gingersnapv1alpha1 "github.com/gingersnap-project/operator/api/v1alpha1"
+	gingersnapv1beta1 "github.com/gingersnap-project/operator/api/v1beta1"
 	//+kubebuilder:scaffold:imports
 )
 
@@ -55,6 +57,12 @@ var _ = BeforeSuite(func() {
 	err = gingersnapprojectv1alpha1.AddToScheme(scheme.Scheme)
 	Expect(err).NotTo(HaveOccurred())
 
+	err = gingersnapv1beta1.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = apiextensionsv1.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
+
 	//+kubebuilder:scaffold:scheme
 
 	k8sClient, err = client.New(cfg, client.Options{Scheme: scheme.Scheme})
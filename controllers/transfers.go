@@ -0,0 +1,79 @@
+package controllers
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// transfers tracks the backups and restores started by this operator replica. The entries are transferred in the
+// background, so that exporting or importing a large Cache does not block the controller, the progress of a transfer
+// being reported in the status of its resource
+type transfers struct {
+	mu      sync.Mutex
+	entries map[types.NamespacedName]*transfer
+}
+
+type transfer struct {
+	uid    types.UID
+	cancel context.CancelFunc
+}
+
+// started returns true if the transfer of the resource has been started by this replica and has not yet finished. A
+// transfer started for a previous resource of the same name is cancelled
+func (t *transfers) started(obj client.Object) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	key := client.ObjectKeyFromObject(obj)
+	if e, ok := t.entries[key]; ok {
+		if e.uid == obj.GetUID() {
+			return true
+		}
+		e.cancel()
+		delete(t.entries, key)
+	}
+	return false
+}
+
+// start runs the transfer of the resource in the background. The transfer outlives the reconciliation that started it,
+// its context is only cancelled once ctx is done, i.e. the operator stops, or the transfer is stopped. The transfer is
+// forgotten once fn returns, so that a resource whose result could not be recorded is transferred again
+func (t *transfers) start(ctx context.Context, obj client.Object, fn func(ctx context.Context)) {
+	ctx, cancel := context.WithCancel(ctx)
+	key := client.ObjectKeyFromObject(obj)
+	e := &transfer{uid: obj.GetUID(), cancel: cancel}
+	t.mu.Lock()
+	if t.entries == nil {
+		t.entries = map[types.NamespacedName]*transfer{}
+	}
+	t.entries[key] = e
+	t.mu.Unlock()
+
+	go func() {
+		defer t.finish(key, e)
+		fn(ctx)
+	}()
+}
+
+// finish removes the entry of a transfer that has returned, unless it has already been replaced
+func (t *transfers) finish(key types.NamespacedName, e *transfer) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	e.cancel()
+	if t.entries[key] == e {
+		delete(t.entries, key)
+	}
+}
+
+// stop cancels the transfer of the resource, e.g. as the resource has been deleted or its Cache is now owned by another
+// replica, which starts the transfer again
+func (t *transfers) stop(key types.NamespacedName) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if e, ok := t.entries[key]; ok {
+		e.cancel()
+		delete(t.entries, key)
+	}
+}
@@ -0,0 +1,115 @@
+#!/usr/bin/env bash
+# Builds the operator manifests for the namespaces watched by the operator, generating the RBAC of the install mode:
+#   ""           the operator namespace, see config/default
+#   "*"          all namespaces, see config/cluster-wide
+#   "ns1,ns2"    a list of namespaces, the manager-role is bound via a RoleBinding in each namespace and the admission
+#                webhooks are restricted to the listed namespaces
+set -o errexit
+set -o nounset
+set -o pipefail
+
+KUSTOMIZE=${1?kustomize binary is required}
+WATCH_NAMESPACE=${2:-}
+
+PREFIX=gingersnap-operator
+OPERATOR_NAMESPACE=${PREFIX}-system
+
+case "${WATCH_NAMESPACE}" in
+  "")
+    ${KUSTOMIZE} build config/default
+    exit
+    ;;
+  "*")
+    ${KUSTOMIZE} build config/cluster-wide
+    exit
+    ;;
+esac
+
+IFS=',' read -r -a NAMESPACES <<< "${WATCH_NAMESPACE// /}"
+
+OVERLAY=$(mktemp -d config/.watch-namespaces.XXXXXX)
+trap 'rm -rf "${OVERLAY}"' EXIT
+
+for ns in "${NAMESPACES[@]}"; do
+  # The operator namespace is already bound by config/rbac/role_binding.yaml
+  [[ "${ns}" == "${OPERATOR_NAMESPACE}" ]] && continue
+  cat >> "${OVERLAY}/manager_role_bindings.yaml" << EOF
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: RoleBinding
+metadata:
+  name: ${PREFIX}-manager-rolebinding
+  namespace: ${ns}
+  labels:
+    app.kubernetes.io/name: ${PREFIX}
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: ${PREFIX}-manager-role
+subjects:
+- kind: ServiceAccount
+  name: ${PREFIX}-controller-manager
+  namespace: ${OPERATOR_NAMESPACE}
+EOF
+done
+touch "${OVERLAY}/manager_role_bindings.yaml"
+
+cat > "${OVERLAY}/manager_watch_namespace_patch.yaml" << EOF
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: ${PREFIX}-controller-manager
+  namespace: ${OPERATOR_NAMESPACE}
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        env:
+        - name: WATCH_NAMESPACE
+          value: "$(IFS=','; echo "${NAMESPACES[*]}")"
+          valueFrom: null
+EOF
+
+# Restrict every admission webhook to the watched namespaces and the operator namespace, which contains the cluster-wide
+# GingersnapConfig, as the resources of other namespaces are not cached
+namespaceSelector() {
+  echo "  namespaceSelector:"
+  echo "    matchExpressions:"
+  echo "    - key: kubernetes.io/metadata.name"
+  echo "      operator: In"
+  echo "      values:"
+  echo "      - ${OPERATOR_NAMESPACE}"
+  for ns in "${NAMESPACES[@]}"; do
+    [[ "${ns}" == "${OPERATOR_NAMESPACE}" ]] && continue
+    echo "      - ${ns}"
+  done
+}
+for kind in MutatingWebhookConfiguration ValidatingWebhookConfiguration; do
+  if [[ ${kind} == Mutating* ]]; then name=mutating-webhook-configuration; else name=validating-webhook-configuration; fi
+  {
+    echo "apiVersion: admissionregistration.k8s.io/v1"
+    echo "kind: ${kind}"
+    echo "metadata:"
+    echo "  name: ${PREFIX}-${name}"
+    echo "webhooks:"
+    awk -v kind="${kind}" '/^kind:/ { current = $2; hooks = 0 } /^webhooks:/ { hooks = 1 } current == kind && hooks && /^  name: / { print $2 }' config/webhook/manifests.yaml |
+      while read -r webhook; do
+        echo "- name: ${webhook}"
+        namespaceSelector
+      done
+  } > "${OVERLAY}/${name}_patch.yaml"
+done
+
+cat > "${OVERLAY}/kustomization.yaml" << EOF
+resources:
+- ../default
+- manager_role_bindings.yaml
+
+patchesStrategicMerge:
+- manager_watch_namespace_patch.yaml
+- mutating-webhook-configuration_patch.yaml
+- validating-webhook-configuration_patch.yaml
+EOF
+
+${KUSTOMIZE} build "${OVERLAY}"
@@ -17,16 +17,24 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"flag"
 	"os"
-	"strings"
 
 	gingersnapprojectv1alpha1 "github.com/gingersnap-project/operator/api/v1alpha1"
 	gingersnapv1alpha1 "github.com/gingersnap-project/operator/api/v1alpha1"
+	gingersnapv1beta1 "github.com/gingersnap-project/operator/api/v1beta1"
 	"github.com/gingersnap-project/operator/controllers"
 	servicebinding "github.com/gingersnap-project/operator/pkg/apis/binding/v1beta1"
+	keda "github.com/gingersnap-project/operator/pkg/apis/keda/v1alpha1"
+	"github.com/gingersnap-project/operator/pkg/config"
 	"github.com/gingersnap-project/operator/pkg/kubernetes"
+	"github.com/gingersnap-project/operator/pkg/sharding"
+	"github.com/gingersnap-project/operator/pkg/tracing"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	uberzap "go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -50,6 +58,9 @@ func init() {
 	utilruntime.Must(monitoringv1.AddToScheme(scheme))
 	utilruntime.Must(gingersnapprojectv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(servicebinding.AddToScheme(scheme))
+	utilruntime.Must(keda.AddToScheme(scheme))
+	utilruntime.Must(gingersnapv1beta1.AddToScheme(scheme))
+	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
 	//+kubebuilder:scaffold:scheme
 }
 
@@ -57,27 +68,91 @@ func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
+	var tracingOpts tracing.Options
+	var maxConcurrentCaches, maxConcurrentEagerRules, maxConcurrentLazyRules int
+	var shardCount int
+	var shardKey string
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.StringVar(&tracingOpts.Exporter, "tracing-exporter", tracing.ExporterNone,
+		"The exporter used for reconciliation traces, one of 'none' or 'otlp'.")
+	flag.StringVar(&tracingOpts.Endpoint, "tracing-endpoint", "localhost:4317", "The address of the OTLP collector traces are exported to.")
+	flag.BoolVar(&tracingOpts.Insecure, "tracing-insecure", false, "Disable TLS when exporting traces to the OTLP collector.")
+	flag.Float64Var(&tracingOpts.SampleRatio, "tracing-sample-ratio", 1, "The fraction of reconciliations that are traced.")
+	flag.BoolVar(&gingersnapv1alpha1.CachePersistenceEnabled, "enable-cache-persistence", false,
+		"Allow Caches to configure spec.deployment.persistence. Experimental: cache-manager does not store its caches in the volumes yet.")
+	flag.IntVar(&maxConcurrentCaches, "max-concurrent-reconciles-cache", 1, "The maximum number of Caches reconciled concurrently.")
+	flag.IntVar(&maxConcurrentEagerRules, "max-concurrent-reconciles-eagercacherule", 1, "The maximum number of EagerCacheRules reconciled concurrently.")
+	flag.IntVar(&maxConcurrentLazyRules, "max-concurrent-reconciles-lazycacherule", 1, "The maximum number of LazyCacheRules reconciled concurrently.")
+	flag.IntVar(&shardCount, "shards", 1,
+		"The number of shards that Caches, and the rules attached to them, are partitioned into. "+
+			"Each shard is owned by one operator replica, coordinated via Leases in the operator namespace, and the shards are "+
+			"rebalanced whenever a replica joins or leaves. "+
+			"Sharding replaces leader election if greater than 1.")
+	flag.StringVar(&shardKey, "shard-key", sharding.KeyNamespace,
+		"The part of the Cache identity hashed to determine its shard, one of 'namespace' or 'name'.")
 	zapOpts := zap.Options{
 		Development: true,
 	}
 	zapOpts.BindFlags(flag.CommandLine)
 	flag.Parse()
 
+	// The log level is adjusted at runtime according to the cluster-wide GingersnapConfig
+	logLevel, ok := zapOpts.Level.(uberzap.AtomicLevel)
+	if !ok {
+		defaultLevel := zapcore.InfoLevel
+		if zapOpts.Development {
+			defaultLevel = zapcore.DebugLevel
+		}
+		logLevel = uberzap.NewAtomicLevelAt(defaultLevel)
+		zapOpts.Level = logLevel
+	}
+	operatorConfig := &config.Operator{
+		Level:        &logLevel,
+		DefaultLevel: logLevel.Level(),
+	}
+
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&zapOpts)))
 
 	setupLog.Info("initialising manager", "version", Version)
 
 	ctx := ctrl.SetupSignalHandler()
-	namespace, err := kubernetes.WatchNamespace()
+	tracingOpts.Version = Version
+	shutdownTracing, err := tracing.Setup(ctx, tracingOpts)
+	if err != nil {
+		setupLog.Error(err, "unable to configure tracing")
+		os.Exit(1)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			setupLog.Error(err, "unable to flush traces")
+		}
+	}()
+
+	namespaces, err := kubernetes.WatchNamespaces()
 	if err != nil {
 		setupLog.Error(err, "failed to get watch namespace")
 		os.Exit(1)
 	}
+	namespaceSelector, err := kubernetes.WatchNamespaceSelector()
+	if err != nil {
+		setupLog.Error(err, "failed to get watch namespace selector")
+		os.Exit(1)
+	}
+	setupLog.Info("watching namespaces", "namespaces", namespaces, "selector", namespaceSelector.String())
+
+	shards, err := sharding.New(ctrl.GetConfigOrDie(), shardCount, shardKey, kubernetes.OperatorNamespace())
+	if err != nil {
+		setupLog.Error(err, "unable to configure sharding")
+		os.Exit(1)
+	}
+	if shards.Enabled() && enableLeaderElection {
+		setupLog.Info("disabling leader election as every replica reconciles the shards it owns and the owner of the first shard coordinates the remaining work", "shards", shardCount)
+		enableLeaderElection = false
+	}
 
 	ctrlOpts := ctrl.Options{
 		Scheme:                 scheme,
@@ -87,10 +162,18 @@ func main() {
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "cb74f96c.org",
 	}
-	if strings.Contains(namespace, ",") {
-		ctrlOpts.NewCache = cache.MultiNamespacedCacheBuilder(strings.Split(namespace, ","))
-	} else {
-		ctrlOpts.Namespace = namespace
+	// All namespaces are cached if WATCH_NAMESPACE is empty. Otherwise the operator namespace is also cached, as it
+	// contains the cluster-wide GingersnapConfig, however its resources are only reconciled if it is watched
+	cacheNamespaces := namespaces
+	if operatorNamespace := kubernetes.OperatorNamespace(); operatorNamespace != "" && !kubernetes.IsWatchedNamespace(namespaces, operatorNamespace) {
+		cacheNamespaces = append([]string{operatorNamespace}, namespaces...)
+	}
+	switch len(cacheNamespaces) {
+	case 0:
+	case 1:
+		ctrlOpts.Namespace = cacheNamespaces[0]
+	default:
+		ctrlOpts.NewCache = cache.MultiNamespacedCacheBuilder(cacheNamespaces)
 	}
 
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrlOpts)
@@ -99,28 +182,60 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Rules are associated with a Cache via the spec.cacheRef field index by both the controllers and webhooks
+	if err = gingersnapv1alpha1.SetupCacheRefIndexes(ctx, mgr.GetFieldIndexer()); err != nil {
+		setupLog.Error(err, "unable to create field indexes")
+		os.Exit(1)
+	}
+
 	reconciler := &controllers.Reconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:            mgr.GetClient(),
+		Scheme:            mgr.GetScheme(),
+		OperatorConfig:    operatorConfig,
+		WatchNamespaces:   namespaces,
+		NamespaceSelector: namespaceSelector,
+		Shards:            shards,
+	}
+	if err = mgr.Add(shards); err != nil {
+		setupLog.Error(err, "unable to add shards to manager")
+		os.Exit(1)
 	}
 
-	if err = (&controllers.CacheReconciler{Reconciler: reconciler}).SetupWithManager(mgr); err != nil {
+	if err = (&controllers.CacheReconciler{Reconciler: reconciler, MaxConcurrentReconciles: maxConcurrentCaches}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Cache")
 		os.Exit(1)
 	}
-	if err = (&controllers.LazyCacheRuleReconciler{Reconciler: reconciler}).SetupWithManager(ctx, mgr); err != nil {
+	if err = (&controllers.LazyCacheRuleReconciler{Reconciler: reconciler, MaxConcurrentReconciles: maxConcurrentLazyRules}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "LazyCacheRule")
 		os.Exit(1)
 	}
-	if err = (&controllers.EagerCacheRuleReconciler{Reconciler: reconciler}).SetupWithManager(ctx, mgr); err != nil {
+	if err = (&controllers.EagerCacheRuleReconciler{Reconciler: reconciler, MaxConcurrentReconciles: maxConcurrentEagerRules}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "EagerCacheRule")
 		os.Exit(1)
 	}
+	if err = (&controllers.GingersnapConfigReconciler{Reconciler: reconciler}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "GingersnapConfig")
+		os.Exit(1)
+	}
+	if err = (&controllers.CacheBackupReconciler{Reconciler: reconciler}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "CacheBackup")
+		os.Exit(1)
+	}
+	if err = (&controllers.CacheRestoreReconciler{Reconciler: reconciler}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "CacheRestore")
+		os.Exit(1)
+	}
+	if err = (&controllers.StorageVersionMigrator{Client: mgr.GetClient(), Shards: shards, WatchNamespaces: namespaces}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create storage version migrator")
+		os.Exit(1)
+	}
 
 	if err = (&gingersnapv1alpha1.Cache{}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "Cache")
 		os.Exit(1)
 	}
+	gingersnapv1alpha1.RegisterCacheScaleValidatingWebhook(mgr)
+
 	if err = (&gingersnapv1alpha1.LazyCacheRule{}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "LazyCacheRule")
 		os.Exit(1)
@@ -132,6 +247,34 @@ func main() {
 		os.Exit(1)
 	}
 	gingersnapv1alpha1.RegisterEagerRuleValidatingWebhook(mgr)
+
+	if err = (&gingersnapv1alpha1.GingersnapConfig{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "GingersnapConfig")
+		os.Exit(1)
+	}
+	gingersnapv1alpha1.RegisterConfigDefaultingWebhook(mgr)
+
+	if err = (&gingersnapv1alpha1.CacheBackup{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "CacheBackup")
+		os.Exit(1)
+	}
+	if err = (&gingersnapv1alpha1.CacheRestore{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "CacheRestore")
+		os.Exit(1)
+	}
+
+	if err = (&gingersnapv1beta1.Cache{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "Cache", "version", "v1beta1")
+		os.Exit(1)
+	}
+	if err = (&gingersnapv1beta1.LazyCacheRule{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "LazyCacheRule", "version", "v1beta1")
+		os.Exit(1)
+	}
+	if err = (&gingersnapv1beta1.EagerCacheRule{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "EagerCacheRule", "version", "v1beta1")
+		os.Exit(1)
+	}
 	//+kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -0,0 +1,101 @@
+/*
+Copyright 2021 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScaledObjectsKind the Kind of the ScaledObject resource
+const ScaledObjectsKind = "ScaledObject"
+
+// ScaleTarget holds the reference to the scale target Object
+type ScaleTarget struct {
+	// Name of the scale target
+	Name string `json:"name"`
+	// APIVersion of the scale target. Defaults to apps/v1
+	APIVersion string `json:"apiVersion,omitempty"`
+	// Kind of the scale target. Defaults to Deployment
+	Kind string `json:"kind,omitempty"`
+}
+
+// ScaleTriggers reference the scaler that will be used
+type ScaleTriggers struct {
+	// Type of the scaler, e.g. prometheus or metrics-api
+	Type string `json:"type"`
+	// Name of the trigger, used to identify the metric exposed to the HPA
+	Name string `json:"name,omitempty"`
+	// Metadata the scaler specific configuration
+	Metadata map[string]string `json:"metadata"`
+	// MetricType the target type of the metric exposed to the HPA, one of AverageValue, Value or Utilization
+	MetricType autoscalingv2.MetricTargetType `json:"metricType,omitempty"`
+}
+
+// ScaledObjectSpec is the spec for a ScaledObject resource
+type ScaledObjectSpec struct {
+	// ScaleTargetRef the workload scaled by KEDA
+	ScaleTargetRef *ScaleTarget `json:"scaleTargetRef"`
+	// PollingInterval the interval, in seconds, at which each trigger is checked
+	PollingInterval *int32 `json:"pollingInterval,omitempty"`
+	// CooldownPeriod the period, in seconds, to wait after the last trigger reported active before scaling to zero
+	CooldownPeriod *int32 `json:"cooldownPeriod,omitempty"`
+	// MinReplicaCount the minimum number of replicas of the scale target
+	MinReplicaCount *int32 `json:"minReplicaCount,omitempty"`
+	// MaxReplicaCount the maximum number of replicas of the scale target
+	MaxReplicaCount *int32 `json:"maxReplicaCount,omitempty"`
+	// Triggers the scalers that determine the desired number of replicas
+	Triggers []ScaleTriggers `json:"triggers"`
+}
+
+// ScaledObjectStatus is the status for a ScaledObject resource
+type ScaledObjectStatus struct {
+	// ScaleTargetKind the kind of the scale target
+	ScaleTargetKind string `json:"scaleTargetKind,omitempty"`
+	// OriginalReplicaCount the number of replicas of the scale target before it was scaled by KEDA
+	OriginalReplicaCount *int32 `json:"originalReplicaCount,omitempty"`
+	// Conditions the conditions of this ScaledObject
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:subresource:status
+
+// ScaledObject is a specification for a ScaledObject resource
+type ScaledObject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ScaledObjectSpec   `json:"spec"`
+	Status ScaledObjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScaledObjectList is a list of ScaledObject resources
+type ScaledObjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ScaledObject `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ScaledObject{}, &ScaledObjectList{})
+}
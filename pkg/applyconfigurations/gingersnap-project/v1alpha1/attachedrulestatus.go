@@ -0,0 +1,50 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// AttachedRuleStatusApplyConfiguration represents an declarative configuration of the AttachedRuleStatus type for use
+// with apply.
+type AttachedRuleStatusApplyConfiguration struct {
+	Kind      *string `json:"kind,omitempty"`
+	Namespace *string `json:"namespace,omitempty"`
+	Name      *string `json:"name,omitempty"`
+	Ready     *bool   `json:"ready,omitempty"`
+}
+
+// AttachedRuleStatusApplyConfiguration constructs an declarative configuration of the AttachedRuleStatus type for use with
+// apply.
+func AttachedRuleStatus() *AttachedRuleStatusApplyConfiguration {
+	return &AttachedRuleStatusApplyConfiguration{}
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *AttachedRuleStatusApplyConfiguration) WithKind(value string) *AttachedRuleStatusApplyConfiguration {
+	b.Kind = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *AttachedRuleStatusApplyConfiguration) WithNamespace(value string) *AttachedRuleStatusApplyConfiguration {
+	b.Namespace = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *AttachedRuleStatusApplyConfiguration) WithName(value string) *AttachedRuleStatusApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithReady sets the Ready field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ready field is set to the value of the last call.
+func (b *AttachedRuleStatusApplyConfiguration) WithReady(value bool) *AttachedRuleStatusApplyConfiguration {
+	b.Ready = &value
+	return b
+}
@@ -9,9 +9,14 @@ import (
 // CacheDeploymentSpecApplyConfiguration represents an declarative configuration of the CacheDeploymentSpec type for use
 // with apply.
 type CacheDeploymentSpecApplyConfiguration struct {
-	Type      *v1alpha1.CacheDeploymentType `json:"type,omitempty"`
-	Resources *ResourcesApplyConfiguration  `json:"resources,omitempty"`
-	Replicas  *int32                        `json:"replicas,omitempty"`
+	Type           *v1alpha1.CacheDeploymentType           `json:"type,omitempty"`
+	Resources      *ResourcesApplyConfiguration            `json:"resources,omitempty"`
+	Replicas       *int32                                  `json:"replicas,omitempty"`
+	UpdateStrategy *UpdateStrategyApplyConfiguration       `json:"updateStrategy,omitempty"`
+	Logging        *LoggingApplyConfiguration              `json:"logging,omitempty"`
+	ExtraEnv       []*v1alpha1.EnvVar                      `json:"extraEnv,omitempty"`
+	EnvFrom        []*v1alpha1.EnvFromSource               `json:"envFrom,omitempty"`
+	Persistence    *CachePersistenceSpecApplyConfiguration `json:"persistence,omitempty"`
 }
 
 // CacheDeploymentSpecApplyConfiguration constructs an declarative configuration of the CacheDeploymentSpec type for use with
@@ -43,3 +48,53 @@ func (b *CacheDeploymentSpecApplyConfiguration) WithReplicas(value int32) *Cache
 	b.Replicas = &value
 	return b
 }
+
+// WithUpdateStrategy sets the UpdateStrategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpdateStrategy field is set to the value of the last call.
+func (b *CacheDeploymentSpecApplyConfiguration) WithUpdateStrategy(value *UpdateStrategyApplyConfiguration) *CacheDeploymentSpecApplyConfiguration {
+	b.UpdateStrategy = value
+	return b
+}
+
+// WithLogging sets the Logging field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Logging field is set to the value of the last call.
+func (b *CacheDeploymentSpecApplyConfiguration) WithLogging(value *LoggingApplyConfiguration) *CacheDeploymentSpecApplyConfiguration {
+	b.Logging = value
+	return b
+}
+
+// WithExtraEnv adds the given value to the ExtraEnv field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ExtraEnv field.
+func (b *CacheDeploymentSpecApplyConfiguration) WithExtraEnv(values ...**v1alpha1.EnvVar) *CacheDeploymentSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithExtraEnv")
+		}
+		b.ExtraEnv = append(b.ExtraEnv, *values[i])
+	}
+	return b
+}
+
+// WithEnvFrom adds the given value to the EnvFrom field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the EnvFrom field.
+func (b *CacheDeploymentSpecApplyConfiguration) WithEnvFrom(values ...**v1alpha1.EnvFromSource) *CacheDeploymentSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithEnvFrom")
+		}
+		b.EnvFrom = append(b.EnvFrom, *values[i])
+	}
+	return b
+}
+
+// WithPersistence sets the Persistence field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Persistence field is set to the value of the last call.
+func (b *CacheDeploymentSpecApplyConfiguration) WithPersistence(value *CachePersistenceSpecApplyConfiguration) *CacheDeploymentSpecApplyConfiguration {
+	b.Persistence = value
+	return b
+}
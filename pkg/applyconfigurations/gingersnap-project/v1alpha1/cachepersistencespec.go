@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// CachePersistenceSpecApplyConfiguration represents an declarative configuration of the CachePersistenceSpec type for use
+// with apply.
+type CachePersistenceSpecApplyConfiguration struct {
+	Size             *string `json:"size,omitempty"`
+	StorageClassName *string `json:"storageClassName,omitempty"`
+}
+
+// CachePersistenceSpecApplyConfiguration constructs an declarative configuration of the CachePersistenceSpec type for use with
+// apply.
+func CachePersistenceSpec() *CachePersistenceSpecApplyConfiguration {
+	return &CachePersistenceSpecApplyConfiguration{}
+}
+
+// WithSize sets the Size field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Size field is set to the value of the last call.
+func (b *CachePersistenceSpecApplyConfiguration) WithSize(value string) *CachePersistenceSpecApplyConfiguration {
+	b.Size = &value
+	return b
+}
+
+// WithStorageClassName sets the StorageClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StorageClassName field is set to the value of the last call.
+func (b *CachePersistenceSpecApplyConfiguration) WithStorageClassName(value string) *CachePersistenceSpecApplyConfiguration {
+	b.StorageClassName = &value
+	return b
+}
@@ -5,9 +5,10 @@ package v1alpha1
 // CacheSpecApplyConfiguration represents an declarative configuration of the CacheSpec type for use
 // with apply.
 type CacheSpecApplyConfiguration struct {
-	Deployment *CacheDeploymentSpecApplyConfiguration    `json:"deployment,omitempty"`
-	DbSyncer   *DBSyncerDeploymentSpecApplyConfiguration `json:"dbSyncer,omitempty"`
-	DataSource *DataSourceSpecApplyConfiguration         `json:"dataSource,omitempty"`
+	Deployment            *CacheDeploymentSpecApplyConfiguration    `json:"deployment,omitempty"`
+	DbSyncer              *DBSyncerDeploymentSpecApplyConfiguration `json:"dbSyncer,omitempty"`
+	DataSource            *DataSourceSpecApplyConfiguration         `json:"dataSource,omitempty"`
+	AllowedRuleNamespaces *NamespaceSelectorApplyConfiguration      `json:"allowedRuleNamespaces,omitempty"`
 }
 
 // CacheSpecApplyConfiguration constructs an declarative configuration of the CacheSpec type for use with
@@ -39,3 +40,11 @@ func (b *CacheSpecApplyConfiguration) WithDataSource(value *DataSourceSpecApplyC
 	b.DataSource = value
 	return b
 }
+
+// WithAllowedRuleNamespaces sets the AllowedRuleNamespaces field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AllowedRuleNamespaces field is set to the value of the last call.
+func (b *CacheSpecApplyConfiguration) WithAllowedRuleNamespaces(value *NamespaceSelectorApplyConfiguration) *CacheSpecApplyConfiguration {
+	b.AllowedRuleNamespaces = value
+	return b
+}
@@ -2,11 +2,28 @@
 
 package v1alpha1
 
+import (
+	cachev1alpha1 "github.com/gingersnap-project/operator/api/v1alpha1"
+)
+
 // CacheStatusApplyConfiguration represents an declarative configuration of the CacheStatus type for use
 // with apply.
 type CacheStatusApplyConfiguration struct {
-	Conditions     []CacheConditionApplyConfiguration `json:"conditions,omitempty"`
-	ServiceBinding *ServiceBindingApplyConfiguration  `json:"binding,omitempty"`
+	Conditions     []CacheConditionApplyConfiguration     `json:"conditions,omitempty"`
+	ServiceBinding *ServiceBindingApplyConfiguration      `json:"binding,omitempty"`
+	Credentials    *CredentialsStatusApplyConfiguration   `json:"credentials,omitempty"`
+	Rollout        *RolloutStatusApplyConfiguration       `json:"rollout,omitempty"`
+	DeploymentType *cachev1alpha1.CacheDeploymentType     `json:"deploymentType,omitempty"`
+	Workload       *string                                `json:"workload,omitempty"`
+	RetryAttempts  *int32                                 `json:"retryAttempts,omitempty"`
+	Replicas       *int32                                 `json:"replicas,omitempty"`
+	ReadyReplicas  *int32                                 `json:"readyReplicas,omitempty"`
+	Selector       *string                                `json:"selector,omitempty"`
+	Image          *string                                `json:"image,omitempty"`
+	Endpoints      *EndpointsStatusApplyConfiguration     `json:"endpoints,omitempty"`
+	Rules          *int32                                 `json:"rules,omitempty"`
+	ReadyRules     *int32                                 `json:"readyRules,omitempty"`
+	AttachedRules  []AttachedRuleStatusApplyConfiguration `json:"attachedRules,omitempty"`
 }
 
 // CacheStatusApplyConfiguration constructs an declarative configuration of the CacheStatus type for use with
@@ -35,3 +52,112 @@ func (b *CacheStatusApplyConfiguration) WithServiceBinding(value *ServiceBinding
 	b.ServiceBinding = value
 	return b
 }
+
+// WithCredentials sets the Credentials field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Credentials field is set to the value of the last call.
+func (b *CacheStatusApplyConfiguration) WithCredentials(value *CredentialsStatusApplyConfiguration) *CacheStatusApplyConfiguration {
+	b.Credentials = value
+	return b
+}
+
+// WithRollout sets the Rollout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rollout field is set to the value of the last call.
+func (b *CacheStatusApplyConfiguration) WithRollout(value *RolloutStatusApplyConfiguration) *CacheStatusApplyConfiguration {
+	b.Rollout = value
+	return b
+}
+
+// WithDeploymentType sets the DeploymentType field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeploymentType field is set to the value of the last call.
+func (b *CacheStatusApplyConfiguration) WithDeploymentType(value cachev1alpha1.CacheDeploymentType) *CacheStatusApplyConfiguration {
+	b.DeploymentType = &value
+	return b
+}
+
+// WithWorkload sets the Workload field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Workload field is set to the value of the last call.
+func (b *CacheStatusApplyConfiguration) WithWorkload(value string) *CacheStatusApplyConfiguration {
+	b.Workload = &value
+	return b
+}
+
+// WithRetryAttempts sets the RetryAttempts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RetryAttempts field is set to the value of the last call.
+func (b *CacheStatusApplyConfiguration) WithRetryAttempts(value int32) *CacheStatusApplyConfiguration {
+	b.RetryAttempts = &value
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *CacheStatusApplyConfiguration) WithReplicas(value int32) *CacheStatusApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithReadyReplicas sets the ReadyReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadyReplicas field is set to the value of the last call.
+func (b *CacheStatusApplyConfiguration) WithReadyReplicas(value int32) *CacheStatusApplyConfiguration {
+	b.ReadyReplicas = &value
+	return b
+}
+
+// WithSelector sets the Selector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Selector field is set to the value of the last call.
+func (b *CacheStatusApplyConfiguration) WithSelector(value string) *CacheStatusApplyConfiguration {
+	b.Selector = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *CacheStatusApplyConfiguration) WithImage(value string) *CacheStatusApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithEndpoints sets the Endpoints field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Endpoints field is set to the value of the last call.
+func (b *CacheStatusApplyConfiguration) WithEndpoints(value *EndpointsStatusApplyConfiguration) *CacheStatusApplyConfiguration {
+	b.Endpoints = value
+	return b
+}
+
+// WithRules sets the Rules field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rules field is set to the value of the last call.
+func (b *CacheStatusApplyConfiguration) WithRules(value int32) *CacheStatusApplyConfiguration {
+	b.Rules = &value
+	return b
+}
+
+// WithReadyRules sets the ReadyRules field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadyRules field is set to the value of the last call.
+func (b *CacheStatusApplyConfiguration) WithReadyRules(value int32) *CacheStatusApplyConfiguration {
+	b.ReadyRules = &value
+	return b
+}
+
+// WithAttachedRules adds the given value to the AttachedRules field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AttachedRules field.
+func (b *CacheStatusApplyConfiguration) WithAttachedRules(values ...*AttachedRuleStatusApplyConfiguration) *CacheStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAttachedRules")
+		}
+		b.AttachedRules = append(b.AttachedRules, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// CredentialsStatusApplyConfiguration represents an declarative configuration of the CredentialsStatus type for use
+// with apply.
+type CredentialsStatusApplyConfiguration struct {
+	SecretName *string `json:"secretName,omitempty"`
+	Version    *string `json:"version,omitempty"`
+}
+
+// CredentialsStatusApplyConfiguration constructs an declarative configuration of the CredentialsStatus type for use with
+// apply.
+func CredentialsStatus() *CredentialsStatusApplyConfiguration {
+	return &CredentialsStatusApplyConfiguration{}
+}
+
+// WithSecretName sets the SecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretName field is set to the value of the last call.
+func (b *CredentialsStatusApplyConfiguration) WithSecretName(value string) *CredentialsStatusApplyConfiguration {
+	b.SecretName = &value
+	return b
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *CredentialsStatusApplyConfiguration) WithVersion(value string) *CredentialsStatusApplyConfiguration {
+	b.Version = &value
+	return b
+}
@@ -13,6 +13,7 @@ type DataSourceSpecApplyConfiguration struct {
 	ConnectionProperties map[string]string                       `json:"connectionProperties,omitempty"`
 	SecretRef            *LocalObjectReferenceApplyConfiguration `json:"secretRef,omitempty"`
 	ServiceProviderRef   *ServiceRefApplyConfiguration           `json:"serviceProviderRef,omitempty"`
+	Vault                *VaultSourceApplyConfiguration          `json:"vault,omitempty"`
 }
 
 // DataSourceSpecApplyConfiguration constructs an declarative configuration of the DataSourceSpec type for use with
@@ -58,3 +59,11 @@ func (b *DataSourceSpecApplyConfiguration) WithServiceProviderRef(value *Service
 	b.ServiceProviderRef = value
 	return b
 }
+
+// WithVault sets the Vault field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Vault field is set to the value of the last call.
+func (b *DataSourceSpecApplyConfiguration) WithVault(value *VaultSourceApplyConfiguration) *DataSourceSpecApplyConfiguration {
+	b.Vault = value
+	return b
+}
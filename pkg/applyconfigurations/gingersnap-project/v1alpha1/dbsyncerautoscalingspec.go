@@ -0,0 +1,41 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// DBSyncerAutoscalingSpecApplyConfiguration represents an declarative configuration of the DBSyncerAutoscalingSpec type for use
+// with apply.
+type DBSyncerAutoscalingSpecApplyConfiguration struct {
+	MinReplicas *int32  `json:"minReplicas,omitempty"`
+	MaxReplicas *int32  `json:"maxReplicas,omitempty"`
+	TargetLag   *string `json:"targetLag,omitempty"`
+}
+
+// DBSyncerAutoscalingSpecApplyConfiguration constructs an declarative configuration of the DBSyncerAutoscalingSpec type for use with
+// apply.
+func DBSyncerAutoscalingSpec() *DBSyncerAutoscalingSpecApplyConfiguration {
+	return &DBSyncerAutoscalingSpecApplyConfiguration{}
+}
+
+// WithMinReplicas sets the MinReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinReplicas field is set to the value of the last call.
+func (b *DBSyncerAutoscalingSpecApplyConfiguration) WithMinReplicas(value int32) *DBSyncerAutoscalingSpecApplyConfiguration {
+	b.MinReplicas = &value
+	return b
+}
+
+// WithMaxReplicas sets the MaxReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxReplicas field is set to the value of the last call.
+func (b *DBSyncerAutoscalingSpecApplyConfiguration) WithMaxReplicas(value int32) *DBSyncerAutoscalingSpecApplyConfiguration {
+	b.MaxReplicas = &value
+	return b
+}
+
+// WithTargetLag sets the TargetLag field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetLag field is set to the value of the last call.
+func (b *DBSyncerAutoscalingSpecApplyConfiguration) WithTargetLag(value string) *DBSyncerAutoscalingSpecApplyConfiguration {
+	b.TargetLag = &value
+	return b
+}
@@ -2,10 +2,19 @@
 
 package v1alpha1
 
+import (
+	cachev1alpha1 "github.com/gingersnap-project/operator/api/v1alpha1"
+)
+
 // DBSyncerDeploymentSpecApplyConfiguration represents an declarative configuration of the DBSyncerDeploymentSpec type for use
 // with apply.
 type DBSyncerDeploymentSpecApplyConfiguration struct {
-	Resources *ResourcesApplyConfiguration `json:"resources,omitempty"`
+	Resources   *ResourcesApplyConfiguration               `json:"resources,omitempty"`
+	Replicas    *int32                                     `json:"replicas,omitempty"`
+	Logging     *LoggingApplyConfiguration                 `json:"logging,omitempty"`
+	ExtraEnv    []*cachev1alpha1.EnvVar                    `json:"extraEnv,omitempty"`
+	EnvFrom     []*cachev1alpha1.EnvFromSource             `json:"envFrom,omitempty"`
+	Autoscaling *DBSyncerAutoscalingSpecApplyConfiguration `json:"autoscaling,omitempty"`
 }
 
 // DBSyncerDeploymentSpecApplyConfiguration constructs an declarative configuration of the DBSyncerDeploymentSpec type for use with
@@ -21,3 +30,53 @@ func (b *DBSyncerDeploymentSpecApplyConfiguration) WithResources(value *Resource
 	b.Resources = value
 	return b
 }
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *DBSyncerDeploymentSpecApplyConfiguration) WithReplicas(value int32) *DBSyncerDeploymentSpecApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithLogging sets the Logging field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Logging field is set to the value of the last call.
+func (b *DBSyncerDeploymentSpecApplyConfiguration) WithLogging(value *LoggingApplyConfiguration) *DBSyncerDeploymentSpecApplyConfiguration {
+	b.Logging = value
+	return b
+}
+
+// WithExtraEnv adds the given value to the ExtraEnv field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ExtraEnv field.
+func (b *DBSyncerDeploymentSpecApplyConfiguration) WithExtraEnv(values ...**cachev1alpha1.EnvVar) *DBSyncerDeploymentSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithExtraEnv")
+		}
+		b.ExtraEnv = append(b.ExtraEnv, *values[i])
+	}
+	return b
+}
+
+// WithEnvFrom adds the given value to the EnvFrom field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the EnvFrom field.
+func (b *DBSyncerDeploymentSpecApplyConfiguration) WithEnvFrom(values ...**cachev1alpha1.EnvFromSource) *DBSyncerDeploymentSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithEnvFrom")
+		}
+		b.EnvFrom = append(b.EnvFrom, *values[i])
+	}
+	return b
+}
+
+// WithAutoscaling sets the Autoscaling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Autoscaling field is set to the value of the last call.
+func (b *DBSyncerDeploymentSpecApplyConfiguration) WithAutoscaling(value *DBSyncerAutoscalingSpecApplyConfiguration) *DBSyncerDeploymentSpecApplyConfiguration {
+	b.Autoscaling = value
+	return b
+}
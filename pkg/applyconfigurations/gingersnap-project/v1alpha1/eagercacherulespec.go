@@ -2,13 +2,20 @@
 
 package v1alpha1
 
+import (
+	cachev1alpha1 "github.com/gingersnap-project/operator/api/v1alpha1"
+)
+
 // EagerCacheRuleSpecApplyConfiguration represents an declarative configuration of the EagerCacheRuleSpec type for use
 // with apply.
 type EagerCacheRuleSpecApplyConfiguration struct {
-	CacheRef  *NamespacedObjectReferenceApplyConfiguration `json:"cacheRef,omitempty"`
-	TableName *string                                      `json:"tableName,omitempty"`
-	Key       *EagerCacheKeyApplyConfiguration             `json:"key,omitempty"`
-	Value     *ValueApplyConfiguration                     `json:"value,omitempty"`
+	CacheRef         *NamespacedObjectReferenceApplyConfiguration `json:"cacheRef,omitempty"`
+	TableName        *string                                      `json:"tableName,omitempty"`
+	Key              *EagerCacheKeyApplyConfiguration             `json:"key,omitempty"`
+	Value            *ValueApplyConfiguration                     `json:"value,omitempty"`
+	LagThreshold     *string                                      `json:"lagThreshold,omitempty"`
+	SnapshotMode     *cachev1alpha1.SnapshotMode                  `json:"snapshotMode,omitempty"`
+	ResyncGeneration *int64                                       `json:"resyncGeneration,omitempty"`
 }
 
 // EagerCacheRuleSpecApplyConfiguration constructs an declarative configuration of the EagerCacheRuleSpec type for use with
@@ -48,3 +55,27 @@ func (b *EagerCacheRuleSpecApplyConfiguration) WithValue(value *ValueApplyConfig
 	b.Value = value
 	return b
 }
+
+// WithLagThreshold sets the LagThreshold field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LagThreshold field is set to the value of the last call.
+func (b *EagerCacheRuleSpecApplyConfiguration) WithLagThreshold(value string) *EagerCacheRuleSpecApplyConfiguration {
+	b.LagThreshold = &value
+	return b
+}
+
+// WithSnapshotMode sets the SnapshotMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SnapshotMode field is set to the value of the last call.
+func (b *EagerCacheRuleSpecApplyConfiguration) WithSnapshotMode(value cachev1alpha1.SnapshotMode) *EagerCacheRuleSpecApplyConfiguration {
+	b.SnapshotMode = &value
+	return b
+}
+
+// WithResyncGeneration sets the ResyncGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResyncGeneration field is set to the value of the last call.
+func (b *EagerCacheRuleSpecApplyConfiguration) WithResyncGeneration(value int64) *EagerCacheRuleSpecApplyConfiguration {
+	b.ResyncGeneration = &value
+	return b
+}
@@ -5,7 +5,10 @@ package v1alpha1
 // EagerCacheRuleStatusApplyConfiguration represents an declarative configuration of the EagerCacheRuleStatus type for use
 // with apply.
 type EagerCacheRuleStatusApplyConfiguration struct {
-	Conditions []EagerCacheRuleConditionApplyConfiguration `json:"conditions,omitempty"`
+	Conditions    []EagerCacheRuleConditionApplyConfiguration `json:"conditions,omitempty"`
+	Credentials   *CredentialsStatusApplyConfiguration        `json:"credentials,omitempty"`
+	Replication   *ReplicationStatusApplyConfiguration        `json:"replication,omitempty"`
+	RetryAttempts *int32                                      `json:"retryAttempts,omitempty"`
 }
 
 // EagerCacheRuleStatusApplyConfiguration constructs an declarative configuration of the EagerCacheRuleStatus type for use with
@@ -26,3 +29,27 @@ func (b *EagerCacheRuleStatusApplyConfiguration) WithConditions(values ...*Eager
 	}
 	return b
 }
+
+// WithCredentials sets the Credentials field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Credentials field is set to the value of the last call.
+func (b *EagerCacheRuleStatusApplyConfiguration) WithCredentials(value *CredentialsStatusApplyConfiguration) *EagerCacheRuleStatusApplyConfiguration {
+	b.Credentials = value
+	return b
+}
+
+// WithReplication sets the Replication field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replication field is set to the value of the last call.
+func (b *EagerCacheRuleStatusApplyConfiguration) WithReplication(value *ReplicationStatusApplyConfiguration) *EagerCacheRuleStatusApplyConfiguration {
+	b.Replication = value
+	return b
+}
+
+// WithRetryAttempts sets the RetryAttempts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RetryAttempts field is set to the value of the last call.
+func (b *EagerCacheRuleStatusApplyConfiguration) WithRetryAttempts(value int32) *EagerCacheRuleStatusApplyConfiguration {
+	b.RetryAttempts = &value
+	return b
+}
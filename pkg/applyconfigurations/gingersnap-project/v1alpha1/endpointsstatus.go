@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// EndpointsStatusApplyConfiguration represents an declarative configuration of the EndpointsStatus type for use
+// with apply.
+type EndpointsStatusApplyConfiguration struct {
+	HotRod *string `json:"hotrod,omitempty"`
+	Rest   *string `json:"rest,omitempty"`
+}
+
+// EndpointsStatusApplyConfiguration constructs an declarative configuration of the EndpointsStatus type for use with
+// apply.
+func EndpointsStatus() *EndpointsStatusApplyConfiguration {
+	return &EndpointsStatusApplyConfiguration{}
+}
+
+// WithHotRod sets the HotRod field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HotRod field is set to the value of the last call.
+func (b *EndpointsStatusApplyConfiguration) WithHotRod(value string) *EndpointsStatusApplyConfiguration {
+	b.HotRod = &value
+	return b
+}
+
+// WithRest sets the Rest field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rest field is set to the value of the last call.
+func (b *EndpointsStatusApplyConfiguration) WithRest(value string) *EndpointsStatusApplyConfiguration {
+	b.Rest = &value
+	return b
+}
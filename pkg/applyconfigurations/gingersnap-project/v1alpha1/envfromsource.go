@@ -0,0 +1,41 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// EnvFromSourceApplyConfiguration represents an declarative configuration of the EnvFromSource type for use
+// with apply.
+type EnvFromSourceApplyConfiguration struct {
+	Prefix       *string                                 `json:"prefix,omitempty"`
+	ConfigMapRef *LocalObjectReferenceApplyConfiguration `json:"configMapRef,omitempty"`
+	SecretRef    *LocalObjectReferenceApplyConfiguration `json:"secretRef,omitempty"`
+}
+
+// EnvFromSourceApplyConfiguration constructs an declarative configuration of the EnvFromSource type for use with
+// apply.
+func EnvFromSource() *EnvFromSourceApplyConfiguration {
+	return &EnvFromSourceApplyConfiguration{}
+}
+
+// WithPrefix sets the Prefix field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Prefix field is set to the value of the last call.
+func (b *EnvFromSourceApplyConfiguration) WithPrefix(value string) *EnvFromSourceApplyConfiguration {
+	b.Prefix = &value
+	return b
+}
+
+// WithConfigMapRef sets the ConfigMapRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigMapRef field is set to the value of the last call.
+func (b *EnvFromSourceApplyConfiguration) WithConfigMapRef(value *LocalObjectReferenceApplyConfiguration) *EnvFromSourceApplyConfiguration {
+	b.ConfigMapRef = value
+	return b
+}
+
+// WithSecretRef sets the SecretRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretRef field is set to the value of the last call.
+func (b *EnvFromSourceApplyConfiguration) WithSecretRef(value *LocalObjectReferenceApplyConfiguration) *EnvFromSourceApplyConfiguration {
+	b.SecretRef = value
+	return b
+}
@@ -0,0 +1,50 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// EnvVarApplyConfiguration represents an declarative configuration of the EnvVar type for use
+// with apply.
+type EnvVarApplyConfiguration struct {
+	Name            *string                        `json:"name,omitempty"`
+	Value           *string                        `json:"value,omitempty"`
+	ConfigMapKeyRef *KeySelectorApplyConfiguration `json:"configMapKeyRef,omitempty"`
+	SecretKeyRef    *KeySelectorApplyConfiguration `json:"secretKeyRef,omitempty"`
+}
+
+// EnvVarApplyConfiguration constructs an declarative configuration of the EnvVar type for use with
+// apply.
+func EnvVar() *EnvVarApplyConfiguration {
+	return &EnvVarApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *EnvVarApplyConfiguration) WithName(value string) *EnvVarApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *EnvVarApplyConfiguration) WithValue(value string) *EnvVarApplyConfiguration {
+	b.Value = &value
+	return b
+}
+
+// WithConfigMapKeyRef sets the ConfigMapKeyRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigMapKeyRef field is set to the value of the last call.
+func (b *EnvVarApplyConfiguration) WithConfigMapKeyRef(value *KeySelectorApplyConfiguration) *EnvVarApplyConfiguration {
+	b.ConfigMapKeyRef = value
+	return b
+}
+
+// WithSecretKeyRef sets the SecretKeyRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretKeyRef field is set to the value of the last call.
+func (b *EnvVarApplyConfiguration) WithSecretKeyRef(value *KeySelectorApplyConfiguration) *EnvVarApplyConfiguration {
+	b.SecretKeyRef = value
+	return b
+}
@@ -0,0 +1,41 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// KeySelectorApplyConfiguration represents an declarative configuration of the KeySelector type for use
+// with apply.
+type KeySelectorApplyConfiguration struct {
+	Name     *string `json:"name,omitempty"`
+	Key      *string `json:"key,omitempty"`
+	Optional *bool   `json:"optional,omitempty"`
+}
+
+// KeySelectorApplyConfiguration constructs an declarative configuration of the KeySelector type for use with
+// apply.
+func KeySelector() *KeySelectorApplyConfiguration {
+	return &KeySelectorApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *KeySelectorApplyConfiguration) WithName(value string) *KeySelectorApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithKey sets the Key field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Key field is set to the value of the last call.
+func (b *KeySelectorApplyConfiguration) WithKey(value string) *KeySelectorApplyConfiguration {
+	b.Key = &value
+	return b
+}
+
+// WithOptional sets the Optional field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Optional field is set to the value of the last call.
+func (b *KeySelectorApplyConfiguration) WithOptional(value bool) *KeySelectorApplyConfiguration {
+	b.Optional = &value
+	return b
+}
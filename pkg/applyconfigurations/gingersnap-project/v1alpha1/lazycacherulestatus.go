@@ -5,7 +5,8 @@ package v1alpha1
 // LazyCacheRuleStatusApplyConfiguration represents an declarative configuration of the LazyCacheRuleStatus type for use
 // with apply.
 type LazyCacheRuleStatusApplyConfiguration struct {
-	Conditions []LazyCacheRuleConditionApplyConfiguration `json:"conditions,omitempty"`
+	Conditions    []LazyCacheRuleConditionApplyConfiguration `json:"conditions,omitempty"`
+	RetryAttempts *int32                                     `json:"retryAttempts,omitempty"`
 }
 
 // LazyCacheRuleStatusApplyConfiguration constructs an declarative configuration of the LazyCacheRuleStatus type for use with
@@ -26,3 +27,11 @@ func (b *LazyCacheRuleStatusApplyConfiguration) WithConditions(values ...*LazyCa
 	}
 	return b
 }
+
+// WithRetryAttempts sets the RetryAttempts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RetryAttempts field is set to the value of the last call.
+func (b *LazyCacheRuleStatusApplyConfiguration) WithRetryAttempts(value int32) *LazyCacheRuleStatusApplyConfiguration {
+	b.RetryAttempts = &value
+	return b
+}
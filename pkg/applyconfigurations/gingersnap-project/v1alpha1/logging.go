@@ -0,0 +1,47 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// LoggingApplyConfiguration represents an declarative configuration of the Logging type for use
+// with apply.
+type LoggingApplyConfiguration struct {
+	Level      *string           `json:"level,omitempty"`
+	Categories map[string]string `json:"categories,omitempty"`
+	Json       *bool             `json:"json,omitempty"`
+}
+
+// LoggingApplyConfiguration constructs an declarative configuration of the Logging type for use with
+// apply.
+func Logging() *LoggingApplyConfiguration {
+	return &LoggingApplyConfiguration{}
+}
+
+// WithLevel sets the Level field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Level field is set to the value of the last call.
+func (b *LoggingApplyConfiguration) WithLevel(value string) *LoggingApplyConfiguration {
+	b.Level = &value
+	return b
+}
+
+// WithCategories puts the entries into the Categories field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Categories field,
+// overwriting an existing map entries in Categories field with the same key.
+func (b *LoggingApplyConfiguration) WithCategories(entries map[string]string) *LoggingApplyConfiguration {
+	if b.Categories == nil && len(entries) > 0 {
+		b.Categories = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Categories[k] = v
+	}
+	return b
+}
+
+// WithJson sets the Json field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Json field is set to the value of the last call.
+func (b *LoggingApplyConfiguration) WithJson(value bool) *LoggingApplyConfiguration {
+	b.Json = &value
+	return b
+}
@@ -0,0 +1,40 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// NamespaceSelectorApplyConfiguration represents an declarative configuration of the NamespaceSelector type for use
+// with apply.
+type NamespaceSelectorApplyConfiguration struct {
+	MatchNames  []string          `json:"matchNames,omitempty"`
+	MatchLabels map[string]string `json:"matchLabels,omitempty"`
+}
+
+// NamespaceSelectorApplyConfiguration constructs an declarative configuration of the NamespaceSelector type for use with
+// apply.
+func NamespaceSelector() *NamespaceSelectorApplyConfiguration {
+	return &NamespaceSelectorApplyConfiguration{}
+}
+
+// WithMatchNames adds the given value to the MatchNames field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the MatchNames field.
+func (b *NamespaceSelectorApplyConfiguration) WithMatchNames(values ...string) *NamespaceSelectorApplyConfiguration {
+	for i := range values {
+		b.MatchNames = append(b.MatchNames, values[i])
+	}
+	return b
+}
+
+// WithMatchLabels puts the entries into the MatchLabels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the MatchLabels field,
+// overwriting an existing map entries in MatchLabels field with the same key.
+func (b *NamespaceSelectorApplyConfiguration) WithMatchLabels(entries map[string]string) *NamespaceSelectorApplyConfiguration {
+	if b.MatchLabels == nil && len(entries) > 0 {
+		b.MatchLabels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.MatchLabels[k] = v
+	}
+	return b
+}
@@ -0,0 +1,45 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReplicationStatusApplyConfiguration represents an declarative configuration of the ReplicationStatus type for use
+// with apply.
+type ReplicationStatusApplyConfiguration struct {
+	Lag                 *v1.Duration                      `json:"lag,omitempty"`
+	LastProcessedChange *v1.Time                          `json:"lastProcessedChange,omitempty"`
+	Snapshot            *SnapshotStatusApplyConfiguration `json:"snapshot,omitempty"`
+}
+
+// ReplicationStatusApplyConfiguration constructs an declarative configuration of the ReplicationStatus type for use with
+// apply.
+func ReplicationStatus() *ReplicationStatusApplyConfiguration {
+	return &ReplicationStatusApplyConfiguration{}
+}
+
+// WithLag sets the Lag field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Lag field is set to the value of the last call.
+func (b *ReplicationStatusApplyConfiguration) WithLag(value v1.Duration) *ReplicationStatusApplyConfiguration {
+	b.Lag = &value
+	return b
+}
+
+// WithLastProcessedChange sets the LastProcessedChange field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastProcessedChange field is set to the value of the last call.
+func (b *ReplicationStatusApplyConfiguration) WithLastProcessedChange(value v1.Time) *ReplicationStatusApplyConfiguration {
+	b.LastProcessedChange = &value
+	return b
+}
+
+// WithSnapshot sets the Snapshot field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Snapshot field is set to the value of the last call.
+func (b *ReplicationStatusApplyConfiguration) WithSnapshot(value *SnapshotStatusApplyConfiguration) *ReplicationStatusApplyConfiguration {
+	b.Snapshot = value
+	return b
+}
@@ -0,0 +1,59 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// RolloutStatusApplyConfiguration represents an declarative configuration of the RolloutStatus type for use
+// with apply.
+type RolloutStatusApplyConfiguration struct {
+	Desired   *int32 `json:"desired,omitempty"`
+	Updated   *int32 `json:"updated,omitempty"`
+	Ready     *int32 `json:"ready,omitempty"`
+	Available *int32 `json:"available,omitempty"`
+	Complete  *bool  `json:"complete,omitempty"`
+}
+
+// RolloutStatusApplyConfiguration constructs an declarative configuration of the RolloutStatus type for use with
+// apply.
+func RolloutStatus() *RolloutStatusApplyConfiguration {
+	return &RolloutStatusApplyConfiguration{}
+}
+
+// WithDesired sets the Desired field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Desired field is set to the value of the last call.
+func (b *RolloutStatusApplyConfiguration) WithDesired(value int32) *RolloutStatusApplyConfiguration {
+	b.Desired = &value
+	return b
+}
+
+// WithUpdated sets the Updated field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Updated field is set to the value of the last call.
+func (b *RolloutStatusApplyConfiguration) WithUpdated(value int32) *RolloutStatusApplyConfiguration {
+	b.Updated = &value
+	return b
+}
+
+// WithReady sets the Ready field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ready field is set to the value of the last call.
+func (b *RolloutStatusApplyConfiguration) WithReady(value int32) *RolloutStatusApplyConfiguration {
+	b.Ready = &value
+	return b
+}
+
+// WithAvailable sets the Available field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Available field is set to the value of the last call.
+func (b *RolloutStatusApplyConfiguration) WithAvailable(value int32) *RolloutStatusApplyConfiguration {
+	b.Available = &value
+	return b
+}
+
+// WithComplete sets the Complete field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Complete field is set to the value of the last call.
+func (b *RolloutStatusApplyConfiguration) WithComplete(value bool) *RolloutStatusApplyConfiguration {
+	b.Complete = &value
+	return b
+}
@@ -0,0 +1,54 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/gingersnap-project/operator/api/v1alpha1"
+)
+
+// SnapshotStatusApplyConfiguration represents an declarative configuration of the SnapshotStatus type for use
+// with apply.
+type SnapshotStatusApplyConfiguration struct {
+	State            *v1alpha1.SnapshotState `json:"state,omitempty"`
+	ProcessedRows    *int64                  `json:"processedRows,omitempty"`
+	TotalRows        *int64                  `json:"totalRows,omitempty"`
+	ResyncGeneration *int64                  `json:"resyncGeneration,omitempty"`
+}
+
+// SnapshotStatusApplyConfiguration constructs an declarative configuration of the SnapshotStatus type for use with
+// apply.
+func SnapshotStatus() *SnapshotStatusApplyConfiguration {
+	return &SnapshotStatusApplyConfiguration{}
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *SnapshotStatusApplyConfiguration) WithState(value v1alpha1.SnapshotState) *SnapshotStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithProcessedRows sets the ProcessedRows field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProcessedRows field is set to the value of the last call.
+func (b *SnapshotStatusApplyConfiguration) WithProcessedRows(value int64) *SnapshotStatusApplyConfiguration {
+	b.ProcessedRows = &value
+	return b
+}
+
+// WithTotalRows sets the TotalRows field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TotalRows field is set to the value of the last call.
+func (b *SnapshotStatusApplyConfiguration) WithTotalRows(value int64) *SnapshotStatusApplyConfiguration {
+	b.TotalRows = &value
+	return b
+}
+
+// WithResyncGeneration sets the ResyncGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResyncGeneration field is set to the value of the last call.
+func (b *SnapshotStatusApplyConfiguration) WithResyncGeneration(value int64) *SnapshotStatusApplyConfiguration {
+	b.ResyncGeneration = &value
+	return b
+}
@@ -0,0 +1,54 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/gingersnap-project/operator/api/v1alpha1"
+)
+
+// UpdateStrategyApplyConfiguration represents an declarative configuration of the UpdateStrategy type for use
+// with apply.
+type UpdateStrategyApplyConfiguration struct {
+	Type           *v1alpha1.UpdateStrategyType `json:"type,omitempty"`
+	MaxUnavailable *string                      `json:"maxUnavailable,omitempty"`
+	MaxSurge       *string                      `json:"maxSurge,omitempty"`
+	Partition      *int32                       `json:"partition,omitempty"`
+}
+
+// UpdateStrategyApplyConfiguration constructs an declarative configuration of the UpdateStrategy type for use with
+// apply.
+func UpdateStrategy() *UpdateStrategyApplyConfiguration {
+	return &UpdateStrategyApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *UpdateStrategyApplyConfiguration) WithType(value v1alpha1.UpdateStrategyType) *UpdateStrategyApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithMaxUnavailable sets the MaxUnavailable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxUnavailable field is set to the value of the last call.
+func (b *UpdateStrategyApplyConfiguration) WithMaxUnavailable(value string) *UpdateStrategyApplyConfiguration {
+	b.MaxUnavailable = &value
+	return b
+}
+
+// WithMaxSurge sets the MaxSurge field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxSurge field is set to the value of the last call.
+func (b *UpdateStrategyApplyConfiguration) WithMaxSurge(value string) *UpdateStrategyApplyConfiguration {
+	b.MaxSurge = &value
+	return b
+}
+
+// WithPartition sets the Partition field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Partition field is set to the value of the last call.
+func (b *UpdateStrategyApplyConfiguration) WithPartition(value int32) *UpdateStrategyApplyConfiguration {
+	b.Partition = &value
+	return b
+}
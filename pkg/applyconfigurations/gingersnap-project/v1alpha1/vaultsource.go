@@ -0,0 +1,96 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/gingersnap-project/operator/api/v1alpha1"
+)
+
+// VaultSourceApplyConfiguration represents an declarative configuration of the VaultSource type for use
+// with apply.
+type VaultSourceApplyConfiguration struct {
+	Address         *string                                 `json:"address,omitempty"`
+	Engine          *v1alpha1.VaultSecretEngine             `json:"engine,omitempty"`
+	Path            *string                                 `json:"path,omitempty"`
+	Role            *string                                 `json:"role,omitempty"`
+	AuthPath        *string                                 `json:"authPath,omitempty"`
+	TokenSecretRef  *LocalObjectReferenceApplyConfiguration `json:"tokenSecretRef,omitempty"`
+	BindingData     map[string]string                       `json:"bindingData,omitempty"`
+	RefreshInterval *string                                 `json:"refreshInterval,omitempty"`
+}
+
+// VaultSourceApplyConfiguration constructs an declarative configuration of the VaultSource type for use with
+// apply.
+func VaultSource() *VaultSourceApplyConfiguration {
+	return &VaultSourceApplyConfiguration{}
+}
+
+// WithAddress sets the Address field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Address field is set to the value of the last call.
+func (b *VaultSourceApplyConfiguration) WithAddress(value string) *VaultSourceApplyConfiguration {
+	b.Address = &value
+	return b
+}
+
+// WithEngine sets the Engine field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Engine field is set to the value of the last call.
+func (b *VaultSourceApplyConfiguration) WithEngine(value v1alpha1.VaultSecretEngine) *VaultSourceApplyConfiguration {
+	b.Engine = &value
+	return b
+}
+
+// WithPath sets the Path field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Path field is set to the value of the last call.
+func (b *VaultSourceApplyConfiguration) WithPath(value string) *VaultSourceApplyConfiguration {
+	b.Path = &value
+	return b
+}
+
+// WithRole sets the Role field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Role field is set to the value of the last call.
+func (b *VaultSourceApplyConfiguration) WithRole(value string) *VaultSourceApplyConfiguration {
+	b.Role = &value
+	return b
+}
+
+// WithAuthPath sets the AuthPath field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AuthPath field is set to the value of the last call.
+func (b *VaultSourceApplyConfiguration) WithAuthPath(value string) *VaultSourceApplyConfiguration {
+	b.AuthPath = &value
+	return b
+}
+
+// WithTokenSecretRef sets the TokenSecretRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TokenSecretRef field is set to the value of the last call.
+func (b *VaultSourceApplyConfiguration) WithTokenSecretRef(value *LocalObjectReferenceApplyConfiguration) *VaultSourceApplyConfiguration {
+	b.TokenSecretRef = value
+	return b
+}
+
+// WithBindingData puts the entries into the BindingData field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the BindingData field,
+// overwriting an existing map entries in BindingData field with the same key.
+func (b *VaultSourceApplyConfiguration) WithBindingData(entries map[string]string) *VaultSourceApplyConfiguration {
+	if b.BindingData == nil && len(entries) > 0 {
+		b.BindingData = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.BindingData[k] = v
+	}
+	return b
+}
+
+// WithRefreshInterval sets the RefreshInterval field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RefreshInterval field is set to the value of the last call.
+func (b *VaultSourceApplyConfiguration) WithRefreshInterval(value string) *VaultSourceApplyConfiguration {
+	b.RefreshInterval = &value
+	return b
+}
@@ -0,0 +1,73 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ScaledObjectSpecApplyConfiguration represents an declarative configuration of the ScaledObjectSpec type for use
+// with apply.
+type ScaledObjectSpecApplyConfiguration struct {
+	ScaleTargetRef  *ScaleTargetApplyConfiguration    `json:"scaleTargetRef,omitempty"`
+	PollingInterval *int32                            `json:"pollingInterval,omitempty"`
+	CooldownPeriod  *int32                            `json:"cooldownPeriod,omitempty"`
+	MinReplicaCount *int32                            `json:"minReplicaCount,omitempty"`
+	MaxReplicaCount *int32                            `json:"maxReplicaCount,omitempty"`
+	Triggers        []ScaleTriggersApplyConfiguration `json:"triggers,omitempty"`
+}
+
+// ScaledObjectSpecApplyConfiguration constructs an declarative configuration of the ScaledObjectSpec type for use with
+// apply.
+func ScaledObjectSpec() *ScaledObjectSpecApplyConfiguration {
+	return &ScaledObjectSpecApplyConfiguration{}
+}
+
+// WithScaleTargetRef sets the ScaleTargetRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScaleTargetRef field is set to the value of the last call.
+func (b *ScaledObjectSpecApplyConfiguration) WithScaleTargetRef(value *ScaleTargetApplyConfiguration) *ScaledObjectSpecApplyConfiguration {
+	b.ScaleTargetRef = value
+	return b
+}
+
+// WithPollingInterval sets the PollingInterval field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PollingInterval field is set to the value of the last call.
+func (b *ScaledObjectSpecApplyConfiguration) WithPollingInterval(value int32) *ScaledObjectSpecApplyConfiguration {
+	b.PollingInterval = &value
+	return b
+}
+
+// WithCooldownPeriod sets the CooldownPeriod field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CooldownPeriod field is set to the value of the last call.
+func (b *ScaledObjectSpecApplyConfiguration) WithCooldownPeriod(value int32) *ScaledObjectSpecApplyConfiguration {
+	b.CooldownPeriod = &value
+	return b
+}
+
+// WithMinReplicaCount sets the MinReplicaCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinReplicaCount field is set to the value of the last call.
+func (b *ScaledObjectSpecApplyConfiguration) WithMinReplicaCount(value int32) *ScaledObjectSpecApplyConfiguration {
+	b.MinReplicaCount = &value
+	return b
+}
+
+// WithMaxReplicaCount sets the MaxReplicaCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxReplicaCount field is set to the value of the last call.
+func (b *ScaledObjectSpecApplyConfiguration) WithMaxReplicaCount(value int32) *ScaledObjectSpecApplyConfiguration {
+	b.MaxReplicaCount = &value
+	return b
+}
+
+// WithTriggers adds the given value to the Triggers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Triggers field.
+func (b *ScaledObjectSpecApplyConfiguration) WithTriggers(values ...*ScaleTriggersApplyConfiguration) *ScaledObjectSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTriggers")
+		}
+		b.Triggers = append(b.Triggers, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,47 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScaledObjectStatusApplyConfiguration represents an declarative configuration of the ScaledObjectStatus type for use
+// with apply.
+type ScaledObjectStatusApplyConfiguration struct {
+	ScaleTargetKind      *string        `json:"scaleTargetKind,omitempty"`
+	OriginalReplicaCount *int32         `json:"originalReplicaCount,omitempty"`
+	Conditions           []v1.Condition `json:"conditions,omitempty"`
+}
+
+// ScaledObjectStatusApplyConfiguration constructs an declarative configuration of the ScaledObjectStatus type for use with
+// apply.
+func ScaledObjectStatus() *ScaledObjectStatusApplyConfiguration {
+	return &ScaledObjectStatusApplyConfiguration{}
+}
+
+// WithScaleTargetKind sets the ScaleTargetKind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScaleTargetKind field is set to the value of the last call.
+func (b *ScaledObjectStatusApplyConfiguration) WithScaleTargetKind(value string) *ScaledObjectStatusApplyConfiguration {
+	b.ScaleTargetKind = &value
+	return b
+}
+
+// WithOriginalReplicaCount sets the OriginalReplicaCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OriginalReplicaCount field is set to the value of the last call.
+func (b *ScaledObjectStatusApplyConfiguration) WithOriginalReplicaCount(value int32) *ScaledObjectStatusApplyConfiguration {
+	b.OriginalReplicaCount = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *ScaledObjectStatusApplyConfiguration) WithConditions(values ...v1.Condition) *ScaledObjectStatusApplyConfiguration {
+	for i := range values {
+		b.Conditions = append(b.Conditions, values[i])
+	}
+	return b
+}
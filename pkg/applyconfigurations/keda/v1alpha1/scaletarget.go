@@ -0,0 +1,41 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ScaleTargetApplyConfiguration represents an declarative configuration of the ScaleTarget type for use
+// with apply.
+type ScaleTargetApplyConfiguration struct {
+	Name       *string `json:"name,omitempty"`
+	APIVersion *string `json:"apiVersion,omitempty"`
+	Kind       *string `json:"kind,omitempty"`
+}
+
+// ScaleTargetApplyConfiguration constructs an declarative configuration of the ScaleTarget type for use with
+// apply.
+func ScaleTarget() *ScaleTargetApplyConfiguration {
+	return &ScaleTargetApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ScaleTargetApplyConfiguration) WithName(value string) *ScaleTargetApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithAPIVersion sets the APIVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIVersion field is set to the value of the last call.
+func (b *ScaleTargetApplyConfiguration) WithAPIVersion(value string) *ScaleTargetApplyConfiguration {
+	b.APIVersion = &value
+	return b
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *ScaleTargetApplyConfiguration) WithKind(value string) *ScaleTargetApplyConfiguration {
+	b.Kind = &value
+	return b
+}
@@ -0,0 +1,60 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v2 "k8s.io/api/autoscaling/v2"
+)
+
+// ScaleTriggersApplyConfiguration represents an declarative configuration of the ScaleTriggers type for use
+// with apply.
+type ScaleTriggersApplyConfiguration struct {
+	Type       *string              `json:"type,omitempty"`
+	Name       *string              `json:"name,omitempty"`
+	Metadata   map[string]string    `json:"metadata,omitempty"`
+	MetricType *v2.MetricTargetType `json:"metricType,omitempty"`
+}
+
+// ScaleTriggersApplyConfiguration constructs an declarative configuration of the ScaleTriggers type for use with
+// apply.
+func ScaleTriggers() *ScaleTriggersApplyConfiguration {
+	return &ScaleTriggersApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *ScaleTriggersApplyConfiguration) WithType(value string) *ScaleTriggersApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ScaleTriggersApplyConfiguration) WithName(value string) *ScaleTriggersApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithMetadata puts the entries into the Metadata field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Metadata field,
+// overwriting an existing map entries in Metadata field with the same key.
+func (b *ScaleTriggersApplyConfiguration) WithMetadata(entries map[string]string) *ScaleTriggersApplyConfiguration {
+	if b.Metadata == nil && len(entries) > 0 {
+		b.Metadata = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Metadata[k] = v
+	}
+	return b
+}
+
+// WithMetricType sets the MetricType field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MetricType field is set to the value of the last call.
+func (b *ScaleTriggersApplyConfiguration) WithMetricType(value v2.MetricTargetType) *ScaleTriggersApplyConfiguration {
+	b.MetricType = &value
+	return b
+}
@@ -5,7 +5,9 @@ package applyconfigurations
 import (
 	v1beta1 "github.com/gingersnap-project/operator/pkg/apis/binding/v1beta1"
 	v1alpha1 "github.com/gingersnap-project/operator/api/v1alpha1"
+	kedav1alpha1 "github.com/gingersnap-project/operator/pkg/apis/keda/v1alpha1"
 	gingersnapprojectv1alpha1 "github.com/gingersnap-project/operator/pkg/applyconfigurations/gingersnap-project/v1alpha1"
+	applyconfigurationskedav1alpha1 "github.com/gingersnap-project/operator/pkg/applyconfigurations/keda/v1alpha1"
 	monitoringv1 "github.com/gingersnap-project/operator/pkg/applyconfigurations/monitoring/v1"
 	servicebindingv1beta1 "github.com/gingersnap-project/operator/pkg/applyconfigurations/servicebinding/v1beta1"
 	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -17,18 +19,26 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=gingersnap-project.io, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithKind("AttachedRuleStatus"):
+		return &gingersnapprojectv1alpha1.AttachedRuleStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Cache"):
 		return &gingersnapprojectv1alpha1.CacheApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CacheCondition"):
 		return &gingersnapprojectv1alpha1.CacheConditionApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CacheDeploymentSpec"):
 		return &gingersnapprojectv1alpha1.CacheDeploymentSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CachePersistenceSpec"):
+		return &gingersnapprojectv1alpha1.CachePersistenceSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CacheSpec"):
 		return &gingersnapprojectv1alpha1.CacheSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CacheStatus"):
 		return &gingersnapprojectv1alpha1.CacheStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CredentialsStatus"):
+		return &gingersnapprojectv1alpha1.CredentialsStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("DataSourceSpec"):
 		return &gingersnapprojectv1alpha1.DataSourceSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DBSyncerAutoscalingSpec"):
+		return &gingersnapprojectv1alpha1.DBSyncerAutoscalingSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("DBSyncerDeploymentSpec"):
 		return &gingersnapprojectv1alpha1.DBSyncerDeploymentSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("EagerCacheKey"):
@@ -41,6 +51,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &gingersnapprojectv1alpha1.EagerCacheRuleSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("EagerCacheRuleStatus"):
 		return &gingersnapprojectv1alpha1.EagerCacheRuleStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("EndpointsStatus"):
+		return &gingersnapprojectv1alpha1.EndpointsStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("EnvFromSource"):
+		return &gingersnapprojectv1alpha1.EnvFromSourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("EnvVar"):
+		return &gingersnapprojectv1alpha1.EnvVarApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("KeySelector"):
+		return &gingersnapprojectv1alpha1.KeySelectorApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("LazyCacheKey"):
 		return &gingersnapprojectv1alpha1.LazyCacheKeyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("LazyCacheRule"):
@@ -53,18 +71,44 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &gingersnapprojectv1alpha1.LazyCacheRuleStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("LocalObjectReference"):
 		return &gingersnapprojectv1alpha1.LocalObjectReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Logging"):
+		return &gingersnapprojectv1alpha1.LoggingApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NamespacedObjectReference"):
 		return &gingersnapprojectv1alpha1.NamespacedObjectReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NamespaceSelector"):
+		return &gingersnapprojectv1alpha1.NamespaceSelectorApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ReplicationStatus"):
+		return &gingersnapprojectv1alpha1.ReplicationStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ResourceQuantity"):
 		return &gingersnapprojectv1alpha1.ResourceQuantityApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Resources"):
 		return &gingersnapprojectv1alpha1.ResourcesApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RolloutStatus"):
+		return &gingersnapprojectv1alpha1.RolloutStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ServiceBinding"):
 		return &gingersnapprojectv1alpha1.ServiceBindingApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ServiceRef"):
 		return &gingersnapprojectv1alpha1.ServiceRefApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SnapshotStatus"):
+		return &gingersnapprojectv1alpha1.SnapshotStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("UpdateStrategy"):
+		return &gingersnapprojectv1alpha1.UpdateStrategyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Value"):
 		return &gingersnapprojectv1alpha1.ValueApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VaultSource"):
+		return &gingersnapprojectv1alpha1.VaultSourceApplyConfiguration{}
+
+		// Group=keda.sh, Version=v1alpha1
+	case kedav1alpha1.SchemeGroupVersion.WithKind("ScaledObject"):
+		return &applyconfigurationskedav1alpha1.ScaledObjectApplyConfiguration{}
+	case kedav1alpha1.SchemeGroupVersion.WithKind("ScaledObjectSpec"):
+		return &applyconfigurationskedav1alpha1.ScaledObjectSpecApplyConfiguration{}
+	case kedav1alpha1.SchemeGroupVersion.WithKind("ScaledObjectStatus"):
+		return &applyconfigurationskedav1alpha1.ScaledObjectStatusApplyConfiguration{}
+	case kedav1alpha1.SchemeGroupVersion.WithKind("ScaleTarget"):
+		return &applyconfigurationskedav1alpha1.ScaleTargetApplyConfiguration{}
+	case kedav1alpha1.SchemeGroupVersion.WithKind("ScaleTriggers"):
+		return &applyconfigurationskedav1alpha1.ScaleTriggersApplyConfiguration{}
 
 		// Group=monitoring.coreos.com, Version=v1
 	case v1.SchemeGroupVersion.WithKind("AlertingSpec"):
@@ -0,0 +1,182 @@
+package backup
+
+import (
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+)
+
+// ArchiveVersion the version of the archive format written by Writer
+const ArchiveVersion = 1
+
+// ChecksumPrefix the algorithm prefix of archive checksums
+const ChecksumPrefix = "sha256:"
+
+// Header the first line of an archive, describing its contents
+type Header struct {
+	Version int `json:"version"`
+	// Cache the <namespace>/<name> of the Cache the entries were exported from
+	Cache string `json:"cache"`
+	// Rules the names of the rules whose entries are contained in the archive
+	Rules []string `json:"rules"`
+}
+
+// Entry a cache entry of a rule. Values are opaque to the operator and base64 encoded in the archive
+type Entry struct {
+	Rule  string `json:"rule"`
+	Key   string `json:"key"`
+	Value []byte `json:"value"`
+}
+
+// Writer writes a gzip compressed archive of JSON lines, a Header followed by one line per Entry, whilst computing the
+// size and checksum of the compressed bytes
+type Writer struct {
+	gz      *gzip.Writer
+	enc     *json.Encoder
+	counter *counter
+	// Entries the number of entries written
+	Entries int64
+}
+
+// NewWriter writes the header to w and returns a Writer of the archive's entries
+func NewWriter(w io.Writer, header Header) (*Writer, error) {
+	header.Version = ArchiveVersion
+	c := newCounter()
+	gz := gzip.NewWriter(io.MultiWriter(w, c))
+	enc := json.NewEncoder(gz)
+	if err := enc.Encode(header); err != nil {
+		return nil, fmt.Errorf("unable to write archive header: %w", err)
+	}
+	return &Writer{gz: gz, enc: enc, counter: c}, nil
+}
+
+// Write appends the entry to the archive
+func (w *Writer) Write(entry *Entry) error {
+	if err := w.enc.Encode(entry); err != nil {
+		return fmt.Errorf("unable to write entry '%s' of rule '%s': %w", entry.Key, entry.Rule, err)
+	}
+	w.Entries++
+	return nil
+}
+
+// Close flushes the archive, it does not close the underlying io.Writer
+func (w *Writer) Close() error {
+	return w.gz.Close()
+}
+
+// Size returns the number of compressed bytes written
+func (w *Writer) Size() int64 {
+	return w.counter.n
+}
+
+// Checksum returns the checksum of the compressed bytes written, complete once the Writer is closed
+func (w *Writer) Checksum() string {
+	return w.counter.checksum()
+}
+
+// Reader reads the entries of an archive written by Writer
+type Reader struct {
+	header  Header
+	scanner *bufio.Scanner
+	counter *counter
+	// Entries the number of entries read
+	Entries int64
+}
+
+// maxLineSize the maximum size of an encoded Entry
+const maxLineSize = 64 * 1024 * 1024
+
+// NewReader reads the archive header from r
+func NewReader(r io.Reader) (*Reader, error) {
+	c := newCounter()
+	gz, err := gzip.NewReader(io.TeeReader(r, c))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read archive: %w", err)
+	}
+	scanner := bufio.NewScanner(gz)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+
+	reader := &Reader{scanner: scanner, counter: c}
+	if !scanner.Scan() {
+		return nil, fmt.Errorf("unable to read archive header: %w", scanErr(scanner))
+	}
+	if err := json.Unmarshal(scanner.Bytes(), &reader.header); err != nil {
+		return nil, fmt.Errorf("unable to decode archive header: %w", err)
+	}
+	if reader.header.Version != ArchiveVersion {
+		return nil, fmt.Errorf("unsupported archive version %d", reader.header.Version)
+	}
+	return reader, nil
+}
+
+// Header returns the archive header
+func (r *Reader) Header() Header {
+	return r.header
+}
+
+// Next returns the next entry of the archive, or io.EOF once all entries have been read
+func (r *Reader) Next() (*Entry, error) {
+	if !r.scanner.Scan() {
+		if err := r.scanner.Err(); err != nil {
+			return nil, fmt.Errorf("unable to read archive entry: %w", err)
+		}
+		return nil, io.EOF
+	}
+	entry := &Entry{}
+	if err := json.Unmarshal(r.scanner.Bytes(), entry); err != nil {
+		return nil, fmt.Errorf("unable to decode archive entry: %w", err)
+	}
+	r.Entries++
+	return entry, nil
+}
+
+// Size returns the number of compressed bytes read
+func (r *Reader) Size() int64 {
+	return r.counter.n
+}
+
+// Checksum returns the checksum of the compressed bytes read, complete once Next has returned io.EOF
+func (r *Reader) Checksum() string {
+	return r.counter.checksum()
+}
+
+// Checksum returns the checksum and size of the archive read from r
+func Checksum(r io.Reader) (string, int64, error) {
+	c := newCounter()
+	if _, err := io.Copy(c, r); err != nil {
+		return "", 0, fmt.Errorf("unable to read archive: %w", err)
+	}
+	return c.checksum(), c.n, nil
+}
+
+func scanErr(scanner *bufio.Scanner) error {
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	return errors.New("archive is empty")
+}
+
+// counter counts and hashes the bytes written to it
+type counter struct {
+	hash hash.Hash
+	n    int64
+}
+
+func newCounter() *counter {
+	return &counter{hash: sha256.New()}
+}
+
+func (c *counter) Write(p []byte) (int, error) {
+	c.n += int64(len(p))
+	return c.hash.Write(p)
+}
+
+func (c *counter) checksum() string {
+	return ChecksumPrefix + hex.EncodeToString(c.hash.Sum(nil))
+}
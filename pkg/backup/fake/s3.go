@@ -0,0 +1,82 @@
+// Package fake provides an in-memory S3-compatible object store for tests
+package fake
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+// S3Server stores the objects uploaded with the access key id it has been created with, requests signed with any other
+// credentials are rejected
+type S3Server struct {
+	*httptest.Server
+	accessKeyID string
+	mu          sync.RWMutex
+	objects     map[string][]byte
+}
+
+// NewS3Server starts a S3Server accepting requests signed with the given access key id
+func NewS3Server(accessKeyID string) *S3Server {
+	s := &S3Server{accessKeyID: accessKeyID, objects: map[string][]byte{}}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// Object returns the content of the object and whether it exists
+func (s *S3Server) Object(bucket, key string) ([]byte, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	object, ok := s.objects[objectPath(bucket, key)]
+	return object, ok
+}
+
+// PutObject stores the content of the object
+func (s *S3Server) PutObject(bucket, key string, content []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[objectPath(bucket, key)] = content
+}
+
+func (s *S3Server) serve(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential="+s.accessKeyID+"/") {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		hash := sha256.Sum256(body)
+		if r.Header.Get("x-amz-content-sha256") != hex.EncodeToString(hash[:]) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte("<Error><Code>XAmzContentSHA256Mismatch</Code></Error>"))
+			return
+		}
+		s.objects[r.URL.Path] = body
+	case http.MethodGet:
+		object, ok := s.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte("<Error><Code>NoSuchKey</Code></Error>"))
+			return
+		}
+		_, _ = w.Write(object)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func objectPath(bucket, key string) string {
+	return "/" + bucket + "/" + strings.TrimPrefix(key, "/")
+}
@@ -0,0 +1,219 @@
+package backup
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// emptyPayloadHash the SHA256 of an empty request body
+const emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+// S3Store stores an archive as an object of an S3-compatible object store. Requests are authenticated with AWS
+// Signature Version 4 and buckets are addressed path-style, as supported by MinIO and other S3-compatible stores
+type S3Store struct {
+	Endpoint        *url.URL
+	Region          string
+	Bucket          string
+	Key             string
+	AccessKeyID     string
+	SecretAccessKey string
+	// HTTPClient used to communicate with the object store. If nil, http.DefaultClient is used
+	HTTPClient *http.Client
+}
+
+func (s *S3Store) Writer(ctx context.Context) (ArchiveWriter, error) {
+	// The size and hash of the object must be signed before it is uploaded, so the archive is buffered to a temporary
+	// file
+	tmp, err := os.CreateTemp("", "gingersnap-backup-*.tmp")
+	if err != nil {
+		return nil, fmt.Errorf("unable to buffer archive: %w", err)
+	}
+	return &s3Writer{ctx: ctx, store: s, file: tmp, hash: sha256.New()}, nil
+}
+
+func (s *S3Store) Reader(ctx context.Context) (io.ReadCloser, error) {
+	req, err := s.request(ctx, http.MethodGet, nil, emptyPayloadHash)
+	if err != nil {
+		return nil, err
+	}
+	rsp, err := s.httpClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to download s3 object '%s': %w", s.Key, err)
+	}
+	if rsp.StatusCode != http.StatusOK {
+		defer rsp.Body.Close()
+		return nil, fmt.Errorf("unexpected s3 download response %d for object '%s': %s", rsp.StatusCode, s.Key, errorMessage(rsp))
+	}
+	return rsp.Body, nil
+}
+
+func (s *S3Store) request(ctx context.Context, method string, body io.Reader, payloadHash string) (*http.Request, error) {
+	u := *s.Endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + s.Bucket + "/" + strings.TrimPrefix(s.Key, "/")
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+	s.sign(req, payloadHash, time.Now())
+	return req, nil
+}
+
+// sign adds the AWS Signature Version 4 Authorization header to the request, signing the host and every header of the
+// request
+func (s *S3Store) sign(req *http.Request, payloadHash string, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("x-amz-date", amzDate)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		uriEncode(req.URL.Path, false),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", date, s.Region)
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hexSHA256([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.SecretAccessKey), date)
+	key = hmacSHA256(key, s.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s", s.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func (s *S3Store) httpClient() *http.Client {
+	if s.HTTPClient != nil {
+		return s.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+type s3Writer struct {
+	ctx   context.Context
+	store *S3Store
+	file  *os.File
+	hash  hash.Hash
+	size  int64
+}
+
+func (w *s3Writer) Write(p []byte) (int, error) {
+	n, err := w.file.Write(p)
+	w.hash.Write(p[:n])
+	w.size += int64(n)
+	return n, err
+}
+
+func (w *s3Writer) Commit() error {
+	defer w.Abort()
+	if _, err := w.file.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("unable to read buffered archive: %w", err)
+	}
+
+	req, err := w.store.request(w.ctx, http.MethodPut, w.file, hex.EncodeToString(w.hash.Sum(nil)))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = w.size
+	rsp, err := w.store.httpClient().Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to upload s3 object '%s': %w", w.store.Key, err)
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected s3 upload response %d for object '%s': %s", rsp.StatusCode, w.store.Key, errorMessage(rsp))
+	}
+	return nil
+}
+
+func (w *s3Writer) Abort() {
+	_ = w.file.Close()
+	_ = os.Remove(w.file.Name())
+}
+
+// errorMessage returns the start of the S3 error document of the response
+func errorMessage(rsp *http.Response) string {
+	body, _ := io.ReadAll(io.LimitReader(rsp.Body, 512))
+	return strings.TrimSpace(string(body))
+}
+
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var params []string
+	for _, k := range keys {
+		values := append([]string{}, query[k]...)
+		sort.Strings(values)
+		for _, v := range values {
+			params = append(params, uriEncode(k, true)+"="+uriEncode(v, true))
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+// uriEncode encodes every byte except the unreserved characters defined by RFC 3986, as required by Signature Version 4
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func hexSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -0,0 +1,68 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// AccessKeyIDKey the key of the S3 access key id in the BackupStorage credentials Secret
+	AccessKeyIDKey = "AWS_ACCESS_KEY_ID"
+	// SecretAccessKeyKey the key of the S3 secret access key in the BackupStorage credentials Secret
+	SecretAccessKeyKey = "AWS_SECRET_ACCESS_KEY"
+	// DefaultRegion the region of S3 buckets that do not configure one
+	DefaultRegion = "us-east-1"
+)
+
+// Store reads and writes backup archives
+type Store interface {
+	// Writer returns a writer of the archive
+	Writer(ctx context.Context) (ArchiveWriter, error)
+	// Reader returns a reader of the archive
+	Reader(ctx context.Context) (io.ReadCloser, error)
+}
+
+// ArchiveWriter writes an archive, which is only stored once committed
+type ArchiveWriter interface {
+	io.Writer
+	// Commit stores the archive, replacing any existing archive
+	Commit() error
+	// Abort discards the archive
+	Abort()
+}
+
+// NewStore returns the Store of the archive located by storage. credentials is the Secret referenced by the S3 storage
+func NewStore(storage *v1alpha1.BackupStorage, credentials *corev1.Secret) (Store, error) {
+	s3 := storage.S3
+	if s3 == nil {
+		return nil, fmt.Errorf("no backup storage configured")
+	}
+	endpoint, err := url.Parse(s3.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid S3 endpoint '%s': %w", s3.Endpoint, err)
+	}
+	if credentials == nil {
+		return nil, fmt.Errorf("S3 credentials Secret '%s' is required", s3.CredentialsSecret)
+	}
+	accessKeyID, secretAccessKey := string(credentials.Data[AccessKeyIDKey]), string(credentials.Data[SecretAccessKeyKey])
+	if accessKeyID == "" || secretAccessKey == "" {
+		return nil, fmt.Errorf("S3 credentials Secret '%s' must contain '%s' and '%s'", s3.CredentialsSecret, AccessKeyIDKey, SecretAccessKeyKey)
+	}
+	region := s3.Region
+	if region == "" {
+		region = DefaultRegion
+	}
+	return &S3Store{
+		Endpoint:        endpoint,
+		Region:          region,
+		Bucket:          s3.Bucket,
+		Key:             s3.Key,
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: secretAccessKey,
+	}, nil
+}
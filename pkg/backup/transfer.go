@@ -0,0 +1,124 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gingersnap-project/operator/pkg/cachemanager"
+)
+
+// Progress of a transfer, reported after each rule and every ProgressInterval entries
+type Progress struct {
+	// Rules the number of rules whose entries have been transferred
+	Rules int32
+	// Entries the number of entries transferred
+	Entries int64
+}
+
+// ProgressInterval the number of entries transferred between progress reports
+const ProgressInterval = 1000
+
+// Export writes the entries of the rules to the archive. The entries of each rule are read from every cache-manager
+// host, as the pods of a LOCAL Cache each cache their own entries, an entry cached by several hosts is only exported
+// once
+func Export(ctx context.Context, c *cachemanager.Client, hosts, rules []string, w *Writer, progress func(Progress)) error {
+	p := Progress{}
+	for _, rule := range rules {
+		exported := map[string]struct{}{}
+		for _, host := range hosts {
+			keys, err := c.Keys(ctx, host, rule)
+			if err != nil {
+				return err
+			}
+			for _, key := range keys {
+				if _, ok := exported[key]; ok {
+					continue
+				}
+				value, err := c.Get(ctx, host, rule, key)
+				if err != nil {
+					if errors.Is(err, cachemanager.ErrNotFound) {
+						// The entry was evicted or expired after its key was listed
+						continue
+					}
+					return err
+				}
+				if err := w.Write(&Entry{Rule: rule, Key: key, Value: value}); err != nil {
+					return err
+				}
+				exported[key] = struct{}{}
+				p.Entries++
+				if p.Entries%ProgressInterval == 0 {
+					progress(p)
+				}
+			}
+		}
+		p.Rules++
+		progress(p)
+	}
+	return nil
+}
+
+// Import writes the entries of the archive whose rule is contained in rules to every cache-manager host. The entries of
+// each rule must be contiguous, as written by Export
+func Import(ctx context.Context, c *cachemanager.Client, hosts, rules []string, r *Reader, progress func(Progress)) error {
+	include := make(map[string]struct{}, len(rules))
+	for _, rule := range rules {
+		include[rule] = struct{}{}
+	}
+
+	p := Progress{}
+	var current string
+	for {
+		entry, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if _, ok := include[entry.Rule]; !ok {
+			continue
+		}
+		if entry.Rule != current {
+			if current != "" {
+				p.Rules++
+				progress(p)
+			}
+			current = entry.Rule
+		}
+		for _, host := range hosts {
+			if err := c.Put(ctx, host, entry.Rule, entry.Key, entry.Value); err != nil {
+				return err
+			}
+		}
+		p.Entries++
+		if p.Entries%ProgressInterval == 0 {
+			progress(p)
+		}
+	}
+	// Rules without entries are complete as soon as the archive has been read
+	p.Rules = int32(len(rules))
+	progress(p)
+	return nil
+}
+
+// Verify reads the archive of the store, returning an error if its checksum does not match the expected checksum. The
+// size of the archive is returned
+func Verify(ctx context.Context, store Store, checksum string) (int64, error) {
+	reader, err := store.Reader(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer reader.Close()
+
+	actual, size, err := Checksum(reader)
+	if err != nil {
+		return 0, err
+	}
+	if actual != checksum {
+		return 0, fmt.Errorf("archive checksum '%s' does not match the expected checksum '%s'", actual, checksum)
+	}
+	return size, nil
+}
@@ -0,0 +1,112 @@
+package cachemanager
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultPort is the port of the cache-manager REST endpoint
+	DefaultPort = 8080
+	// RulesPath is the root of the cache-manager REST resources. GET <RulesPath>/<rule> returns a JSON array of the keys
+	// cached by the rule, GET and PUT <RulesPath>/<rule>/<key> read and write the value of an individual entry.
+	//
+	// NOTE: cache-manager does not provide these resources yet, they are a stand-in for the export and import contract
+	// proposed to the cache-manager project, and the client must be updated to match the API once it is implemented
+	RulesPath = "/rules"
+)
+
+// ErrNotFound is returned when an entry does not exist, e.g. as it was evicted after its key was listed
+var ErrNotFound = fmt.Errorf("cache entry not found")
+
+// Client reads and writes the entries of the rules served by cache-manager instances
+type Client struct {
+	// HTTPClient used to communicate with cache-manager. If nil, a client with a 30 second timeout is used
+	HTTPClient *http.Client
+	// Port of the cache-manager REST endpoint. If zero, DefaultPort is used
+	Port int
+}
+
+// Keys returns the keys of the entries cached by the rule on the cache-manager instance at host
+func (c *Client) Keys(ctx context.Context, host, rule string) ([]string, error) {
+	rsp, err := c.do(ctx, http.MethodGet, host, nil, rule)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve keys of rule '%s': %w", rule, err)
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected cache-manager keys response %d for rule '%s'", rsp.StatusCode, rule)
+	}
+
+	var keys []string
+	if err := json.NewDecoder(rsp.Body).Decode(&keys); err != nil {
+		return nil, fmt.Errorf("unable to decode keys of rule '%s': %w", rule, err)
+	}
+	return keys, nil
+}
+
+// Get returns the value of the rule's entry with the given key, or ErrNotFound if the entry does not exist
+func (c *Client) Get(ctx context.Context, host, rule, key string) ([]byte, error) {
+	rsp, err := c.do(ctx, http.MethodGet, host, nil, rule, key)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve entry '%s' of rule '%s': %w", key, rule, err)
+	}
+	defer rsp.Body.Close()
+
+	switch rsp.StatusCode {
+	case http.StatusOK:
+		return io.ReadAll(rsp.Body)
+	case http.StatusNotFound, http.StatusNoContent:
+		return nil, ErrNotFound
+	default:
+		return nil, fmt.Errorf("unexpected cache-manager entry response %d for rule '%s'", rsp.StatusCode, rule)
+	}
+}
+
+// Put writes the value of the rule's entry with the given key
+func (c *Client) Put(ctx context.Context, host, rule, key string, value []byte) error {
+	rsp, err := c.do(ctx, http.MethodPut, host, value, rule, key)
+	if err != nil {
+		return fmt.Errorf("unable to write entry '%s' of rule '%s': %w", key, rule, err)
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode < 200 || rsp.StatusCode > 299 {
+		return fmt.Errorf("unexpected cache-manager write response %d for rule '%s'", rsp.StatusCode, rule)
+	}
+	return nil
+}
+
+func (c *Client) do(ctx context.Context, method, host string, body []byte, segments ...string) (*http.Response, error) {
+	port := c.Port
+	if port == 0 {
+		port = DefaultPort
+	}
+	path := RulesPath
+	for _, s := range segments {
+		path += "/" + url.PathEscape(s)
+	}
+	u := fmt.Sprintf("http://%s%s", net.JoinHostPort(host, strconv.Itoa(port)), path)
+	req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/octet-stream")
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	return httpClient.Do(req)
+}
@@ -0,0 +1,63 @@
+package cachemanager_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gingersnap-project/operator/pkg/cachemanager"
+	"github.com/gingersnap-project/operator/pkg/cachemanager/fake"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestCacheManager(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Cache Manager Suite")
+}
+
+var _ = Describe("Client", func() {
+
+	var server *fake.Server
+	var client *cachemanager.Client
+	var host string
+
+	BeforeEach(func() {
+		server = fake.NewServer("us-east")
+		h, port := server.Host()
+		host = h
+		client = &cachemanager.Client{Port: port}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should list keys and read entries", func() {
+		server.Put("us-east", "1", `{"name":"a"}`)
+		server.Put("us-east", "key/with spaces", `{"name":"b"}`)
+
+		keys, err := client.Keys(context.TODO(), host, "us-east")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(keys).Should(ConsistOf("1", "key/with spaces"))
+
+		value, err := client.Get(context.TODO(), host, "us-east", "key/with spaces")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(value)).Should(Equal(`{"name":"b"}`))
+
+		_, err = client.Get(context.TODO(), host, "us-east", "2")
+		Expect(err).Should(MatchError(cachemanager.ErrNotFound))
+	})
+
+	It("should write entries", func() {
+		Expect(client.Put(context.TODO(), host, "us-east", "1", []byte("value"))).Should(Succeed())
+		Expect(server.Entries("us-east")).Should(Equal(map[string]string{"1": "value"}))
+	})
+
+	It("should fail for undefined rules", func() {
+		_, err := client.Keys(context.TODO(), host, "eu-west")
+		Expect(err).Should(MatchError(ContainSubstring("unexpected cache-manager keys response 404 for rule 'eu-west'")))
+
+		err = client.Put(context.TODO(), host, "eu-west", "1", []byte("value"))
+		Expect(err).Should(MatchError(ContainSubstring("unexpected cache-manager write response 404")))
+	})
+})
@@ -0,0 +1,111 @@
+// Package fake provides an in-memory cache-manager REST endpoint for tests
+package fake
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gingersnap-project/operator/pkg/cachemanager"
+)
+
+// Server serves the entries of the rules it has been created with, requests for any other rule return 404 as
+// cache-manager does for rules that are not defined
+type Server struct {
+	*httptest.Server
+	mu      sync.RWMutex
+	entries map[string]map[string][]byte
+}
+
+// NewServer starts a Server defining the given rules
+func NewServer(rules ...string) *Server {
+	s := &Server{entries: make(map[string]map[string][]byte, len(rules))}
+	for _, rule := range rules {
+		s.entries[rule] = map[string][]byte{}
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// Host returns the host and port of the Server
+func (s *Server) Host() (string, int) {
+	h, p, _ := net.SplitHostPort(s.Listener.Addr().String())
+	port, _ := strconv.Atoi(p)
+	return h, port
+}
+
+// Put writes the value of the rule's entry, defining the rule if necessary
+func (s *Server) Put(rule, key, value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.entries[rule] == nil {
+		s.entries[rule] = map[string][]byte{}
+	}
+	s.entries[rule][key] = []byte(value)
+}
+
+// Entries returns a copy of the entries of the rule
+func (s *Server) Entries(rule string) map[string]string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	entries := make(map[string]string, len(s.entries[rule]))
+	for k, v := range s.entries[rule] {
+		entries[k] = string(v)
+	}
+	return entries
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.EscapedPath(), cachemanager.RulesPath+"/")
+	var segments []string
+	for _, segment := range strings.Split(path, "/") {
+		unescaped, err := url.PathUnescape(segment)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		segments = append(segments, unescaped)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entries, ok := s.entries[segments[0]]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	switch {
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		keys := make([]string, 0, len(entries))
+		for k := range entries {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		_ = json.NewEncoder(w).Encode(keys)
+	case len(segments) == 2 && r.Method == http.MethodGet:
+		value, ok := entries[segments[1]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(value)
+	case len(segments) == 2 && r.Method == http.MethodPut:
+		value, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		entries[segments[1]] = value
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,170 @@
+package config
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	"github.com/gingersnap-project/operator/pkg/images"
+	"github.com/gingersnap-project/operator/pkg/kubernetes/client"
+	"github.com/gingersnap-project/operator/pkg/reconcile"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+// Change identifies a part of the operator configuration that affects the reconciled resources
+type Change int
+
+const (
+	// ImagesChanged the images of the cache-manager or db-syncer have changed
+	ImagesChanged Change = 1 << iota
+	// BackoffChanged the delay of requeues with backoff has changed
+	BackoffChanged
+)
+
+// Operator the operator configuration of the cluster-wide GingersnapConfig. It is reloaded by the GingersnapConfig
+// controller whenever the resource changes, so that reconcilers observe the current configuration without a restart.
+// A nil Operator returns the operator defaults
+type Operator struct {
+	// Level the level of the operator's logger, adjusted according to OperatorConfig.LogLevel
+	Level *zap.AtomicLevel
+	// DefaultLevel the level restored when OperatorConfig.LogLevel is not set
+	DefaultLevel zapcore.Level
+
+	mu           sync.RWMutex
+	loaded       bool
+	spec         v1alpha1.OperatorConfig
+	fieldManager string
+	subscribers  []subscriber
+}
+
+type subscriber struct {
+	changes Change
+	events  chan event.GenericEvent
+}
+
+// Update replaces the current configuration with spec, restoring the defaults if spec is nil. Subscribers are notified
+// of the changes affecting the reconciled resources. The field manager is only read by the first update, as changing
+// the manager of fields that have already been applied would orphan them
+func (o *Operator) Update(spec *v1alpha1.OperatorConfig) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	previous := o.spec
+	if spec == nil {
+		o.spec = v1alpha1.OperatorConfig{}
+	} else {
+		o.spec = *spec.DeepCopy()
+	}
+
+	if !o.loaded {
+		o.fieldManager = o.spec.FieldManager
+		if o.fieldManager == "" {
+			o.fieldManager = client.DefaultFieldManager
+		}
+	}
+
+	overrides := map[string]string{}
+	if i := o.spec.Images; i != nil {
+		overrides[images.CacheManagerMySQLEnvName] = i.CacheManagerMySQL
+		overrides[images.CacheManagerPostgresEnvName] = i.CacheManagerPostgres
+		overrides[images.CacheManagerMSSQLEnvName] = i.CacheManagerMSSQL
+		overrides[images.DBSyncerEnvName] = i.DBSyncer
+	}
+	images.Override(overrides)
+
+	if o.Level != nil {
+		level := o.DefaultLevel
+		if o.spec.LogLevel != "" {
+			// The LogLevel is validated by the GingersnapConfig webhook, so an invalid level is ignored
+			_ = level.UnmarshalText([]byte(o.spec.LogLevel))
+		}
+		o.Level.SetLevel(level)
+	}
+
+	// Resources are not reconciled before the configuration has been loaded, so only later updates are notified
+	if o.loaded {
+		var changes Change
+		if !equality.Semantic.DeepEqual(previous.Images, o.spec.Images) {
+			changes |= ImagesChanged
+		}
+		if !equality.Semantic.DeepEqual(previous.Backoff, o.spec.Backoff) {
+			changes |= BackoffChanged
+		}
+		o.notify(changes)
+	}
+	o.loaded = true
+}
+
+// Loaded returns true once the configuration has been loaded, either from the cluster-wide GingersnapConfig or the
+// defaults if none exists. Resources must not be reconciled before, so that they are applied by the configured field
+// manager
+func (o *Operator) Loaded() bool {
+	if o == nil {
+		return true
+	}
+
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.loaded
+}
+
+// Subscribe returns a channel that receives an event whenever an update makes any of the given changes, so that the
+// affected resources can be reconciled. Events are coalesced while the subscriber is busy. Must be called before the
+// manager is started
+func (o *Operator) Subscribe(changes Change) <-chan event.GenericEvent {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	events := make(chan event.GenericEvent, 1)
+	o.subscribers = append(o.subscribers, subscriber{changes: changes, events: events})
+	return events
+}
+
+func (o *Operator) notify(changes Change) {
+	for _, s := range o.subscribers {
+		if s.changes&changes == 0 {
+			continue
+		}
+		select {
+		case s.events <- event.GenericEvent{Object: &v1alpha1.GingersnapConfig{}}:
+		default:
+			// An event is already pending, which reconciles the resources with the current configuration
+		}
+	}
+}
+
+// Backoff returns the BackoffPolicy of requeues requested via reconcile.Context.RequeueWithBackoff
+func (o *Operator) Backoff() reconcile.BackoffPolicy {
+	policy := reconcile.DefaultBackoff
+	if o == nil {
+		return policy
+	}
+
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	if b := o.spec.Backoff; b != nil {
+		if d, err := time.ParseDuration(b.Initial); err == nil && d > 0 {
+			policy.Initial = d
+		}
+		if d, err := time.ParseDuration(b.Max); err == nil && d > 0 {
+			policy.Max = d
+		}
+	}
+	return policy
+}
+
+// FieldManager returns the field manager of the resources applied by the operator. It is fixed by the first load of
+// the configuration for the lifetime of the operator, a change only taking effect once the operator is restarted
+func (o *Operator) FieldManager() string {
+	if o == nil {
+		return client.DefaultFieldManager
+	}
+
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	if o.fieldManager != "" {
+		return o.fieldManager
+	}
+	return client.DefaultFieldManager
+}
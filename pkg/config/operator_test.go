@@ -0,0 +1,116 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	"github.com/gingersnap-project/operator/pkg/images"
+	"github.com/gingersnap-project/operator/pkg/kubernetes/client"
+	"github.com/gingersnap-project/operator/pkg/reconcile"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Config Suite")
+}
+
+var _ = Describe("Operator", func() {
+
+	AfterEach(func() {
+		// Images are overridden globally
+		images.Override(nil)
+	})
+
+	It("should return the defaults if nil", func() {
+		var o *Operator
+		Expect(o.Loaded()).Should(BeTrue())
+		Expect(o.Backoff()).Should(Equal(reconcile.DefaultBackoff))
+		Expect(o.FieldManager()).Should(Equal(client.DefaultFieldManager))
+	})
+
+	It("should only be loaded once updated", func() {
+		o := &Operator{}
+		Expect(o.Loaded()).Should(BeFalse())
+		Expect(o.FieldManager()).Should(Equal(client.DefaultFieldManager))
+
+		o.Update(nil)
+		Expect(o.Loaded()).Should(BeTrue())
+		Expect(o.Backoff()).Should(Equal(reconcile.DefaultBackoff))
+		Expect(o.FieldManager()).Should(Equal(client.DefaultFieldManager))
+	})
+
+	It("should apply the backoff, images and log level", func() {
+		level := zap.NewAtomicLevelAt(zapcore.InfoLevel)
+		o := &Operator{Level: &level, DefaultLevel: zapcore.InfoLevel}
+		o.Update(&v1alpha1.OperatorConfig{
+			Images:   &v1alpha1.ImagesConfig{DBSyncer: "quay.io/gingersnap/db-syncer:custom"},
+			Backoff:  &v1alpha1.BackoffConfig{Initial: "1s", Max: "invalid"},
+			LogLevel: "debug",
+		})
+
+		backoff := reconcile.DefaultBackoff
+		backoff.Initial = time.Second
+		Expect(o.Backoff()).Should(Equal(backoff))
+		Expect(images.DBSyncer()).Should(Equal("quay.io/gingersnap/db-syncer:custom"))
+		Expect(level.Level()).Should(Equal(zapcore.DebugLevel))
+
+		o.Update(nil)
+		Expect(o.Backoff()).Should(Equal(reconcile.DefaultBackoff))
+		Expect(images.DBSyncer()).ShouldNot(Equal("quay.io/gingersnap/db-syncer:custom"))
+		Expect(level.Level()).Should(Equal(zapcore.InfoLevel))
+	})
+
+	It("should fix the field manager on the first load", func() {
+		o := &Operator{}
+		o.Update(&v1alpha1.OperatorConfig{FieldManager: "first"})
+		Expect(o.FieldManager()).Should(Equal("first"))
+
+		o.Update(&v1alpha1.OperatorConfig{FieldManager: "second"})
+		Expect(o.FieldManager()).Should(Equal("first"))
+		o.Update(nil)
+		Expect(o.FieldManager()).Should(Equal("first"))
+	})
+
+	It("should notify the subscribers of the changes after the first load", func() {
+		o := &Operator{}
+		imagesChanged := o.Subscribe(ImagesChanged)
+		backoffChanged := o.Subscribe(BackoffChanged)
+		anyChanged := o.Subscribe(ImagesChanged | BackoffChanged)
+
+		config := &v1alpha1.OperatorConfig{Images: &v1alpha1.ImagesConfig{DBSyncer: "db-syncer:1"}}
+		o.Update(config)
+		Expect(imagesChanged).ShouldNot(Receive())
+		Expect(anyChanged).ShouldNot(Receive())
+
+		By("ignoring changes that do not affect the resources")
+		config.LogLevel = "debug"
+		config.FieldManager = "other"
+		o.Update(config)
+		Expect(imagesChanged).ShouldNot(Receive())
+		Expect(backoffChanged).ShouldNot(Receive())
+		Expect(anyChanged).ShouldNot(Receive())
+
+		By("notifying image changes")
+		config.Images.DBSyncer = "db-syncer:2"
+		o.Update(config)
+		Expect(imagesChanged).Should(Receive())
+		Expect(backoffChanged).ShouldNot(Receive())
+		Expect(anyChanged).Should(Receive())
+
+		By("coalescing the changes of consecutive updates")
+		config.Backoff = &v1alpha1.BackoffConfig{Initial: "1s"}
+		o.Update(config)
+		config.Backoff = &v1alpha1.BackoffConfig{Initial: "2s"}
+		o.Update(config)
+		Expect(imagesChanged).ShouldNot(Receive())
+		Expect(backoffChanged).Should(Receive())
+		Expect(backoffChanged).ShouldNot(Receive())
+		Expect(anyChanged).Should(Receive())
+		Expect(anyChanged).ShouldNot(Receive())
+	})
+})
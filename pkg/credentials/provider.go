@@ -0,0 +1,27 @@
+package credentials
+
+import (
+	"context"
+	"time"
+)
+
+// Credentials retrieved from an external secret store
+type Credentials struct {
+	// Data contains the key/value pairs that should be added to the data source binding Secret
+	Data map[string]string
+	// Version identifies the revision of the credentials, e.g. a KV secret version or a dynamic secret lease ID
+	Version string
+	// TTL the duration for which the credentials are valid. Zero if the credentials do not expire
+	TTL time.Duration
+}
+
+// Provider retrieves data source credentials from an external secret store
+type Provider interface {
+	// Credentials returns the current data source credentials
+	Credentials(ctx context.Context) (*Credentials, error)
+	// Renew extends the validity of the credentials with the given Version, returning their new TTL. Zero is returned
+	// if the credentials cannot be extended any further
+	Renew(ctx context.Context, version string) (time.Duration, error)
+	// Revoke invalidates the credentials with the given Version
+	Revoke(ctx context.Context, version string) error
+}
@@ -0,0 +1,240 @@
+package vault
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gingersnap-project/operator/pkg/credentials"
+)
+
+const (
+	// DefaultAuthPath is the default mount path of the Vault Kubernetes auth method
+	DefaultAuthPath = "kubernetes"
+	// DefaultTimeout is the timeout of requests to Vault when HTTPClient is not configured
+	DefaultTimeout = 30 * time.Second
+)
+
+// defaultHTTPClient bounds every request, so that an unresponsive Vault server cannot block a reconciliation
+var defaultHTTPClient = &http.Client{Timeout: DefaultTimeout}
+
+// Engine is the Vault secrets engine serving the credentials
+type Engine int
+
+const (
+	// EngineKV reads static credentials from a KV version 1 or version 2 secrets engine
+	EngineKV Engine = iota
+	// EngineDatabase generates dynamic credentials from the database secrets engine
+	EngineDatabase
+)
+
+var _ credentials.Provider = &Provider{}
+
+// Provider retrieves data source credentials from Vault's HTTP API
+type Provider struct {
+	// Address of the Vault server
+	Address string
+	// Engine serving the secret at Path
+	Engine Engine
+	// Path of the secret to read
+	Path string
+	// Token used to authenticate requests. If empty, the Kubernetes auth method is used to obtain a token
+	Token string
+	// Role used to authenticate with the Kubernetes auth method
+	Role string
+	// AuthPath is the mount path of the Kubernetes auth method
+	AuthPath string
+	// JWT returns the ServiceAccount token used to authenticate with the Kubernetes auth method
+	JWT func() (string, error)
+	// HTTPClient used to communicate with Vault. If nil, a client with the DefaultTimeout is used
+	HTTPClient *http.Client
+}
+
+// response is the subset of Vault's API response envelope used by the provider
+type response struct {
+	LeaseID       string          `json:"lease_id"`
+	LeaseDuration int             `json:"lease_duration"`
+	Data          json.RawMessage `json:"data"`
+	Auth          *struct {
+		ClientToken string `json:"client_token"`
+	} `json:"auth"`
+	Errors []string `json:"errors"`
+}
+
+// kvV2Data is the data envelope returned by the KV version 2 secrets engine
+type kvV2Data struct {
+	Data     map[string]interface{} `json:"data"`
+	Metadata *struct {
+		Version int `json:"version"`
+	} `json:"metadata"`
+}
+
+func (p *Provider) Credentials(ctx context.Context) (*credentials.Credentials, error) {
+	token, err := p.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := p.do(ctx, http.MethodGet, p.Path, token, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read Vault secret '%s': %w", p.Path, err)
+	}
+
+	switch p.Engine {
+	case EngineDatabase:
+		data, err := stringMap(rsp.Data)
+		if err != nil {
+			return nil, err
+		}
+		return &credentials.Credentials{
+			Data:    data,
+			Version: rsp.LeaseID,
+			TTL:     time.Duration(rsp.LeaseDuration) * time.Second,
+		}, nil
+	default:
+		// KV v2 wraps the secret in a data/metadata envelope, whereas KV v1 returns the secret directly
+		v2 := &kvV2Data{}
+		if err := json.Unmarshal(rsp.Data, v2); err == nil && v2.Metadata != nil && v2.Data != nil {
+			b, _ := json.Marshal(v2.Data)
+			data, err := stringMap(b)
+			if err != nil {
+				return nil, err
+			}
+			return &credentials.Credentials{
+				Data:    data,
+				Version: strconv.Itoa(v2.Metadata.Version),
+			}, nil
+		}
+		data, err := stringMap(rsp.Data)
+		if err != nil {
+			return nil, err
+		}
+		return &credentials.Credentials{Data: data}, nil
+	}
+}
+
+// Renew extends the lease of dynamic credentials, returning the TTL granted by Vault. The TTL is shorter than requested
+// once the lease approaches its maximum TTL
+func (p *Provider) Renew(ctx context.Context, version string) (time.Duration, error) {
+	token, err := p.token(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	body, _ := json.Marshal(map[string]string{"lease_id": version})
+	rsp, err := p.do(ctx, http.MethodPut, "sys/leases/renew", token, body)
+	if err != nil {
+		return 0, fmt.Errorf("unable to renew Vault lease '%s': %w", version, err)
+	}
+	return time.Duration(rsp.LeaseDuration) * time.Second, nil
+}
+
+// Revoke revokes the lease of dynamic credentials, so that Vault removes the credentials from the database
+func (p *Provider) Revoke(ctx context.Context, version string) error {
+	token, err := p.token(ctx)
+	if err != nil {
+		return err
+	}
+
+	body, _ := json.Marshal(map[string]string{"lease_id": version})
+	if _, err := p.do(ctx, http.MethodPut, "sys/leases/revoke", token, body); err != nil {
+		return fmt.Errorf("unable to revoke Vault lease '%s': %w", version, err)
+	}
+	return nil
+}
+
+func (p *Provider) token(ctx context.Context) (string, error) {
+	if p.Token != "" {
+		return p.Token, nil
+	}
+
+	if p.Role == "" {
+		return "", fmt.Errorf("a Vault token or Kubernetes auth role must be provided")
+	}
+
+	if p.JWT == nil {
+		return "", fmt.Errorf("a ServiceAccount token must be provided for the Kubernetes auth role '%s'", p.Role)
+	}
+	jwt, err := p.JWT()
+	if err != nil {
+		return "", fmt.Errorf("unable to read ServiceAccount token for Vault Kubernetes auth: %w", err)
+	}
+
+	authPath := p.AuthPath
+	if authPath == "" {
+		authPath = DefaultAuthPath
+	}
+	body, _ := json.Marshal(map[string]string{"role": p.Role, "jwt": jwt})
+	rsp, err := p.do(ctx, http.MethodPost, fmt.Sprintf("auth/%s/login", strings.Trim(authPath, "/")), "", body)
+	if err != nil {
+		return "", fmt.Errorf("unable to login to Vault with role '%s': %w", p.Role, err)
+	}
+	if rsp.Auth == nil || rsp.Auth.ClientToken == "" {
+		return "", fmt.Errorf("vault login with role '%s' did not return a client token", p.Role)
+	}
+	return rsp.Auth.ClientToken, nil
+}
+
+func (p *Provider) do(ctx context.Context, method, path, token string, body []byte) (*response, error) {
+	url := fmt.Sprintf("%s/v1/%s", strings.TrimSuffix(p.Address, "/"), strings.TrimPrefix(path, "/"))
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	httpClient := p.HTTPClient
+	if httpClient == nil {
+		httpClient = defaultHTTPClient
+	}
+	httpRsp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer httpRsp.Body.Close()
+
+	b, err := io.ReadAll(httpRsp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &response{}
+	if len(b) > 0 {
+		if err := json.Unmarshal(b, rsp); err != nil {
+			return nil, fmt.Errorf("unable to decode Vault response: %w", err)
+		}
+	}
+	if httpRsp.StatusCode < http.StatusOK || httpRsp.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("unexpected Vault response status %d: %s", httpRsp.StatusCode, strings.Join(rsp.Errors, ", "))
+	}
+	return rsp, nil
+}
+
+func stringMap(b []byte) (map[string]string, error) {
+	raw := map[string]interface{}{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, fmt.Errorf("unable to decode Vault secret data: %w", err)
+	}
+	data := make(map[string]string, len(raw))
+	for k, v := range raw {
+		switch v := v.(type) {
+		case string:
+			data[k] = v
+		default:
+			encoded, _ := json.Marshal(v)
+			data[k] = string(encoded)
+		}
+	}
+	return data, nil
+}
@@ -0,0 +1,191 @@
+package vault_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gingersnap-project/operator/pkg/credentials/vault"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestVault(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Vault Suite")
+}
+
+const (
+	rootToken  = "root"
+	loginToken = "k8s-token"
+	jwt        = "service-account-jwt"
+)
+
+// devServer is a minimal stand-in for a Vault dev server exposing the KV v1, KV v2, database and Kubernetes auth APIs
+func devServer() *httptest.Server {
+	write := func(w http.ResponseWriter, body interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(body)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/auth/kubernetes/login", func(w http.ResponseWriter, r *http.Request) {
+		req := map[string]string{}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if r.Method != http.MethodPost || req["role"] != "gingersnap" || req["jwt"] != jwt {
+			w.WriteHeader(http.StatusForbidden)
+			write(w, map[string]interface{}{"errors": []string{"permission denied"}})
+			return
+		}
+		write(w, map[string]interface{}{"auth": map[string]interface{}{"client_token": loginToken}})
+	})
+
+	authenticated := func(h http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			token := r.Header.Get("X-Vault-Token")
+			if token != rootToken && token != loginToken {
+				w.WriteHeader(http.StatusForbidden)
+				write(w, map[string]interface{}{"errors": []string{"permission denied"}})
+				return
+			}
+			h(w, r)
+		}
+	}
+
+	mux.HandleFunc("/v1/secret/data/db", authenticated(func(w http.ResponseWriter, r *http.Request) {
+		write(w, map[string]interface{}{
+			"data": map[string]interface{}{
+				"data":     map[string]interface{}{"username": "kv2-user", "password": "kv2-pass", "port": 3306},
+				"metadata": map[string]interface{}{"version": 3},
+			},
+		})
+	}))
+
+	mux.HandleFunc("/v1/kv/db", authenticated(func(w http.ResponseWriter, r *http.Request) {
+		write(w, map[string]interface{}{
+			"data": map[string]interface{}{"username": "kv1-user", "password": "kv1-pass"},
+		})
+	}))
+
+	mux.HandleFunc("/v1/database/creds/gingersnap", authenticated(func(w http.ResponseWriter, r *http.Request) {
+		write(w, map[string]interface{}{
+			"lease_id":       "database/creds/gingersnap/abc",
+			"lease_duration": 3600,
+			"renewable":      true,
+			"data":           map[string]interface{}{"username": "v-token-gingersnap", "password": "dynamic-pass"},
+		})
+	}))
+
+	leases := map[string]int{"database/creds/gingersnap/abc": 3600}
+	mux.HandleFunc("/v1/sys/leases/renew", authenticated(func(w http.ResponseWriter, r *http.Request) {
+		req := map[string]string{}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		duration, ok := leases[req["lease_id"]]
+		if r.Method != http.MethodPut || !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			write(w, map[string]interface{}{"errors": []string{"lease not found"}})
+			return
+		}
+		write(w, map[string]interface{}{"lease_id": req["lease_id"], "lease_duration": duration, "renewable": true})
+	}))
+
+	mux.HandleFunc("/v1/sys/leases/revoke", authenticated(func(w http.ResponseWriter, r *http.Request) {
+		req := map[string]string{}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if _, ok := leases[req["lease_id"]]; r.Method != http.MethodPut || !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			write(w, map[string]interface{}{"errors": []string{"lease not found"}})
+			return
+		}
+		delete(leases, req["lease_id"])
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	return httptest.NewServer(mux)
+}
+
+var _ = Describe("Vault Provider", func() {
+
+	var server *httptest.Server
+
+	BeforeEach(func() {
+		server = devServer()
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should read KV version 2 secrets", func() {
+		p := &vault.Provider{Address: server.URL, Engine: vault.EngineKV, Path: "secret/data/db", Token: rootToken}
+		creds, err := p.Credentials(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(creds.Data).To(Equal(map[string]string{"username": "kv2-user", "password": "kv2-pass", "port": "3306"}))
+		Expect(creds.Version).To(Equal("3"))
+		Expect(creds.TTL).To(BeZero())
+	})
+
+	It("should read KV version 1 secrets", func() {
+		p := &vault.Provider{Address: server.URL, Engine: vault.EngineKV, Path: "kv/db", Token: rootToken}
+		creds, err := p.Credentials(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(creds.Data).To(Equal(map[string]string{"username": "kv1-user", "password": "kv1-pass"}))
+		Expect(creds.Version).To(BeEmpty())
+	})
+
+	It("should generate database dynamic secrets using Kubernetes auth", func() {
+		p := &vault.Provider{
+			Address: server.URL + "/",
+			Engine:  vault.EngineDatabase,
+			Path:    "/database/creds/gingersnap",
+			Role:    "gingersnap",
+			JWT: func() (string, error) {
+				return jwt, nil
+			},
+		}
+		creds, err := p.Credentials(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(creds.Data).To(Equal(map[string]string{"username": "v-token-gingersnap", "password": "dynamic-pass"}))
+		Expect(creds.Version).To(Equal("database/creds/gingersnap/abc"))
+		Expect(creds.TTL).To(Equal(time.Hour))
+	})
+
+	It("should fail if Kubernetes auth is rejected", func() {
+		p := &vault.Provider{
+			Address: server.URL,
+			Path:    "secret/data/db",
+			Role:    "unknown",
+			JWT: func() (string, error) {
+				return jwt, nil
+			},
+		}
+		_, err := p.Credentials(context.Background())
+		Expect(err).To(MatchError(ContainSubstring("unable to login to Vault with role 'unknown'")))
+		Expect(err).To(MatchError(ContainSubstring("permission denied")))
+	})
+
+	It("should renew and revoke the lease of dynamic secrets", func() {
+		p := &vault.Provider{Address: server.URL, Engine: vault.EngineDatabase, Path: "database/creds/gingersnap", Token: rootToken}
+		ttl, err := p.Renew(context.Background(), "database/creds/gingersnap/abc")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ttl).To(Equal(time.Hour))
+
+		Expect(p.Revoke(context.Background(), "database/creds/gingersnap/abc")).To(Succeed())
+		_, err = p.Renew(context.Background(), "database/creds/gingersnap/abc")
+		Expect(err).To(MatchError(ContainSubstring("lease not found")))
+	})
+
+	It("should fail if no ServiceAccount token is provided for Kubernetes auth", func() {
+		p := &vault.Provider{Address: server.URL, Path: "secret/data/db", Role: "gingersnap"}
+		_, err := p.Credentials(context.Background())
+		Expect(err).To(MatchError("a ServiceAccount token must be provided for the Kubernetes auth role 'gingersnap'"))
+	})
+
+	It("should fail if no authentication is configured", func() {
+		p := &vault.Provider{Address: server.URL, Path: "secret/data/db"}
+		_, err := p.Credentials(context.Background())
+		Expect(err).To(MatchError("a Vault token or Kubernetes auth role must be provided"))
+	})
+})
@@ -0,0 +1,109 @@
+package dbsyncer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultPort is the HTTP port exposed by db-syncer for health checks and status reporting
+	DefaultPort = 8080
+	// StatusPath is the endpoint reporting the replication status of every rule served by a db-syncer instance
+	StatusPath = "/rules/status"
+	// LagPath is the endpoint reporting the total replication lag, in seconds, of all tables replicated by the db-syncer
+	// cluster as a JSON object containing the LagValueLocation field.
+	//
+	// NOTE: db-syncer does not expose this endpoint yet. The path and response format are a stand-in for the contract
+	// proposed to the db-syncer project and must be updated to match the endpoint once it is implemented
+	LagPath = "/rules/lag"
+	// LagValueLocation the field of the LagPath response containing the replication lag
+	LagValueLocation = "lagSeconds"
+	// LagMetric is the custom metric reporting the total replication lag, in seconds, of the tables replicated by a
+	// db-syncer pod.
+	//
+	// NOTE: db-syncer does not publish this metric yet, the name is a stand-in for the proposed metric. Debezium's own
+	// MilliSecondsBehindSource streaming metric is only available via JMX and is reported in milliseconds, so it cannot
+	// be used as a drop-in replacement
+	LagMetric = "gingersnap_db_syncer_replication_lag_seconds"
+)
+
+// SnapshotState the state of a rule's table snapshot
+type SnapshotState string
+
+const (
+	SnapshotPending    SnapshotState = "Pending"
+	SnapshotInProgress SnapshotState = "InProgress"
+	SnapshotCompleted  SnapshotState = "Completed"
+	SnapshotFailed     SnapshotState = "Failed"
+)
+
+// RuleStatus the replication status of an individual rule as reported by db-syncer
+type RuleStatus struct {
+	// LagMillis the time between a change being committed to the database and it being applied to the cache
+	LagMillis int64 `json:"lagMillis"`
+	// LastProcessedChange the commit time of the most recent change applied to the cache
+	LastProcessedChange *time.Time `json:"lastProcessedChange,omitempty"`
+	// Snapshot progress of the rule's table snapshot
+	Snapshot *Snapshot `json:"snapshot,omitempty"`
+}
+
+// Lag returns the replication lag as a time.Duration
+func (s *RuleStatus) Lag() time.Duration {
+	return time.Duration(s.LagMillis) * time.Millisecond
+}
+
+// Snapshot the progress of a table snapshot
+type Snapshot struct {
+	State         SnapshotState `json:"state"`
+	ProcessedRows int64         `json:"processedRows"`
+	TotalRows     int64         `json:"totalRows"`
+	// ResyncGeneration the rule's resync generation that triggered the snapshot
+	ResyncGeneration int64 `json:"resyncGeneration"`
+}
+
+// Client retrieves status information from db-syncer instances
+type Client struct {
+	// HTTPClient used to communicate with db-syncer. If nil, a client with a 5 second timeout is used
+	HTTPClient *http.Client
+	// Port of the db-syncer HTTP endpoint. If zero, DefaultPort is used
+	Port int
+}
+
+// RuleStatuses returns the replication status of all rules served by the db-syncer instance at host, keyed by the
+// rule's key in the rule ConfigMap, see v1alpha1.CacheService.RuleKey
+func (c *Client) RuleStatuses(ctx context.Context, host string) (map[string]RuleStatus, error) {
+	port := c.Port
+	if port == 0 {
+		port = DefaultPort
+	}
+	url := fmt.Sprintf("http://%s%s", net.JoinHostPort(host, strconv.Itoa(port)), StatusPath)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 5 * time.Second}
+	}
+	rsp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve db-syncer status: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected db-syncer status response %d", rsp.StatusCode)
+	}
+
+	statuses := map[string]RuleStatus{}
+	if err := json.NewDecoder(rsp.Body).Decode(&statuses); err != nil {
+		return nil, fmt.Errorf("unable to decode db-syncer status: %w", err)
+	}
+	return statuses, nil
+}
@@ -0,0 +1,75 @@
+package dbsyncer_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gingersnap-project/operator/pkg/dbsyncer"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestDBSyncer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "DB Syncer Suite")
+}
+
+var _ = Describe("Client", func() {
+
+	var server *httptest.Server
+	var client *dbsyncer.Client
+	var host string
+
+	serve := func(status int, body string) {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Path).Should(Equal(dbsyncer.StatusPath))
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(body))
+		}))
+
+		h, p, err := net.SplitHostPort(server.Listener.Addr().String())
+		Expect(err).ShouldNot(HaveOccurred())
+		port, err := strconv.Atoi(p)
+		Expect(err).ShouldNot(HaveOccurred())
+		host = h
+		client = &dbsyncer.Client{Port: port}
+	}
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should decode rule statuses", func() {
+		serve(http.StatusOK, `{
+			"rule-a": {"lagMillis": 1500, "lastProcessedChange": "2022-10-01T10:00:00Z", "snapshot": {"state": "Completed", "processedRows": 10, "totalRows": 10, "resyncGeneration": 2}},
+			"rule-b": {"lagMillis": 0}
+		}`)
+
+		statuses, err := client.RuleStatuses(context.TODO(), host)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(statuses).Should(HaveLen(2))
+
+		a := statuses["rule-a"]
+		Expect(a.Lag()).Should(Equal(1500 * time.Millisecond))
+		Expect(a.LastProcessedChange.Equal(time.Date(2022, 10, 1, 10, 0, 0, 0, time.UTC))).Should(BeTrue())
+		Expect(a.Snapshot.State).Should(Equal(dbsyncer.SnapshotCompleted))
+		Expect(a.Snapshot.ProcessedRows).Should(Equal(int64(10)))
+		Expect(a.Snapshot.ResyncGeneration).Should(Equal(int64(2)))
+
+		b := statuses["rule-b"]
+		Expect(b.LastProcessedChange).Should(BeNil())
+		Expect(b.Snapshot).Should(BeNil())
+	})
+
+	It("should fail on an unexpected response status", func() {
+		serve(http.StatusServiceUnavailable, "")
+
+		_, err := client.RuleStatuses(context.TODO(), host)
+		Expect(err).Should(MatchError(ContainSubstring("503")))
+	})
+})
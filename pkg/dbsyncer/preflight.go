@@ -0,0 +1,61 @@
+package dbsyncer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	// PreflightEnvName is the environment variable that starts db-syncer in preflight mode. Instead of replicating
+	// changes, db-syncer verifies the CDC prerequisites of the bound database, writes a PreflightResult to its
+	// termination message and exits with a non-zero status if any check fails
+	PreflightEnvName = "GINGERSNAP_PREFLIGHT"
+	// PreflightTableEnvName is the environment variable containing the table whose CDC prerequisites are checked
+	PreflightTableEnvName = "GINGERSNAP_PREFLIGHT_TABLE"
+)
+
+// PreflightResult the outcome of a db-syncer CDC preflight check
+type PreflightResult struct {
+	Checks []PreflightCheck `json:"checks"`
+}
+
+// PreflightCheck the outcome of an individual CDC prerequisite check, e.g. MySQL binlog_format
+type PreflightCheck struct {
+	Name    string `json:"name"`
+	Passed  bool   `json:"passed"`
+	Message string `json:"message,omitempty"`
+}
+
+// Failed returns the checks that did not pass
+func (r *PreflightResult) Failed() []PreflightCheck {
+	var failed []PreflightCheck
+	for _, c := range r.Checks {
+		if !c.Passed {
+			failed = append(failed, c)
+		}
+	}
+	return failed
+}
+
+// String returns a human-readable summary of the failed checks
+func (r *PreflightResult) String() string {
+	var msgs []string
+	for _, c := range r.Failed() {
+		if c.Message == "" {
+			msgs = append(msgs, c.Name)
+		} else {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", c.Name, c.Message))
+		}
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// ParsePreflightResult parses the termination message written by db-syncer in preflight mode
+func ParsePreflightResult(msg string) (*PreflightResult, error) {
+	result := &PreflightResult{}
+	if err := json.Unmarshal([]byte(msg), result); err != nil {
+		return nil, fmt.Errorf("unable to parse db-syncer preflight result: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,26 @@
+package dbsyncer_test
+
+import (
+	"github.com/gingersnap-project/operator/pkg/dbsyncer"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PreflightResult", func() {
+
+	It("should report failed checks", func() {
+		result, err := dbsyncer.ParsePreflightResult(`{"checks": [
+			{"name": "binlog_format", "passed": false, "message": "expected ROW, found STATEMENT"},
+			{"name": "binlog_row_image", "passed": true},
+			{"name": "privileges", "passed": false}
+		]}`)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Failed()).Should(HaveLen(2))
+		Expect(result.String()).Should(Equal("binlog_format: expected ROW, found STATEMENT; privileges"))
+	})
+
+	It("should fail on an invalid termination message", func() {
+		_, err := dbsyncer.ParsePreflightResult("Connection refused")
+		Expect(err).Should(HaveOccurred())
+	})
+})
@@ -1,6 +1,9 @@
 package images
 
-import "os"
+import (
+	"os"
+	"sync"
+)
 
 const (
 	CacheManagerMSSQLEnvName    = "RELATED_IMAGE_CACHE_MANAGER_MSSQL"
@@ -10,8 +13,45 @@ const (
 )
 
 var (
-	CacheManagerMSSQL    = os.Getenv(CacheManagerMSSQLEnvName)
-	CacheManagerMySQL    = os.Getenv(CacheManagerMySQLEnvName)
-	CacheManagerPostgres = os.Getenv(CacheManagerPostgresEnvName)
-	DBSyncer             = os.Getenv(DBSyncerEnvName)
+	mu        sync.RWMutex
+	overrides map[string]string
 )
+
+func CacheManagerMSSQL() string {
+	return image(CacheManagerMSSQLEnvName)
+}
+
+func CacheManagerMySQL() string {
+	return image(CacheManagerMySQLEnvName)
+}
+
+func CacheManagerPostgres() string {
+	return image(CacheManagerPostgresEnvName)
+}
+
+func DBSyncer() string {
+	return image(DBSyncerEnvName)
+}
+
+// Override replaces the images configured via the RELATED_IMAGE_ environment variables, keyed by the name of the
+// variable. Images without an override revert to the value of their environment variable
+func Override(images map[string]string) {
+	o := make(map[string]string, len(images))
+	for k, v := range images {
+		if v != "" {
+			o[k] = v
+		}
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	overrides = o
+}
+
+func image(envName string) string {
+	mu.RLock()
+	defer mu.RUnlock()
+	if img, ok := overrides[envName]; ok {
+		return img
+	}
+	return os.Getenv(envName)
+}
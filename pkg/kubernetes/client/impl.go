@@ -2,47 +2,140 @@ package client
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/go-logr/logr"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	metav1apply "k8s.io/client-go/applyconfigurations/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
 	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-const fieldManager = "infinispan-operator"
+// DefaultFieldManager the field manager of write operations that do not specify FieldManager
+const DefaultFieldManager = "infinispan-operator"
 
 var _ Client = &Runtime{}
 
 // Runtime is a Client implementation based upon the controller-runtime client
 type Runtime struct {
 	record.EventRecorder
-	Client    runtimeClient.Client
-	Ctx       context.Context
-	Namespace string
-	Owner     runtimeClient.Object
-	Scheme    *runtime.Scheme
+	Client runtimeClient.Client
+	Ctx    context.Context
+	// FieldManager the field manager of write operations that do not specify one, DefaultFieldManager if empty
+	FieldManager string
+	Log          logr.Logger
+	Namespace    string
+	Owner        runtimeClient.Object
+	Scheme       *runtime.Scheme
+	// ServiceAccounts used to request ServiceAccount tokens, tokens cannot be requested if nil
+	ServiceAccounts corev1client.ServiceAccountsGetter
+	// WatchNamespaces the namespaces watched by the operator, all namespaces if empty
+	WatchNamespaces []string
 }
 
-func (c *Runtime) Apply(obj interface{}) error {
+func (c *Runtime) Apply(obj interface{}, opts ...func(config *Config)) (OperationResult, error) {
+	_, result, err := c.apply(obj, opts...)
+	return result, err
+}
+
+func (c *Runtime) ApplyInto(apply interface{}, obj runtimeClient.Object, opts ...func(config *Config)) (OperationResult, error) {
+	live, result, err := c.apply(apply, opts...)
+	if err != nil {
+		return result, err
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(live.Object, obj); err != nil {
+		return result, fmt.Errorf("unable to convert applied %s '%s': %w", live.GetKind(), live.GetName(), err)
+	}
+	return result, nil
+}
+
+// apply executes the Server Side apply. If the OperationResult is requested via DetermineResult, it is determined by
+// comparing the live resource returned by the apply with the resource observed before the apply, ignoring the status
+// and server maintained metadata. A resource observed from a stale cache may be reported as updated
+func (c *Runtime) apply(obj interface{}, opts ...func(config *Config)) (*unstructured.Unstructured, OperationResult, error) {
+	config := c.writeConfig(opts...)
 	// First convert to unstructured so that default values are emitted from the struct
 	unstr, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
 	if err != nil {
-		return err
+		return nil, OperationResultNone, err
 	}
 
 	patch := &unstructured.Unstructured{
 		Object: unstr,
 	}
 
-	patchOptions := &runtimeClient.PatchOptions{Force: pointer.Bool(true), FieldManager: fieldManager}
+	var existing *unstructured.Unstructured
+	if config.DetermineResult() {
+		existing = &unstructured.Unstructured{}
+		existing.SetGroupVersionKind(patch.GroupVersionKind())
+		err = c.Client.Get(c.Ctx, runtimeClient.ObjectKeyFromObject(patch), existing)
+		if runtimeClient.IgnoreNotFound(err) != nil {
+			return nil, OperationResultNone, err
+		}
+		if err != nil {
+			existing = nil
+		}
+	}
+
+	patchOptions := &runtimeClient.PatchOptions{Force: pointer.Bool(true), FieldManager: config.FieldManager()}
+	if config.DryRun() {
+		patchOptions.DryRun = []string{metav1.DryRunAll}
+	}
 	if err := c.Client.Patch(c.Ctx, patch, runtimeClient.Apply, patchOptions); err != nil {
-		return err
+		return nil, OperationResultNone, err
+	}
+
+	result := OperationResultApplied
+	if config.DetermineResult() {
+		if existing == nil {
+			result = OperationResultCreated
+		} else if equality.Semantic.DeepEqual(withoutServerFields(existing), withoutServerFields(patch)) {
+			result = OperationResultNone
+		} else {
+			result = OperationResultUpdated
+		}
 	}
-	return nil
+	c.logResult(patch, result, config)
+	return patch, result, nil
+}
+
+func (c *Runtime) logResult(obj *unstructured.Unstructured, result OperationResult, config *Config) {
+	if c.Log.GetSink() == nil {
+		return
+	}
+	log := c.Log
+	// Applied resources may not have changed, so they are only logged alongside those known to be unchanged
+	if result == OperationResultNone || result == OperationResultApplied {
+		log = log.V(1)
+	}
+	name := obj.GetName()
+	if obj.GetNamespace() != "" {
+		name = obj.GetNamespace() + "/" + name
+	}
+	msg := fmt.Sprintf("%s %s has been %s", obj.GetKind(), name, result)
+	if config.DryRun() {
+		msg += " (dry run)"
+	}
+	log.Info(msg, "fieldManager", config.FieldManager())
+}
+
+// withoutServerFields returns a copy of the resource without the status and the metadata maintained by the server
+func withoutServerFields(obj *unstructured.Unstructured) map[string]interface{} {
+	c := obj.DeepCopy()
+	unstructured.RemoveNestedField(c.Object, "status")
+	unstructured.RemoveNestedField(c.Object, "metadata", "managedFields")
+	unstructured.RemoveNestedField(c.Object, "metadata", "resourceVersion")
+	return c.Object
 }
 
 func (c *Runtime) OwnerReference() *metav1apply.OwnerReferenceApplyConfiguration {
@@ -61,14 +154,24 @@ func (c *Runtime) WithNamespace(namespace string) Client {
 	return clone
 }
 
+func (c *Runtime) WithContext(ctx context.Context) Client {
+	clone := c.clone()
+	clone.Ctx = ctx
+	return clone
+}
+
 func (c *Runtime) clone() *Runtime {
 	return &Runtime{
-		Ctx:           c.Ctx,
-		Client:        c.Client,
-		EventRecorder: c.EventRecorder,
-		Namespace:     c.Namespace,
-		Owner:         c.Owner,
-		Scheme:        c.Scheme,
+		Ctx:             c.Ctx,
+		Client:          c.Client,
+		EventRecorder:   c.EventRecorder,
+		FieldManager:    c.FieldManager,
+		Log:             c.Log,
+		Namespace:       c.Namespace,
+		Owner:           c.Owner,
+		Scheme:          c.Scheme,
+		ServiceAccounts: c.ServiceAccounts,
+		WatchNamespaces: c.WatchNamespaces,
 	}
 }
 
@@ -76,6 +179,13 @@ func (c *Runtime) Create(obj runtimeClient.Object) error {
 	return c.Client.Create(c.Ctx, obj)
 }
 
+func (c *Runtime) CreateToken(serviceAccount string, request *authenticationv1.TokenRequest) (*authenticationv1.TokenRequest, error) {
+	if c.ServiceAccounts == nil {
+		return nil, fmt.Errorf("unable to request a token of ServiceAccount '%s', the client does not support TokenRequests", serviceAccount)
+	}
+	return c.ServiceAccounts.ServiceAccounts(c.Namespace).CreateToken(c.Ctx, serviceAccount, request, metav1.CreateOptions{})
+}
+
 func (c *Runtime) DeleteAllOf(set map[string]string, obj runtimeClient.Object, opts ...func(config *Config)) error {
 	config := config(opts...)
 	labelSelector := labels.SelectorFromSet(set)
@@ -97,7 +207,12 @@ func (c *Runtime) Delete(name string, obj runtimeClient.Object, opts ...func(con
 	if !config.ClusterScoped() {
 		obj.SetNamespace(c.Namespace)
 	}
-	return c.Client.Delete(c.Ctx, obj)
+
+	var deleteOpts []runtimeClient.DeleteOption
+	if policy := config.PropagationPolicy(); policy != nil {
+		deleteOpts = append(deleteOpts, runtimeClient.PropagationPolicy(*policy))
+	}
+	return c.Client.Delete(c.Ctx, obj, deleteOpts...)
 }
 
 func (c *Runtime) List(set map[string]string, list runtimeClient.ObjectList, opts ...func(config *Config)) error {
@@ -105,12 +220,42 @@ func (c *Runtime) List(set map[string]string, list runtimeClient.ObjectList, opt
 	labelSelector := labels.SelectorFromSet(set)
 	listOps := &runtimeClient.ListOptions{LabelSelector: labelSelector}
 
-	if !config.ClusterScoped() {
+	if !config.ClusterScoped() && !config.AllNamespaces() {
 		listOps.Namespace = c.Namespace
 	}
+	if set := config.Fields(); set != nil {
+		listOps.FieldSelector = fields.SelectorFromSet(set)
+	}
+	if config.AllNamespaces() && len(c.WatchNamespaces) > 0 {
+		return c.listWatchNamespaces(list, listOps)
+	}
 	return c.Client.List(c.Ctx, list, listOps)
 }
 
+// listWatchNamespaces lists the resources of each watched namespace individually, so that the operation does not
+// require permissions outside the namespaces watched by the operator
+func (c *Runtime) listWatchNamespaces(list runtimeClient.ObjectList, listOps *runtimeClient.ListOptions) error {
+	var items []runtime.Object
+	for _, namespace := range c.WatchNamespaces {
+		namespaceList := list.DeepCopyObject().(runtimeClient.ObjectList)
+		namespaceOps := *listOps
+		namespaceOps.Namespace = namespace
+		if err := c.Client.List(c.Ctx, namespaceList, &namespaceOps); err != nil {
+			return err
+		}
+		namespaceItems, err := apimeta.ExtractList(namespaceList)
+		if err != nil {
+			return err
+		}
+		items = append(items, namespaceItems...)
+	}
+	return apimeta.SetList(list, items)
+}
+
+func (c *Runtime) Get(key types.NamespacedName, obj runtimeClient.Object) error {
+	return c.Client.Get(c.Ctx, key, obj)
+}
+
 func (c *Runtime) Load(name string, obj runtimeClient.Object, opts ...func(config *Config)) error {
 	config := config(opts...)
 
@@ -123,6 +268,15 @@ func (c *Runtime) Load(name string, obj runtimeClient.Object, opts ...func(confi
 	return c.Client.Get(c.Ctx, key, obj)
 }
 
+func (c *Runtime) Patch(obj runtimeClient.Object, patch runtimeClient.Patch, opts ...func(config *Config)) error {
+	config := c.writeConfig(opts...)
+	patchOpts := []runtimeClient.PatchOption{runtimeClient.FieldOwner(config.FieldManager())}
+	if config.DryRun() {
+		patchOpts = append(patchOpts, runtimeClient.DryRunAll)
+	}
+	return c.Client.Patch(c.Ctx, obj, patch, patchOpts...)
+}
+
 func (c *Runtime) Update(obj runtimeClient.Object) error {
 	return c.Client.Update(c.Ctx, obj)
 }
@@ -149,3 +303,12 @@ func config(opts ...func(config *Config)) *Config {
 	}
 	return config
 }
+
+// writeConfig returns the Config of a write operation, defaulting its field manager to the Runtime's FieldManager
+func (c *Runtime) writeConfig(opts ...func(config *Config)) *Config {
+	config := config(opts...)
+	if config.fieldManager == "" {
+		config.fieldManager = c.FieldManager
+	}
+	return config
+}
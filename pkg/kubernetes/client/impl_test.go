@@ -12,7 +12,9 @@ import (
 
 	"github.com/gingersnap-project/operator/pkg/kubernetes/client"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 	"sigs.k8s.io/controller-runtime/pkg/envtest/printer"
@@ -124,12 +126,88 @@ var _ = Describe("Runtime", func() {
 			},
 			Data: map[string]string{"key": "value"},
 		}
-		Expect(testClient.Apply(cm)).Should(Succeed())
+		result, err := testClient.Apply(cm, client.DetermineResult)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result).Should(Equal(client.OperationResultCreated))
 
 		created := &corev1.ConfigMap{}
 		Expect(testClient.Load(cm.Name, created))
 		Expect(created.Data["key"]).Should(Equal("value"))
 		Expect(created.OwnerReferences).Should(BeEmpty())
+
+		result, err = testClient.Apply(cm, client.DetermineResult)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result).Should(Equal(client.OperationResultNone))
+
+		result, err = testClient.Apply(cm)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result).Should(Equal(client.OperationResultApplied))
+
+		cm.Data["key"] = "updated"
+		live, result, err := client.Apply[corev1.ConfigMap](testClient, cm, client.DetermineResult)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result).Should(Equal(client.OperationResultUpdated))
+		Expect(live.UID).Should(Equal(created.UID))
+		Expect(live.Data["key"]).Should(Equal("updated"))
+	})
+
+	It("should not persist dry run operations", func() {
+		cm := &corev1.ConfigMap{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "test-cm",
+			},
+			Data: map[string]string{"key": "value"},
+		}
+		live := &corev1.ConfigMap{}
+		result, err := testClient.ApplyInto(cm, live, client.DryRun, client.DetermineResult)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result).Should(Equal(client.OperationResultCreated))
+		Expect(live.Data["key"]).Should(Equal("value"))
+		Expect(errors.IsNotFound(testClient.Load(cm.Name, &corev1.ConfigMap{}))).Should(BeTrue())
+
+		_, err = testClient.Apply(cm, client.FieldManager("test-manager"))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		patch := runtimeClient.MergeFrom(live.DeepCopy())
+		live.Data["key"] = "patched"
+		Expect(testClient.Patch(live, patch, client.DryRun)).Should(Succeed())
+
+		existing := &corev1.ConfigMap{}
+		Expect(testClient.Get(types.NamespacedName{Namespace: namespace, Name: cm.Name}, existing)).Should(Succeed())
+		Expect(existing.Data["key"]).Should(Equal("value"))
+		Expect(existing.ManagedFields).Should(ContainElement(HaveField("Manager", "test-manager")))
+	})
+
+	It("should default the field manager to the Runtime's FieldManager", func() {
+		cm := &corev1.ConfigMap{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "test-field-manager",
+			},
+			Data: map[string]string{"key": "value"},
+		}
+		managedClient := &client.Runtime{
+			Client:       k8sClient,
+			Ctx:          ctx,
+			FieldManager: "configured-manager",
+			Namespace:    namespace,
+			Scheme:       k8sClient.Scheme(),
+		}
+		_, err := managedClient.WithNamespace(namespace).Apply(cm)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		existing := &corev1.ConfigMap{}
+		Expect(testClient.Get(types.NamespacedName{Namespace: namespace, Name: cm.Name}, existing)).Should(Succeed())
+		Expect(existing.ManagedFields).Should(ContainElement(HaveField("Manager", "configured-manager")))
 	})
 
 	It("should load cluster scoped resources", func() {
@@ -142,7 +220,8 @@ var _ = Describe("Runtime", func() {
 				Name: "some-namespace",
 			},
 		}
-		Expect(testClient.Apply(ns)).Should(Succeed())
+		_, err := testClient.Apply(ns)
+		Expect(err).ShouldNot(HaveOccurred())
 
 		created := &corev1.Namespace{}
 		Expect(testClient.Load(ns.Name, created, client.ClusterScoped)).Should(Succeed())
@@ -1,6 +1,11 @@
 package client
 
 import (
+	"context"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	metav1apply "k8s.io/client-go/applyconfigurations/meta/v1"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
@@ -11,6 +16,8 @@ import (
 type OperationResult string
 
 const ( // They should complete the sentence "Deployment default/foo has been ..."
+	// OperationResultApplied means that the resource has been applied, without determining whether it has changed
+	OperationResultApplied OperationResult = "applied"
 	// OperationResultNone means that the resource has not been changed
 	OperationResultNone OperationResult = "unchanged"
 	// OperationResultCreated means that a new resource is created
@@ -24,40 +31,145 @@ const ( // They should complete the sentence "Deployment default/foo has been ..
 )
 
 type Config struct {
-	clusterScoped *bool
+	allNamespaces     bool
+	clusterScoped     *bool
+	propagationPolicy *metav1.DeletionPropagation
+	dryRun            bool
+	determineResult   bool
+	fieldManager      string
+	fields            map[string]string
+}
+
+// AllNamespaces returns true if a List operation should return the resources of every watched namespace
+func (c *Config) AllNamespaces() bool {
+	return c.allNamespaces
 }
 
 func (c *Config) ClusterScoped() bool {
 	return c.clusterScoped != nil && *c.clusterScoped
 }
 
+func (c *Config) PropagationPolicy() *metav1.DeletionPropagation {
+	return c.propagationPolicy
+}
+
+// DryRun returns true if the operation should be validated by the server without being persisted
+func (c *Config) DryRun() bool {
+	return c.dryRun
+}
+
+// DetermineResult returns true if an Apply operation should determine whether the resource has been created or updated
+func (c *Config) DetermineResult() bool {
+	return c.determineResult
+}
+
+// FieldManager returns the field manager recorded by the server for the operation
+func (c *Config) FieldManager() string {
+	if c.fieldManager == "" {
+		return DefaultFieldManager
+	}
+	return c.fieldManager
+}
+
+// Fields returns the field selector set of a List operation
+func (c *Config) Fields() map[string]string {
+	return c.fields
+}
+
 // ClusterScoped indicates that the operation should be invoked on a cluster scoped resource
 func ClusterScoped(config *Config) {
 	config.clusterScoped = pointer.Bool(true)
 }
 
+// AllNamespaces indicates that a List operation should return the namespaced resources of every namespace watched by
+// the operator, instead of those in the client's namespace
+func AllNamespaces(config *Config) {
+	config.allNamespaces = true
+}
+
+// BackgroundDeletion indicates that the dependents of a deleted resource should be garbage collected in the background
+func BackgroundDeletion(config *Config) {
+	policy := metav1.DeletePropagationBackground
+	config.propagationPolicy = &policy
+}
+
+// DryRun indicates that a write operation should be validated by the server without being persisted
+func DryRun(config *Config) {
+	config.dryRun = true
+}
+
+// DetermineResult indicates that an Apply operation should return whether the resource has been created, updated or left
+// unchanged, instead of OperationResultApplied. This requires a Get of the resource before it is applied, which is served
+// by the cache of the underlying client for the resource types it caches
+func DetermineResult(config *Config) {
+	config.determineResult = true
+}
+
+// MatchingFields restricts a List operation to the resources with fields matching those in the provided set. Fields
+// must be indexed by the cache of the underlying client
+func MatchingFields(set map[string]string) func(config *Config) {
+	return func(config *Config) {
+		config.fields = set
+	}
+}
+
+// FieldManager overrides the field manager of a write operation
+func FieldManager(name string) func(config *Config) {
+	return func(config *Config) {
+		config.fieldManager = name
+	}
+}
+
 type Client interface {
 	record.EventRecorder
-	// Apply executes a k8s Server Side apply using the provided resource
-	Apply(obj interface{}) error
+	// Apply executes a k8s Server Side apply using the provided resource and returns the OperationResult, which is
+	// OperationResultApplied unless DetermineResult is provided
+	Apply(obj interface{}, opts ...func(config *Config)) (OperationResult, error)
+	// ApplyInto executes a k8s Server Side apply using the provided resource, storing the live resource returned by the
+	// server in obj
+	ApplyInto(apply interface{}, obj client.Object, opts ...func(config *Config)) (OperationResult, error)
 	// OwnerReference returns a OwnerReferenceApplyConfiguration based upon the clients configured Owner
 	OwnerReference() *metav1apply.OwnerReferenceApplyConfiguration
 	// For returns a new Client implementation with the owner, used by OwnerReference, set to the provided Object
 	For(owner client.Object) Client
 	// WithNamespace returns a new client implementation with the specified namespace
 	WithNamespace(namespace string) Client
+	// WithContext returns a new client implementation whose operations use the specified context.Context
+	WithContext(ctx context.Context) Client
 	// Create a k8s resource
 	Create(obj client.Object) error
+	// CreateToken requests a token of the ServiceAccount in the client's namespace
+	CreateToken(serviceAccount string, request *authenticationv1.TokenRequest) (*authenticationv1.TokenRequest, error)
 	// Delete a k8s resource
 	Delete(name string, obj client.Object, opts ...func(config *Config)) error
 	// DeleteAllOf deletes all objects of the given type matching the given options.
 	DeleteAllOf(set map[string]string, obj client.Object, opts ...func(config *Config)) error
 	// List k8s resources with labels matching those in the provided set
 	List(set map[string]string, list client.ObjectList, opts ...func(config *Config)) error
+	// Get a k8s resource in any namespace
+	Get(key types.NamespacedName, obj client.Object) error
 	// Load a k8s resource
 	Load(name string, obj client.Object, opts ...func(config *Config)) error
+	// Patch a k8s resource
+	Patch(obj client.Object, patch client.Patch, opts ...func(config *Config)) error
 	// Update a k8s resource
 	Update(obj client.Object) error
 	// UpdateStatus of a k8s resource
 	UpdateStatus(obj client.Object) error
 }
+
+// ObjectPointer is satisfied by pointers to k8s resource structs, e.g. *appsv1.Deployment
+type ObjectPointer[T any] interface {
+	*T
+	client.Object
+}
+
+// Apply executes a k8s Server Side apply using the provided resource and returns the live resource of type T
+func Apply[T any, PT ObjectPointer[T]](c Client, apply interface{}, opts ...func(config *Config)) (PT, OperationResult, error) {
+	obj := PT(new(T))
+	result, err := c.ApplyInto(apply, obj, opts...)
+	if err != nil {
+		return nil, result, err
+	}
+	return obj, result, nil
+}
@@ -3,6 +3,9 @@ package kubernetes
 import (
 	"fmt"
 	"os"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 const (
@@ -10,6 +13,13 @@ const (
 	// which is the namespace where the watch activity happens.
 	// this value is empty if the operator is running with clusterScope.
 	WatchNamespaceEnvVar = "WATCH_NAMESPACE"
+	// WatchNamespaceSelectorEnvVar is the constant for env variable WATCH_NAMESPACE_SELECTOR
+	// which is the label selector of the watched namespaces whose resources are reconciled.
+	// this value is empty if the resources of every watched namespace are reconciled.
+	WatchNamespaceSelectorEnvVar = "WATCH_NAMESPACE_SELECTOR"
+	// OperatorNamespaceEnvVar is the constant for env variable OPERATOR_NAMESPACE
+	// which is the namespace the operator is deployed in.
+	OperatorNamespaceEnvVar = "OPERATOR_NAMESPACE"
 )
 
 // WatchNamespace returns the namespace the operator should be watching for changes
@@ -20,3 +30,49 @@ func WatchNamespace() (string, error) {
 	}
 	return ns, nil
 }
+
+// WatchNamespaces returns the comma separated namespaces of WatchNamespaceEnvVar, or an empty slice if the operator
+// watches all namespaces
+func WatchNamespaces() ([]string, error) {
+	ns, err := WatchNamespace()
+	if err != nil {
+		return nil, err
+	}
+
+	var namespaces []string
+	for _, n := range strings.Split(ns, ",") {
+		if n = strings.TrimSpace(n); n != "" {
+			namespaces = append(namespaces, n)
+		}
+	}
+	return namespaces, nil
+}
+
+// IsWatchedNamespace returns true if the namespace is one of watchNamespaces, or if watchNamespaces is empty as all
+// namespaces are watched
+func IsWatchedNamespace(watchNamespaces []string, namespace string) bool {
+	if len(watchNamespaces) == 0 {
+		return true
+	}
+	for _, ns := range watchNamespaces {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// WatchNamespaceSelector returns the label selector of the namespaces whose resources are reconciled, or
+// labels.Everything() if WatchNamespaceSelectorEnvVar is not set
+func WatchNamespaceSelector() (labels.Selector, error) {
+	selector, err := labels.Parse(os.Getenv(WatchNamespaceSelectorEnvVar))
+	if err != nil {
+		return nil, fmt.Errorf("%s is not a valid label selector: %w", WatchNamespaceSelectorEnvVar, err)
+	}
+	return selector, nil
+}
+
+// OperatorNamespace returns the namespace the operator is deployed in, or an empty string if it is unknown
+func OperatorNamespace() string {
+	return os.Getenv(OperatorNamespaceEnvVar)
+}
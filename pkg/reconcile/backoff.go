@@ -0,0 +1,80 @@
+package reconcile
+
+import (
+	"math"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// DefaultBackoff the BackoffPolicy applied to requeues requested via Context.RequeueWithBackoff
+var DefaultBackoff = BackoffPolicy{
+	Initial: 2 * time.Second,
+	Max:     5 * time.Minute,
+	Factor:  2,
+	Jitter:  0.1,
+}
+
+// BackoffPolicy determines the delay of consecutive requeues of a resource that is not making progress
+type BackoffPolicy struct {
+	// Initial the delay of the first requeue
+	Initial time.Duration
+	// Max the upper bound of the delay, excluding jitter
+	Max time.Duration
+	// Factor the delay is multiplied by for each consecutive attempt
+	Factor float64
+	// Jitter the maximum fraction of the delay that is randomly added to it, spreading the requeues of resources that
+	// started failing at the same time
+	Jitter float64
+}
+
+// Delay returns the delay before the given attempt, where the first attempt is 1
+func (b BackoffPolicy) Delay(attempt int32) time.Duration {
+	delay := float64(b.Initial)
+	if attempt > 1 {
+		delay *= math.Pow(b.Factor, float64(attempt-1))
+	}
+	if b.Max > 0 && delay > float64(b.Max) {
+		delay = float64(b.Max)
+	}
+	if b.Jitter > 0 {
+		return wait.Jitter(time.Duration(delay), b.Jitter)
+	}
+	return time.Duration(delay)
+}
+
+// RetryTracker is implemented by resources that record the number of consecutive requeues with backoff in their
+// status, so that the backoff is preserved across operator restarts
+type RetryTracker interface {
+	RetryAttempts() int32
+	SetRetryAttempts(attempts int32)
+}
+
+// IgnoreRetryAttempts returns a Predicate that filters update events of a RetryTracker where only the number of retry
+// attempts has changed. Without it, recording an attempt would immediately trigger the reconciliation it defers
+func IgnoreRetryAttempts() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if e.ObjectOld == nil || e.ObjectNew == nil {
+				return true
+			}
+			if _, ok := e.ObjectNew.(RetryTracker); !ok {
+				return true
+			}
+			oldObj := e.ObjectOld.DeepCopyObject().(client.Object)
+			newObj := e.ObjectNew.DeepCopyObject().(client.Object)
+			if oldObj.(RetryTracker).RetryAttempts() == newObj.(RetryTracker).RetryAttempts() {
+				return true
+			}
+			oldObj.(RetryTracker).SetRetryAttempts(0)
+			newObj.(RetryTracker).SetRetryAttempts(0)
+			newObj.SetResourceVersion(oldObj.GetResourceVersion())
+			newObj.SetManagedFields(oldObj.GetManagedFields())
+			return !equality.Semantic.DeepEqual(oldObj, newObj)
+		},
+	}
+}
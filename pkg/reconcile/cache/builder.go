@@ -1,6 +1,8 @@
 package cache
 
 import (
+	"time"
+
 	"github.com/gingersnap-project/operator/api/v1alpha1"
 	"github.com/gingersnap-project/operator/pkg/reconcile"
 	"github.com/gingersnap-project/operator/pkg/reconcile/pipeline"
@@ -8,6 +10,18 @@ import (
 
 type Context struct {
 	reconcile.Context
+	// CredentialsRefresh the duration after which externally provided data source credentials must be refreshed
+	CredentialsRefresh time.Duration
+	// Credentials the resolved data source credentials, nil if they are not yet available
+	Credentials *reconcile.DataSourceCredentials
+}
+
+// Fork returns a copy of the Context with an independent FlowStatus. Changes made to the fields of a forked Context are
+// not visible to the pipeline, so Handlers executed concurrently must not populate the Context
+func (c *Context) Fork() reconcile.Context {
+	fork := *c
+	fork.Context = c.Context.Fork()
+	return &fork
 }
 
 type HandlerFunc func(cache *v1alpha1.Cache, ctx *Context)
@@ -24,24 +38,88 @@ func NewContextProvider(ctx reconcile.Context) reconcile.ContextProviderFunc {
 	}
 }
 
-func PipelineBuilder(c *v1alpha1.Cache) *pipeline.Builder {
-	builder := &pipeline.Builder{}
+// PredicateFunc a pipeline.Predicate evaluated against the Cache being reconciled
+type PredicateFunc func(cache *v1alpha1.Cache, ctx *Context) bool
 
-	var deploymentHandler HandlerFunc
-	if c.Local() {
-		deploymentHandler = DaemonSet
-	} else {
-		deploymentHandler = Deployment
+func (f PredicateFunc) Test(i interface{}, ctx reconcile.Context) bool {
+	return f(i.(*v1alpha1.Cache), ctx.(*Context))
+}
+
+// workloadKind returns a PredicateFunc that is true if the cache pods must be deployed by a workload of the kind
+func workloadKind(kind string) PredicateFunc {
+	return func(c *v1alpha1.Cache, _ *Context) bool {
+		return c.WorkloadKind() == kind
 	}
+}
 
-	return builder.WithHandlers(
-		HandlerFunc(WatchServiceAccount),
-		HandlerFunc(Service),
-		HandlerFunc(UserServiceBindingSecret),
-		HandlerFunc(DBSyncerCacheServiceBindingSecret),
-		HandlerFunc(ApplyDataSourceServiceBinding),
-		HandlerFunc(ServiceMonitor),
-		deploymentHandler,
-		HandlerFunc(ConditionReady),
-	)
+func PipelineBuilder() *pipeline.Builder {
+	builder := &pipeline.Builder{}
+	return builder.
+		WithStages(
+			pipeline.Stage{Name: "InitDeploymentType", Handler: HandlerFunc(InitDeploymentType)},
+		).
+		// Resources that neither depend on one another nor update the Cache are applied concurrently
+		WithParallelStages("ApplyResources",
+			pipeline.Stage{Name: "WatchServiceAccount", Handler: HandlerFunc(WatchServiceAccount)},
+			pipeline.Stage{Name: "Service", Handler: HandlerFunc(Service)},
+			pipeline.Stage{Name: "DBSyncerCacheServiceBindingSecret", Handler: HandlerFunc(DBSyncerCacheServiceBindingSecret)},
+			pipeline.Stage{
+				Name:      "ServiceMonitor",
+				Handler:   HandlerFunc(ServiceMonitor),
+				Predicate: pipeline.TypeSupported(reconcile.ServiceMonitorGVK),
+			},
+		).
+		WithStages(
+			pipeline.Stage{Name: "UserServiceBindingSecret", Handler: HandlerFunc(UserServiceBindingSecret)},
+			pipeline.Stage{Name: "ApplyDataSourceCredentials", Handler: HandlerFunc(ApplyDataSourceCredentials)},
+			pipeline.Stage{
+				Name:      "ApplyDataSourceServiceBinding",
+				Handler:   HandlerFunc(ApplyDataSourceServiceBinding),
+				DependsOn: []string{"InitDeploymentType", "ApplyDataSourceCredentials"},
+			},
+			pipeline.Stage{
+				Name:      "DataSourceCredentials",
+				Handler:   HandlerFunc(DataSourceCredentials),
+				DependsOn: []string{"ApplyDataSourceServiceBinding"},
+			},
+			pipeline.Stage{
+				Name:      "DaemonSet",
+				Handler:   HandlerFunc(DaemonSet),
+				Predicate: workloadKind(v1alpha1.WorkloadDaemonSet),
+				DependsOn: []string{"DataSourceCredentials"},
+			},
+			pipeline.Stage{
+				Name:      "Deployment",
+				Handler:   HandlerFunc(Deployment),
+				Predicate: workloadKind(v1alpha1.WorkloadDeployment),
+				DependsOn: []string{"DataSourceCredentials"},
+			},
+			pipeline.Stage{
+				Name:      "StatefulSet",
+				Handler:   HandlerFunc(StatefulSet),
+				Predicate: workloadKind(v1alpha1.WorkloadStatefulSet),
+				DependsOn: []string{"DataSourceCredentials"},
+			},
+			pipeline.Stage{
+				Name:      "PartitionedRollout",
+				Handler:   HandlerFunc(PartitionedRollout),
+				DependsOn: []string{"DaemonSet"},
+			},
+			pipeline.Stage{
+				Name:      "RolloutStatus",
+				Handler:   HandlerFunc(RolloutStatus),
+				DependsOn: []string{"DaemonSet", "Deployment", "StatefulSet"},
+			},
+			pipeline.Stage{Name: "AggregateStatus", Handler: HandlerFunc(AggregateStatus)},
+			pipeline.Stage{
+				Name:      "DeploymentTransition",
+				Handler:   HandlerFunc(DeploymentTransition),
+				DependsOn: []string{"InitDeploymentType", "RolloutStatus"},
+			},
+			pipeline.Stage{
+				Name:      "ConditionReady",
+				Handler:   HandlerFunc(ConditionReady),
+				DependsOn: []string{"RolloutStatus"},
+			},
+		)
 }
@@ -2,7 +2,6 @@ package cache
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/gingersnap-project/operator/api/v1alpha1"
 	binding "github.com/gingersnap-project/operator/pkg/apis/binding/v1beta1"
@@ -13,8 +12,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-const conditionWait = time.Second * 2
-
 func ConditionReady(c *v1alpha1.Cache, ctx *Context) {
 	condition := v1alpha1.CacheCondition{
 		Type:   v1alpha1.CacheConditionReady,
@@ -37,7 +34,7 @@ func ConditionReady(c *v1alpha1.Cache, ctx *Context) {
 		if errors.IsNotFound(err) {
 			notFound("ServiceBinding", sbName)
 		} else {
-			ctx.RequeueAfter(conditionWait, fmt.Errorf("unable to load ServiceBinding '%s': %w", sbName, err))
+			ctx.RequeueWithBackoff(fmt.Errorf("unable to load ServiceBinding '%s': %w", sbName, err))
 			return
 		}
 	}
@@ -57,7 +54,8 @@ func ConditionReady(c *v1alpha1.Cache, ctx *Context) {
 		}
 	}
 	if applicationBound {
-		if c.Local() {
+		switch c.WorkloadKind() {
+		case v1alpha1.WorkloadDaemonSet:
 			ds := &appsv1.DaemonSet{}
 			if err := ctx.Client().Load(c.Name, ds); client.IgnoreNotFound(err) != nil {
 				ctx.Requeue(fmt.Errorf("unable to load DaemonSet for %s Ready Condition check: %w", v1alpha1.KindCache, err))
@@ -77,7 +75,31 @@ func ConditionReady(c *v1alpha1.Cache, ctx *Context) {
 					)
 				}
 			}
-		} else {
+		case v1alpha1.WorkloadStatefulSet:
+			statefulSet := &appsv1.StatefulSet{}
+			if err := ctx.Client().Load(c.Name, statefulSet); client.IgnoreNotFound(err) != nil {
+				ctx.Requeue(fmt.Errorf("unable to load StatefulSet for %s Ready Condition check: %w", v1alpha1.KindCache, err))
+				return
+			} else if err != nil {
+				notFound("StatefulSet", c.Name)
+			} else {
+				var desired int32 = 1
+				if statefulSet.Spec.Replicas != nil {
+					desired = *statefulSet.Spec.Replicas
+				}
+				if statefulSet.Status.ReadyReplicas >= desired {
+					update(
+						metav1.ConditionTrue,
+						"Expected number of StatefulSet pods are Ready",
+					)
+				} else {
+					update(
+						metav1.ConditionFalse,
+						fmt.Sprintf("Required StatefulSet '%d' pods to be Ready, observed '%d'", desired, statefulSet.Status.ReadyReplicas),
+					)
+				}
+			}
+		default:
 			deployment := &appsv1.Deployment{}
 			if err := ctx.Client().Load(c.Name, deployment); client.IgnoreNotFound(err) != nil {
 				ctx.Requeue(fmt.Errorf("unable to load Deployment for %s Ready Condition check: %w", v1alpha1.KindCache, err))
@@ -116,6 +138,8 @@ func ConditionReady(c *v1alpha1.Cache, ctx *Context) {
 	}
 
 	if condition.Status == metav1.ConditionFalse {
-		ctx.RequeueAfter(conditionWait, nil)
+		ctx.RequeueWithBackoff(nil)
+	} else if ctx.CredentialsRefresh > 0 {
+		ctx.RequeueAfter(ctx.CredentialsRefresh, nil)
 	}
 }
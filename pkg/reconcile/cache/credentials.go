@@ -0,0 +1,268 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	"github.com/gingersnap-project/operator/pkg/credentials"
+	"github.com/gingersnap-project/operator/pkg/credentials/vault"
+	"github.com/gingersnap-project/operator/pkg/kubernetes/client"
+	"github.com/gingersnap-project/operator/pkg/reconcile"
+	"github.com/gingersnap-project/operator/pkg/reconcile/meta"
+	"google.golang.org/protobuf/encoding/protojson"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	apicorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	corev1 "k8s.io/client-go/applyconfigurations/core/v1"
+	"k8s.io/utils/pointer"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultCredentialsRefresh = 5 * time.Minute
+	// serviceAccountTokenExpiration the expiration, in seconds, of the ServiceAccount tokens used to login to Vault. The
+	// minimum expiration accepted by the TokenRequest API
+	serviceAccountTokenExpiration = 600
+)
+
+// ApplyDataSourceCredentials materialises data source credentials retrieved from an external secret store in a binding
+// Secret. Credentials are refreshed when the provider configuration changes, when the refresh interval of static
+// credentials elapses, or once two thirds of the TTL of dynamic credentials has expired. The lease of dynamic credentials
+// is renewed until Vault no longer extends it, at which point new credentials are generated and the lease of the
+// replaced credentials is revoked once the cache pods have been rolled out with the new credentials
+func ApplyDataSourceCredentials(c *v1alpha1.Cache, ctx *Context) {
+	vaultSource := c.Spec.DataSource.Vault
+	if vaultSource == nil {
+		return
+	}
+
+	sourceHash, err := credentialsSourceHash(vaultSource)
+	if err != nil {
+		ctx.Requeue(err)
+		return
+	}
+
+	secretName := c.CacheService().DataSourceCredentialsSecret()
+	existing := &apicorev1.Secret{}
+	if err := ctx.Client().Load(secretName, existing); err != nil {
+		if !errors.IsNotFound(err) {
+			ctx.Requeue(fmt.Errorf("unable to load data source credentials Secret '%s': %w", secretName, err))
+			return
+		}
+		existing = nil
+	}
+
+	provider, err := vaultProvider(vaultSource, c, ctx)
+	if err != nil {
+		ctx.Requeue(err)
+		return
+	}
+
+	// Leases are only renewed and revoked with the provider configuration that created them
+	var lease, superseded string
+	if existing != nil && existing.Annotations[meta.AnnotationCredentialsSource] == sourceHash {
+		if vaultSource.Engine == v1alpha1.VaultSecretEngine_DATABASE {
+			lease = existing.Annotations[meta.AnnotationCredentialsVersion]
+			superseded = existing.Annotations[meta.AnnotationCredentialsSuperseded]
+		}
+		if superseded != "" && revokeSupersededCredentials(c, existing, superseded, provider, ctx) {
+			superseded = ""
+		}
+		if ctx.Status().Stop {
+			return
+		}
+		if refresh, err := time.Parse(time.RFC3339, existing.Annotations[meta.AnnotationCredentialsRefresh]); err == nil {
+			if until := time.Until(refresh); until > 0 {
+				ctx.CredentialsRefresh = until
+				return
+			}
+		}
+	}
+
+	var creds *credentials.Credentials
+	if lease != "" {
+		creds = renewCredentials(existing, lease, provider, ctx)
+	}
+	if creds == nil {
+		if creds, err = provider.Credentials(ctx.Ctx()); err != nil {
+			ctx.Requeue(fmt.Errorf("unable to retrieve data source credentials: %w", err))
+			return
+		}
+		if lease != "" && lease != creds.Version {
+			superseded = lease
+		}
+	}
+
+	refresh, err := credentialsRefresh(vaultSource, creds)
+	if err != nil {
+		ctx.Requeue(err)
+		return
+	}
+
+	data := map[string]string{
+		"type":     c.Spec.DataSource.DbType.ServiceBinding(),
+		"provider": "vault",
+	}
+	for k, v := range vaultSource.BindingData {
+		data[k] = v
+	}
+	for k, v := range creds.Data {
+		data[k] = v
+	}
+
+	annotations := map[string]string{
+		meta.AnnotationCredentialsRefresh: time.Now().Add(refresh).UTC().Format(time.RFC3339),
+		meta.AnnotationCredentialsSource:  sourceHash,
+		meta.AnnotationCredentialsVersion: creds.Version,
+	}
+	if superseded != "" {
+		annotations[meta.AnnotationCredentialsSuperseded] = superseded
+	}
+
+	secret := corev1.Secret(secretName, c.Namespace).
+		WithLabels(resourceLabels(c)).
+		WithAnnotations(annotations).
+		WithOwnerReferences(ctx.Client().OwnerReference()).
+		WithStringData(data).
+		WithType(apicorev1.SecretType(fmt.Sprintf("servicebinding.io/%s", data["type"])))
+
+	if _, err := ctx.Client().Apply(secret, client.DetermineResult); err != nil {
+		ctx.Requeue(fmt.Errorf("unable to apply data source credentials Secret: %w", err))
+		return
+	}
+	ctx.CredentialsRefresh = refresh
+}
+
+// minimumCredentialsTTL the TTL below which renewed credentials are replaced, rather than renewed again, as the lease
+// is close to its maximum TTL
+const minimumCredentialsTTL = time.Minute
+
+// renewCredentials renews the lease of the credentials materialised in the existing Secret, returning nil if new
+// credentials must be generated
+func renewCredentials(existing *apicorev1.Secret, lease string, provider credentials.Provider, ctx *Context) *credentials.Credentials {
+	ttl, err := provider.Renew(ctx.Ctx(), lease)
+	if err != nil {
+		ctx.Log().Info(fmt.Sprintf("generating new data source credentials: %v", err))
+		return nil
+	}
+	if ttl < minimumCredentialsTTL {
+		return nil
+	}
+	data := make(map[string]string, len(existing.Data))
+	for k, v := range existing.Data {
+		data[k] = string(v)
+	}
+	return &credentials.Credentials{
+		Data:    data,
+		Version: lease,
+		TTL:     ttl,
+	}
+}
+
+// revokeSupersededCredentials revokes the lease of replaced credentials once the Cache reports the current credentials
+// and its pods have been rolled out, returning true if the lease has been revoked
+func revokeSupersededCredentials(c *v1alpha1.Cache, existing *apicorev1.Secret, lease string, provider credentials.Provider, ctx *Context) bool {
+	if c.Status.Credentials == nil || c.Status.Credentials.Version != existing.Annotations[meta.AnnotationCredentialsVersion] ||
+		c.Status.Rollout == nil || !c.Status.Rollout.Complete {
+		return false
+	}
+
+	if err := provider.Revoke(ctx.Ctx(), lease); err != nil {
+		// The lease expires at the end of its TTL as it is no longer renewed
+		ctx.Log().Error(err, "unable to revoke superseded data source credentials")
+	}
+
+	patch := runtimeClient.MergeFrom(existing.DeepCopy())
+	delete(existing.Annotations, meta.AnnotationCredentialsSuperseded)
+	if err := ctx.Client().Patch(existing, patch); err != nil {
+		ctx.Requeue(fmt.Errorf("unable to update data source credentials Secret: %w", err))
+		return false
+	}
+	return true
+}
+
+// DataSourceCredentials resolves the credentials bound to the data source so that the cache-manager pods are rolled out
+// whenever they change, and reports the active credentials version on the Cache status
+func DataSourceCredentials(c *v1alpha1.Cache, ctx *Context) {
+	creds, err := reconcile.LoadDataSourceCredentials(c, ctx.Client())
+	if err != nil {
+		ctx.Requeue(err)
+		return
+	}
+	if creds == nil {
+		return
+	}
+	ctx.Credentials = creds
+
+	if c.Status.Credentials == nil || *c.Status.Credentials != *creds.Status {
+		c.Status.Credentials = creds.Status
+		if err := ctx.Client().UpdateStatus(c); err != nil {
+			ctx.Requeue(fmt.Errorf("unable to update Cache credentials status: %w", err))
+		}
+	}
+}
+
+func vaultProvider(v *v1alpha1.VaultSource, c *v1alpha1.Cache, ctx *Context) (credentials.Provider, error) {
+	provider := &vault.Provider{
+		Address:  v.Address,
+		Engine:   vault.Engine(v.Engine),
+		Path:     v.Path,
+		Role:     v.Role,
+		AuthPath: v.AuthPath,
+	}
+
+	if v.TokenSecretRef == nil {
+		// Kubernetes auth uses a short-lived token of the Cache's ServiceAccount, so that a Cache can only access the
+		// secrets that the Vault role grants to its namespace
+		provider.JWT = func() (string, error) {
+			request := &authenticationv1.TokenRequest{
+				Spec: authenticationv1.TokenRequestSpec{
+					ExpirationSeconds: pointer.Int64(serviceAccountTokenExpiration),
+				},
+			}
+			token, err := ctx.Client().CreateToken(c.Name, request)
+			if err != nil {
+				return "", fmt.Errorf("unable to request a token of ServiceAccount '%s': %w", c.Name, err)
+			}
+			return token.Status.Token, nil
+		}
+	} else {
+		secret := &apicorev1.Secret{}
+		if err := ctx.Client().Load(v.TokenSecretRef.Name, secret); err != nil {
+			return nil, fmt.Errorf("unable to load Vault token Secret '%s': %w", v.TokenSecretRef.Name, err)
+		}
+		token, ok := secret.Data["token"]
+		if !ok {
+			return nil, fmt.Errorf("vault token Secret '%s' does not contain the 'token' key", v.TokenSecretRef.Name)
+		}
+		provider.Token = string(token)
+	}
+	return provider, nil
+}
+
+func credentialsRefresh(v *v1alpha1.VaultSource, creds *credentials.Credentials) (time.Duration, error) {
+	if creds.TTL > 0 {
+		return creds.TTL * 2 / 3, nil
+	}
+
+	if v.RefreshInterval == "" {
+		return defaultCredentialsRefresh, nil
+	}
+	interval, err := time.ParseDuration(v.RefreshInterval)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse Vault refreshInterval: %w", err)
+	}
+	return interval, nil
+}
+
+func credentialsSourceHash(v *v1alpha1.VaultSource) (string, error) {
+	bytes, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal Vault configuration: %w", err)
+	}
+	hash := sha256.Sum256(bytes)
+	return hex.EncodeToString(hash[:]), nil
+}
@@ -7,13 +7,14 @@ import (
 	"github.com/gingersnap-project/operator/api/v1alpha1"
 	monitoringv1 "github.com/gingersnap-project/operator/pkg/applyconfigurations/monitoring/v1"
 	bindingv1 "github.com/gingersnap-project/operator/pkg/applyconfigurations/servicebinding/v1beta1"
+	"github.com/gingersnap-project/operator/pkg/kubernetes/client"
 	"github.com/gingersnap-project/operator/pkg/reconcile"
 	"github.com/gingersnap-project/operator/pkg/reconcile/meta"
 	apiappsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	apicorev1 "k8s.io/api/core/v1"
 	apirbacv1 "k8s.io/api/rbac/v1"
 	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/intstr"
 	appsv1 "k8s.io/client-go/applyconfigurations/apps/v1"
 	corev1 "k8s.io/client-go/applyconfigurations/core/v1"
 	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
@@ -22,6 +23,9 @@ import (
 
 const (
 	sidecarContainerName = "cache-manager"
+	// persistenceVolumeName the name of the volume, or volumeClaimTemplate, of the cache-manager file-based stores
+	persistenceVolumeName = "data"
+	persistenceMountPath  = "/var/lib/gingersnap/data"
 )
 
 func resourceLabels(c *v1alpha1.Cache) map[string]string {
@@ -32,7 +36,7 @@ func WatchServiceAccount(c *v1alpha1.Cache, ctx *Context) {
 	serviceAccount := corev1.ServiceAccount(c.Name, c.Namespace).
 		WithOwnerReferences(ctx.Client().OwnerReference())
 
-	if err := ctx.Client().Apply(serviceAccount); err != nil {
+	if _, err := ctx.Client().Apply(serviceAccount, client.DetermineResult); err != nil {
 		ctx.Requeue(fmt.Errorf("unable to apply ServiceAccount: %w", err))
 		return
 	}
@@ -43,10 +47,15 @@ func WatchServiceAccount(c *v1alpha1.Cache, ctx *Context) {
 				WithAPIGroups("").
 				WithResources("configmaps").
 				WithVerbs("get", "watch", "list"),
+			// Required by the db-syncer replicas for leader election
+			rbacv1.PolicyRule().
+				WithAPIGroups(coordinationv1.GroupName).
+				WithResources("leases").
+				WithVerbs("create", "get", "update"),
 		).
 		WithOwnerReferences(ctx.Client().OwnerReference())
 
-	if err := ctx.Client().Apply(role); err != nil {
+	if _, err := ctx.Client().Apply(role, client.DetermineResult); err != nil {
 		ctx.Requeue(fmt.Errorf("unable to apply Role: %w", err))
 		return
 	}
@@ -66,7 +75,7 @@ func WatchServiceAccount(c *v1alpha1.Cache, ctx *Context) {
 		).
 		WithOwnerReferences(ctx.Client().OwnerReference())
 
-	if err := ctx.Client().Apply(roleBinding); err != nil {
+	if _, err := ctx.Client().Apply(roleBinding, client.DetermineResult); err != nil {
 		ctx.Requeue(fmt.Errorf("unable to apply RoleBinding: %w", err))
 		return
 	}
@@ -87,17 +96,34 @@ func Service(c *v1alpha1.Cache, ctx *Context) {
 				WithType(apicorev1.ServiceTypeClusterIP).
 				WithSelector(labels).
 				WithPorts(
-					corev1.ServicePort().WithName("hotrod").WithPort(11222),
-					corev1.ServicePort().WithName("rest").WithPort(8080),
+					corev1.ServicePort().WithName("hotrod").WithPort(v1alpha1.HotRodPort),
+					corev1.ServicePort().WithName("rest").WithPort(v1alpha1.RestPort),
 				),
 		)
 
-	if err := ctx.Client().Apply(service); err != nil {
+	if _, err := ctx.Client().Apply(service, client.DetermineResult); err != nil {
 		ctx.Requeue(fmt.Errorf("unable to apply Infinispan Service: %w", err))
 	}
 }
 
 func ApplyDataSourceServiceBinding(cache *v1alpha1.Cache, ctx *Context) {
+	// The ServiceBinding must continue to target the existing workload until a transition between workloads has completed
+	sb := dataSourceServiceBinding(cache.CacheService().DataSourceServiceBinding(), cache.ActiveWorkloadKind(), cache, ctx)
+	if _, err := ctx.Client().Apply(sb, client.DetermineResult); err != nil {
+		ctx.Requeue(fmt.Errorf("unable to apply Cache ServiceBinding: %w", err))
+		return
+	}
+
+	if cache.Transitioning() {
+		// Bind the new workload so that its pods can become Ready before the existing workload is removed
+		sb = dataSourceServiceBinding(cache.CacheService().TransitionServiceBinding(), cache.WorkloadKind(), cache, ctx)
+		if _, err := ctx.Client().Apply(sb, client.DetermineResult); err != nil {
+			ctx.Requeue(fmt.Errorf("unable to apply transition ServiceBinding: %w", err))
+		}
+	}
+}
+
+func dataSourceServiceBinding(name, workloadKind string, cache *v1alpha1.Cache, ctx *Context) *bindingv1.ServiceBindingApplyConfiguration {
 	labels := resourceLabels(cache)
 
 	var serviceRef *bindingv1.ServiceBindingServiceReferenceApplyConfiguration
@@ -111,17 +137,10 @@ func ApplyDataSourceServiceBinding(cache *v1alpha1.Cache, ctx *Context) {
 		serviceRef = bindingv1.ServiceBindingServiceReference().
 			WithAPIVersion(apicorev1.SchemeGroupVersion.String()).
 			WithKind("Secret").
-			WithName(ds.SecretRef.Name)
+			WithName(cache.DataSourceSecret())
 	}
 
-	var workloadKind string
-	if cache.Local() {
-		workloadKind = "DaemonSet"
-	} else {
-		workloadKind = "Deployment"
-	}
-
-	sb := bindingv1.ServiceBinding(cache.CacheService().DataSourceServiceBinding(), cache.Namespace).
+	return bindingv1.ServiceBinding(name, cache.Namespace).
 		WithLabels(labels).
 		WithOwnerReferences(ctx.Client().OwnerReference()).
 		WithSpec(
@@ -139,10 +158,6 @@ func ApplyDataSourceServiceBinding(cache *v1alpha1.Cache, ctx *Context) {
 						),
 				),
 		)
-
-	if err := ctx.Client().Apply(sb); err != nil {
-		ctx.Requeue(fmt.Errorf("unable to apply Cache ServiceBinding: %w", err))
-	}
 }
 
 func UserServiceBindingSecret(c *v1alpha1.Cache, ctx *Context) {
@@ -168,9 +183,9 @@ func UserServiceBindingSecret(c *v1alpha1.Cache, ctx *Context) {
 	//}
 
 	// Initialize the ctx ServiceBinding so that we can use the values when creating the DaemonSet
-	secret := serviceBindingSecret(secretName, 8080, c, ctx)
+	secret := serviceBindingSecret(secretName, v1alpha1.RestPort, c, ctx)
 
-	if err := ctx.Client().Apply(secret); err != nil {
+	if _, err := ctx.Client().Apply(secret, client.DetermineResult); err != nil {
 		ctx.Requeue(fmt.Errorf("unable to apply user ServiceBinding secret: %w", err))
 		return
 	}
@@ -186,9 +201,9 @@ func UserServiceBindingSecret(c *v1alpha1.Cache, ctx *Context) {
 func DBSyncerCacheServiceBindingSecret(c *v1alpha1.Cache, ctx *Context) {
 	// TODO add authentication details once implemented in cache-manager
 	secretName := c.CacheService().DBSyncerCacheServiceBindingSecret()
-	secret := serviceBindingSecret(secretName, 11222, c, ctx)
+	secret := serviceBindingSecret(secretName, v1alpha1.HotRodPort, c, ctx)
 
-	if err := ctx.Client().Apply(secret); err != nil {
+	if _, err := ctx.Client().Apply(secret, client.DetermineResult); err != nil {
 		ctx.Requeue(fmt.Errorf("unable to apply internal ServiceBinding secret: %w", err))
 		return
 	}
@@ -223,13 +238,54 @@ func Deployment(c *v1alpha1.Cache, ctx *Context) {
 			WithSelector(
 				metav1.LabelSelector().WithMatchLabels(labels),
 			).
-			WithTemplate(podTemplateSpec(c)),
+			WithTemplate(podTemplateSpec(c, ctx)),
 		)
-	if err := ctx.Client().Apply(deployment); err != nil {
+	if strategy := deploymentStrategy(c); strategy != nil {
+		deployment.Spec.WithStrategy(strategy)
+	}
+	if _, err := ctx.Client().Apply(deployment, client.DetermineResult); err != nil {
 		ctx.Requeue(fmt.Errorf("unable to apply Infinispan DaemonSet: %w", err))
 	}
 }
 
+// StatefulSet deploys the pods of a CLUSTER cache with persistence, each pod being bound to its own
+// PersistentVolumeClaim so that the file-based stores of cache-manager survive pod restarts
+func StatefulSet(c *v1alpha1.Cache, ctx *Context) {
+	labels := resourceLabels(c)
+	statefulSet := appsv1.
+		StatefulSet(c.Name, c.Namespace).
+		WithLabels(labels).
+		WithOwnerReferences(ctx.Client().OwnerReference()).
+		WithSpec(appsv1.StatefulSetSpec().
+			WithReplicas(c.Spec.Deployment.Replicas).
+			// The headless Service of the Cache governs the network identity of the pods
+			WithServiceName(c.Name).
+			// Cache pods do not depend on one another, so there is no need to start them in order
+			WithPodManagementPolicy(apiappsv1.ParallelPodManagement).
+			WithSelector(
+				metav1.LabelSelector().WithMatchLabels(labels),
+			).
+			WithTemplate(podTemplateSpec(c, ctx)).
+			WithVolumeClaimTemplates(
+				(&corev1.PersistentVolumeClaimApplyConfiguration{}).
+					WithName(persistenceVolumeName).
+					WithLabels(labels).
+					WithSpec(persistentVolumeClaimSpec(c)),
+			).
+			// The data of a pod is only a copy of the data source, so it is not retained once the pod is removed
+			WithPersistentVolumeClaimRetentionPolicy(appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy().
+				WithWhenDeleted(apiappsv1.DeletePersistentVolumeClaimRetentionPolicyType).
+				WithWhenScaled(apiappsv1.DeletePersistentVolumeClaimRetentionPolicyType),
+			),
+		)
+	if strategy := statefulSetUpdateStrategy(c); strategy != nil {
+		statefulSet.Spec.WithUpdateStrategy(strategy)
+	}
+	if _, err := ctx.Client().Apply(statefulSet, client.DetermineResult); err != nil {
+		ctx.Requeue(fmt.Errorf("unable to apply Infinispan StatefulSet: %w", err))
+	}
+}
+
 func DaemonSet(c *v1alpha1.Cache, ctx *Context) {
 	labels := resourceLabels(c)
 	ds := appsv1.
@@ -240,17 +296,29 @@ func DaemonSet(c *v1alpha1.Cache, ctx *Context) {
 			WithSelector(
 				metav1.LabelSelector().WithMatchLabels(labels),
 			).
-			WithTemplate(podTemplateSpec(c)),
+			WithTemplate(podTemplateSpec(c, ctx)),
 		)
-	if err := ctx.Client().Apply(ds); err != nil {
+	if strategy := daemonSetUpdateStrategy(c); strategy != nil {
+		ds.Spec.WithUpdateStrategy(strategy)
+	}
+	if _, err := ctx.Client().Apply(ds, client.DetermineResult); err != nil {
 		ctx.Requeue(fmt.Errorf("unable to apply Infinispan DaemonSet: %w", err))
 	}
 }
 
-func podTemplateSpec(c *v1alpha1.Cache) *corev1.PodTemplateSpecApplyConfiguration {
-	return corev1.PodTemplateSpec().
+func podTemplateSpec(c *v1alpha1.Cache, ctx *Context) *corev1.PodTemplateSpecApplyConfiguration {
+	template := corev1.PodTemplateSpec().
 		WithName(sidecarContainerName).
-		WithLabels(resourceLabels(c)).
+		WithLabels(resourceLabels(c))
+
+	if ctx.Credentials != nil {
+		// Roll out the cache-manager pods when the data source credentials change
+		template.WithAnnotations(map[string]string{
+			meta.AnnotationCredentialsHash: ctx.Credentials.Hash,
+		})
+	}
+
+	return template.
 		WithSpec(corev1.PodSpec().
 			WithServiceAccountName(c.Name).
 			WithContainers(
@@ -258,14 +326,22 @@ func podTemplateSpec(c *v1alpha1.Cache) *corev1.PodTemplateSpecApplyConfiguratio
 					WithName(sidecarContainerName).
 					WithImage(c.CacheManagerImage()).
 					WithEnv(
-						corev1.EnvVar().WithName("GINGERSNAP_K8S_EAGER_CONFIG_MAP").WithValue(c.CacheService().EagerCacheConfigMap()),
-						corev1.EnvVar().WithName("GINGERSNAP_K8S_LAZY_CONFIG_MAP").WithValue(c.CacheService().LazyCacheConfigMap()),
-						corev1.EnvVar().WithName("GINGERSNAP_K8S_NAMESPACE").WithValue(c.Namespace),
-						corev1.EnvVar().WithName("QUARKUS_LOG_CATEGORY__IO_QUARKUS_KUBERNETES_SERVICE_BINDING__LEVEL").WithValue("DEBUG"),
+						reconcile.ContainerEnv(
+							[]*corev1.EnvVarApplyConfiguration{
+								corev1.EnvVar().WithName("GINGERSNAP_K8S_EAGER_CONFIG_MAP").WithValue(c.CacheService().EagerCacheConfigMap()),
+								corev1.EnvVar().WithName("GINGERSNAP_K8S_LAZY_CONFIG_MAP").WithValue(c.CacheService().LazyCacheConfigMap()),
+								corev1.EnvVar().WithName("GINGERSNAP_K8S_NAMESPACE").WithValue(c.Namespace),
+							},
+							c.Spec.Deployment.Logging,
+							c.Spec.Deployment.ExtraEnv,
+						)...,
+					).
+					WithEnvFrom(
+						reconcile.ContainerEnvFrom(c.Spec.Deployment.EnvFrom)...,
 					).
 					WithPorts(
-						corev1.ContainerPort().WithContainerPort(8080),
-						corev1.ContainerPort().WithContainerPort(11222),
+						corev1.ContainerPort().WithContainerPort(v1alpha1.RestPort),
+						corev1.ContainerPort().WithContainerPort(v1alpha1.HotRodPort),
 					).
 					WithResources(
 						corev1.ResourceRequirements().
@@ -273,33 +349,77 @@ func podTemplateSpec(c *v1alpha1.Cache) *corev1.PodTemplateSpecApplyConfiguratio
 							WithRequests(c.DeploymentRequests()),
 					).
 					WithLivenessProbe(
-						httpProbe("live", 5, 0, 10, 1, 80, 8080),
+						reconcile.HTTPProbe("live", 5, 0, 10, 1, 80, v1alpha1.RestPort),
 					).
 					WithReadinessProbe(
-						httpProbe("ready", 5, 0, 10, 1, 80, 8080),
+						reconcile.HTTPProbe("ready", 5, 0, 10, 1, 80, v1alpha1.RestPort),
 					).
 					WithStartupProbe(
-						httpProbe("started", 600, 1, 1, 1, 80, 8080),
+						reconcile.HTTPProbe("started", 600, 1, 1, 1, 80, v1alpha1.RestPort),
 					).
 					WithVolumeMounts(
-						corev1.VolumeMount().WithName("lazy-rules").WithMountPath("/rules/lazy").WithReadOnly(true),
+						volumeMounts(c)...,
 					),
 			).
 			WithVolumes(
-				corev1.Volume().
-					WithName("lazy-rules").
-					WithConfigMap(
-						corev1.ConfigMapVolumeSource().WithName(c.CacheService().LazyCacheConfigMap()).WithOptional(true),
-					),
+				volumes(c)...,
 			),
 		)
 }
 
-func ServiceMonitor(c *v1alpha1.Cache, ctx *Context) {
-	if !ctx.IsTypeSupported(reconcile.ServiceMonitorGVK) {
-		return
+func volumeMounts(c *v1alpha1.Cache) []*corev1.VolumeMountApplyConfiguration {
+	mounts := []*corev1.VolumeMountApplyConfiguration{
+		corev1.VolumeMount().WithName("lazy-rules").WithMountPath("/rules/lazy").WithReadOnly(true),
+	}
+	if c.Persistence() != nil {
+		mounts = append(mounts, corev1.VolumeMount().WithName(persistenceVolumeName).WithMountPath(persistenceMountPath))
+	}
+	return mounts
+}
+
+func volumes(c *v1alpha1.Cache) []*corev1.VolumeApplyConfiguration {
+	volumes := []*corev1.VolumeApplyConfiguration{
+		corev1.Volume().
+			WithName("lazy-rules").
+			WithConfigMap(
+				corev1.ConfigMapVolumeSource().WithName(c.CacheService().LazyCacheConfigMap()).WithOptional(true),
+			),
 	}
 
+	// The volume of a StatefulSet pod is provided by its volumeClaimTemplate. DaemonSets do not support volumeClaimTemplates,
+	// so LOCAL cache pods are attached a generic ephemeral volume that is provisioned and removed with the pod
+	if c.Persistence() != nil && c.Local() {
+		volumes = append(volumes,
+			corev1.Volume().
+				WithName(persistenceVolumeName).
+				WithEphemeral(
+					corev1.EphemeralVolumeSource().WithVolumeClaimTemplate(
+						corev1.PersistentVolumeClaimTemplate().
+							WithLabels(resourceLabels(c)).
+							WithSpec(persistentVolumeClaimSpec(c)),
+					),
+				),
+		)
+	}
+	return volumes
+}
+
+// persistentVolumeClaimSpec returns the spec of the PersistentVolumeClaim of each cache pod
+func persistentVolumeClaimSpec(c *v1alpha1.Cache) *corev1.PersistentVolumeClaimSpecApplyConfiguration {
+	spec := corev1.PersistentVolumeClaimSpec().
+		WithAccessModes(apicorev1.ReadWriteOnce).
+		WithResources(
+			corev1.ResourceRequirements().WithRequests(apicorev1.ResourceList{
+				apicorev1.ResourceStorage: c.PersistenceSize(),
+			}),
+		)
+	if storageClass := c.Persistence().StorageClassName; storageClass != "" {
+		spec.WithStorageClassName(storageClass)
+	}
+	return spec
+}
+
+func ServiceMonitor(c *v1alpha1.Cache, ctx *Context) {
 	labels := resourceLabels(c)
 	serviceMonitor := monitoringv1.
 		ServiceMonitor(c.Name, c.Namespace).
@@ -342,22 +462,7 @@ func ServiceMonitor(c *v1alpha1.Cache, ctx *Context) {
 				},
 			),
 		)
-	if err := ctx.Client().Apply(serviceMonitor); err != nil {
+	if _, err := ctx.Client().Apply(serviceMonitor, client.DetermineResult); err != nil {
 		ctx.Requeue(fmt.Errorf("unable to apply Infinispan ServiceMonitor: %w", err))
 	}
 }
-
-func httpProbe(endpoint string, failureThreshold, initialDelay, period, successThreshold, timeout int32, port int) *corev1.ProbeApplyConfiguration {
-	return corev1.Probe().
-		WithHTTPGet(
-			corev1.HTTPGetAction().
-				WithScheme(apicorev1.URISchemeHTTP).
-				WithPath("q/health/" + endpoint).
-				WithPort(intstr.FromInt(port)),
-		).
-		WithFailureThreshold(failureThreshold).
-		WithInitialDelaySeconds(initialDelay).
-		WithPeriodSeconds(period).
-		WithSuccessThreshold(successThreshold).
-		WithTimeoutSeconds(timeout)
-}
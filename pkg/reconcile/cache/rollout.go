@@ -0,0 +1,263 @@
+package cache
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	apiappsv1 "k8s.io/api/apps/v1"
+	apicorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	appsv1 "k8s.io/client-go/applyconfigurations/apps/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// daemonSetTemplateGenerationAnnotation is maintained by the API server and incremented whenever the DaemonSet
+	// pod template is updated
+	daemonSetTemplateGenerationAnnotation = "deprecated.daemonset.template.generation"
+	// daemonSetTemplateGenerationLabel is added by the DaemonSet controller to identify the template generation of a pod
+	daemonSetTemplateGenerationLabel = "pod-template-generation"
+	defaultMaxUnavailable            = 1
+)
+
+func daemonSetUpdateStrategy(c *v1alpha1.Cache) *appsv1.DaemonSetUpdateStrategyApplyConfiguration {
+	s := c.UpdateStrategy()
+	if s == nil {
+		return nil
+	}
+
+	// Partitioned updates are performed by the operator, as DaemonSets do not support partitions natively
+	if s.Type == v1alpha1.UpdateStrategyType_ON_DELETE || c.PartitionedUpdate() {
+		return appsv1.DaemonSetUpdateStrategy().WithType(apiappsv1.OnDeleteDaemonSetStrategyType)
+	}
+
+	rollingUpdate := appsv1.RollingUpdateDaemonSet()
+	if s.MaxUnavailable != "" {
+		rollingUpdate.WithMaxUnavailable(intstr.Parse(s.MaxUnavailable))
+	}
+	if s.MaxSurge != "" {
+		rollingUpdate.WithMaxSurge(intstr.Parse(s.MaxSurge))
+	}
+	return appsv1.DaemonSetUpdateStrategy().
+		WithType(apiappsv1.RollingUpdateDaemonSetStrategyType).
+		WithRollingUpdate(rollingUpdate)
+}
+
+func deploymentStrategy(c *v1alpha1.Cache) *appsv1.DeploymentStrategyApplyConfiguration {
+	s := c.UpdateStrategy()
+	if s == nil {
+		return nil
+	}
+
+	rollingUpdate := appsv1.RollingUpdateDeployment()
+	if s.MaxUnavailable != "" {
+		rollingUpdate.WithMaxUnavailable(intstr.Parse(s.MaxUnavailable))
+	}
+	if s.MaxSurge != "" {
+		rollingUpdate.WithMaxSurge(intstr.Parse(s.MaxSurge))
+	}
+	return appsv1.DeploymentStrategy().
+		WithType(apiappsv1.RollingUpdateDeploymentStrategyType).
+		WithRollingUpdate(rollingUpdate)
+}
+
+func statefulSetUpdateStrategy(c *v1alpha1.Cache) *appsv1.StatefulSetUpdateStrategyApplyConfiguration {
+	s := c.UpdateStrategy()
+	if s == nil {
+		return nil
+	}
+
+	// StatefulSets replace pods one at a time unless the MaxUnavailableStatefulSet feature gate is enabled. maxSurge is
+	// rejected by the webhook, as each pod is bound to its own PersistentVolumeClaim
+	rollingUpdate := appsv1.RollingUpdateStatefulSetStrategy()
+	if s.MaxUnavailable != "" {
+		rollingUpdate.WithMaxUnavailable(intstr.Parse(s.MaxUnavailable))
+	}
+	return appsv1.StatefulSetUpdateStrategy().
+		WithType(apiappsv1.RollingUpdateStatefulSetStrategyType).
+		WithRollingUpdate(rollingUpdate)
+}
+
+// PartitionedRollout replaces outdated pods of a LOCAL cache configured with an update partition. Pods are deleted,
+// ordered by name, until only spec.deployment.updateStrategy.partition pods run the previous revision, with at most
+// maxUnavailable pods being unavailable at any one time.
+func PartitionedRollout(c *v1alpha1.Cache, ctx *Context) {
+	if !c.PartitionedUpdate() {
+		return
+	}
+
+	ds := &apiappsv1.DaemonSet{}
+	if err := ctx.Client().Load(c.Name, ds); err != nil {
+		ctx.Requeue(fmt.Errorf("unable to load DaemonSet for partitioned rollout: %w", err))
+		return
+	}
+
+	pods := &apicorev1.PodList{}
+	if err := ctx.Client().List(resourceLabels(c), pods); err != nil {
+		ctx.Requeue(fmt.Errorf("unable to list cache pods for partitioned rollout: %w", err))
+		return
+	}
+
+	generation, ok := ds.Annotations[daemonSetTemplateGenerationAnnotation]
+	if !ok {
+		ctx.Requeue(fmt.Errorf("DaemonSet '%s' template generation not available", ds.Name))
+		return
+	}
+	var outdated []*apicorev1.Pod
+	var unavailable int
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.DeletionTimestamp != nil || !podReady(pod) {
+			unavailable++
+			continue
+		}
+		if pod.Labels[daemonSetTemplateGenerationLabel] != generation {
+			outdated = append(outdated, pod)
+		}
+	}
+
+	s := c.UpdateStrategy()
+	desired := int(ds.Status.DesiredNumberScheduled)
+	maxUnavailable := defaultMaxUnavailable
+	if s.MaxUnavailable != "" {
+		maxUnavailableIntStr := intstr.Parse(s.MaxUnavailable)
+		scaled, err := intstr.GetScaledValueFromIntOrPercent(&maxUnavailableIntStr, desired, true)
+		if err != nil {
+			ctx.Requeue(fmt.Errorf("unable to determine maxUnavailable for partitioned rollout: %w", err))
+			return
+		}
+		maxUnavailable = scaled
+	}
+
+	// Replace pods in a deterministic order so that the retained partition is stable between reconciliations
+	sort.Slice(outdated, func(i, j int) bool {
+		return outdated[i].Name < outdated[j].Name
+	})
+
+	toReplace := len(outdated) - int(s.Partition)
+	for i := 0; i < toReplace && unavailable < maxUnavailable; i++ {
+		pod := outdated[i]
+		if err := ctx.Client().Delete(pod.Name, pod); client.IgnoreNotFound(err) != nil {
+			ctx.Requeue(fmt.Errorf("unable to delete outdated cache pod '%s': %w", pod.Name, err))
+			return
+		}
+		unavailable++
+	}
+}
+
+// RolloutStatus reports the progress of the most recent cache-manager workload update on the Cache status
+func RolloutStatus(c *v1alpha1.Cache, ctx *Context) {
+	var status *v1alpha1.RolloutStatus
+	var image string
+	// replicas the number of pods observed by the workload, which may differ from the desired number whilst scaling
+	var replicas int32
+	switch c.WorkloadKind() {
+	case v1alpha1.WorkloadDaemonSet:
+		ds := &apiappsv1.DaemonSet{}
+		if err := ctx.Client().Load(c.Name, ds); client.IgnoreNotFound(err) != nil {
+			ctx.Requeue(fmt.Errorf("unable to load DaemonSet for rollout status: %w", err))
+			return
+		} else if err != nil {
+			return
+		}
+
+		var partition int32
+		if c.PartitionedUpdate() {
+			partition = c.UpdateStrategy().Partition
+		}
+		desired := ds.Status.DesiredNumberScheduled
+		replicas = ds.Status.CurrentNumberScheduled
+		image = cacheManagerImage(&ds.Spec.Template)
+		status = &v1alpha1.RolloutStatus{
+			Desired:   desired,
+			Updated:   ds.Status.UpdatedNumberScheduled,
+			Ready:     ds.Status.NumberReady,
+			Available: ds.Status.NumberAvailable,
+		}
+		status.Complete = ds.Status.ObservedGeneration >= ds.Generation &&
+			status.Updated >= desired-partition &&
+			status.Available == desired
+	case v1alpha1.WorkloadStatefulSet:
+		statefulSet := &apiappsv1.StatefulSet{}
+		if err := ctx.Client().Load(c.Name, statefulSet); client.IgnoreNotFound(err) != nil {
+			ctx.Requeue(fmt.Errorf("unable to load StatefulSet for rollout status: %w", err))
+			return
+		} else if err != nil {
+			return
+		}
+
+		var desired int32 = 1
+		if statefulSet.Spec.Replicas != nil {
+			desired = *statefulSet.Spec.Replicas
+		}
+		replicas = statefulSet.Status.Replicas
+		image = cacheManagerImage(&statefulSet.Spec.Template)
+		status = &v1alpha1.RolloutStatus{
+			Desired:   desired,
+			Updated:   statefulSet.Status.UpdatedReplicas,
+			Ready:     statefulSet.Status.ReadyReplicas,
+			Available: statefulSet.Status.AvailableReplicas,
+		}
+		status.Complete = statefulSet.Status.ObservedGeneration >= statefulSet.Generation &&
+			statefulSet.Status.UpdateRevision == statefulSet.Status.CurrentRevision &&
+			status.Updated == desired &&
+			statefulSet.Status.Replicas == desired &&
+			status.Available == desired
+	default:
+		deployment := &apiappsv1.Deployment{}
+		if err := ctx.Client().Load(c.Name, deployment); client.IgnoreNotFound(err) != nil {
+			ctx.Requeue(fmt.Errorf("unable to load Deployment for rollout status: %w", err))
+			return
+		} else if err != nil {
+			return
+		}
+
+		var desired int32 = 1
+		if deployment.Spec.Replicas != nil {
+			desired = *deployment.Spec.Replicas
+		}
+		replicas = deployment.Status.Replicas
+		image = cacheManagerImage(&deployment.Spec.Template)
+		status = &v1alpha1.RolloutStatus{
+			Desired:   desired,
+			Updated:   deployment.Status.UpdatedReplicas,
+			Ready:     deployment.Status.ReadyReplicas,
+			Available: deployment.Status.AvailableReplicas,
+		}
+		status.Complete = deployment.Status.ObservedGeneration >= deployment.Generation &&
+			status.Updated == desired &&
+			deployment.Status.Replicas == desired &&
+			status.Available == desired
+	}
+
+	if c.Status.Rollout == nil || *c.Status.Rollout != *status || c.Status.Image != image ||
+		c.Status.Replicas != replicas || c.Status.ReadyReplicas != status.Ready {
+		c.Status.Rollout = status
+		c.Status.Replicas = replicas
+		c.Status.ReadyReplicas = status.Ready
+		c.Status.Image = image
+		if err := ctx.Client().UpdateStatus(c); err != nil {
+			ctx.Requeue(fmt.Errorf("unable to update Cache rollout status: %w", err))
+		}
+	}
+}
+
+// cacheManagerImage returns the image of the cache-manager container in the pod template
+func cacheManagerImage(template *apicorev1.PodTemplateSpec) string {
+	for _, container := range template.Spec.Containers {
+		if container.Name == sidecarContainerName {
+			return container.Image
+		}
+	}
+	return ""
+}
+
+func podReady(pod *apicorev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == apicorev1.PodReady {
+			return condition.Status == apicorev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,284 @@
+package cache_test
+
+import (
+	"context"
+	"sort"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	"github.com/gingersnap-project/operator/pkg/reconcile/cache"
+	"github.com/gingersnap-project/operator/pkg/reconcile/meta"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("RolloutStatus", func() {
+
+	objectMeta := metav1.ObjectMeta{Name: "cache", Namespace: "default"}
+
+	newCache := func(deploymentType v1alpha1.CacheDeploymentType) *v1alpha1.Cache {
+		return &v1alpha1.Cache{
+			ObjectMeta: objectMeta,
+			Spec: v1alpha1.CacheSpec{
+				Deployment: &v1alpha1.CacheDeploymentSpec{Type: deploymentType},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		name     string
+		instance *v1alpha1.Cache
+		workload runtimeClient.Object
+	}{
+		{
+			name:     "DaemonSet",
+			instance: newCache(v1alpha1.CacheDeploymentType_LOCAL),
+			workload: &appsv1.DaemonSet{
+				ObjectMeta: objectMeta,
+				Status:     appsv1.DaemonSetStatus{DesiredNumberScheduled: 3, CurrentNumberScheduled: 1},
+			},
+		},
+		{
+			name:     "Deployment",
+			instance: newCache(v1alpha1.CacheDeploymentType_CLUSTER),
+			workload: &appsv1.Deployment{
+				ObjectMeta: objectMeta,
+				Spec:       appsv1.DeploymentSpec{Replicas: pointer.Int32(3)},
+				Status:     appsv1.DeploymentStatus{Replicas: 1},
+			},
+		},
+	} {
+		tc := tc
+		It("should report the replicas observed by the "+tc.name+" and the desired replicas of the rollout", func() {
+			ctx, k8sClient := newContext(tc.instance, tc.workload)
+
+			cache.RolloutStatus(tc.instance, ctx)
+			Expect(ctx.Status().Retry).To(BeFalse())
+
+			Expect(k8sClient.Get(context.TODO(), runtimeClient.ObjectKeyFromObject(tc.instance), tc.instance)).To(Succeed())
+			Expect(tc.instance.Status.Replicas).To(Equal(int32(1)))
+			Expect(tc.instance.Status.Rollout.Desired).To(Equal(int32(3)))
+			Expect(tc.instance.Status.Rollout.Complete).To(BeFalse())
+		})
+	}
+
+	partitioned := newCache(v1alpha1.CacheDeploymentType_LOCAL)
+	partitioned.Spec.Deployment.UpdateStrategy = &v1alpha1.UpdateStrategy{Type: v1alpha1.UpdateStrategyType_ROLLING_UPDATE, Partition: 2}
+	persistent := newCache(v1alpha1.CacheDeploymentType_CLUSTER)
+	persistent.Spec.Deployment.Persistence = &v1alpha1.CachePersistenceSpec{Size: "1Gi"}
+	generation := metav1.ObjectMeta{Name: objectMeta.Name, Namespace: objectMeta.Namespace, Generation: 2}
+
+	for _, tc := range []struct {
+		name     string
+		instance *v1alpha1.Cache
+		workload runtimeClient.Object
+		complete bool
+	}{
+		{
+			name:     "should complete once the pods outside the partition have been updated",
+			instance: partitioned,
+			workload: &appsv1.DaemonSet{
+				ObjectMeta: generation,
+				Status:     appsv1.DaemonSetStatus{ObservedGeneration: 2, DesiredNumberScheduled: 3, UpdatedNumberScheduled: 1, NumberAvailable: 3},
+			},
+			complete: true,
+		},
+		{
+			name:     "should not complete whilst pods outside the partition are outdated",
+			instance: partitioned,
+			workload: &appsv1.DaemonSet{
+				ObjectMeta: generation,
+				Status:     appsv1.DaemonSetStatus{ObservedGeneration: 2, DesiredNumberScheduled: 3, UpdatedNumberScheduled: 0, NumberAvailable: 3},
+			},
+		},
+		{
+			name:     "should not complete before the DaemonSet controller has observed the update",
+			instance: partitioned,
+			workload: &appsv1.DaemonSet{
+				ObjectMeta: generation,
+				Status:     appsv1.DaemonSetStatus{ObservedGeneration: 1, DesiredNumberScheduled: 3, UpdatedNumberScheduled: 3, NumberAvailable: 3},
+			},
+		},
+		{
+			name:     "should complete once all Deployment pods have been updated and are available",
+			instance: newCache(v1alpha1.CacheDeploymentType_CLUSTER),
+			workload: &appsv1.Deployment{
+				ObjectMeta: generation,
+				Spec:       appsv1.DeploymentSpec{Replicas: pointer.Int32(2)},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 2},
+			},
+			complete: true,
+		},
+		{
+			name:     "should not complete whilst outdated Deployment pods are terminating",
+			instance: newCache(v1alpha1.CacheDeploymentType_CLUSTER),
+			workload: &appsv1.Deployment{
+				ObjectMeta: generation,
+				Spec:       appsv1.DeploymentSpec{Replicas: pointer.Int32(2)},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 3, UpdatedReplicas: 2, AvailableReplicas: 2},
+			},
+		},
+		{
+			name:     "should not complete whilst the StatefulSet revision is being rolled out",
+			instance: persistent,
+			workload: &appsv1.StatefulSet{
+				ObjectMeta: generation,
+				Spec:       appsv1.StatefulSetSpec{Replicas: pointer.Int32(2)},
+				Status: appsv1.StatefulSetStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 2,
+					CurrentRevision: "cache-1", UpdateRevision: "cache-2"},
+			},
+		},
+		{
+			name:     "should complete once the StatefulSet revision has been rolled out",
+			instance: persistent,
+			workload: &appsv1.StatefulSet{
+				ObjectMeta: generation,
+				Spec:       appsv1.StatefulSetSpec{Replicas: pointer.Int32(2)},
+				Status: appsv1.StatefulSetStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 2,
+					CurrentRevision: "cache-2", UpdateRevision: "cache-2"},
+			},
+			complete: true,
+		},
+	} {
+		tc := tc
+		It(tc.name, func() {
+			instance := tc.instance.DeepCopy()
+			ctx, k8sClient := newContext(instance, tc.workload.DeepCopyObject().(runtimeClient.Object))
+
+			cache.RolloutStatus(instance, ctx)
+			Expect(ctx.Status().Retry).To(BeFalse())
+
+			Expect(k8sClient.Get(context.TODO(), runtimeClient.ObjectKeyFromObject(instance), instance)).To(Succeed())
+			Expect(instance.Status.Rollout.Complete).To(Equal(tc.complete))
+		})
+	}
+})
+
+var _ = Describe("PartitionedRollout", func() {
+
+	objectMeta := metav1.ObjectMeta{Name: "cache", Namespace: "default"}
+
+	// pod returns a cache pod running the given DaemonSet template generation
+	pod := func(name, generation string, ready bool) *corev1.Pod {
+		labels := meta.GingersnapLabels("infinispan", meta.ComponentCache, objectMeta.Name)
+		labels["pod-template-generation"] = generation
+		status := corev1.ConditionFalse
+		if ready {
+			status = corev1.ConditionTrue
+		}
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: objectMeta.Namespace, Labels: labels},
+			Status: corev1.PodStatus{
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		name           string
+		partition      int32
+		maxUnavailable string
+		pods           []*corev1.Pod
+		remaining      []string
+	}{
+		{
+			name:           "should replace outdated pods until the partition retains the previous revision",
+			partition:      2,
+			maxUnavailable: "100%",
+			pods:           []*corev1.Pod{pod("d", "1", true), pod("c", "1", true), pod("b", "1", true), pod("a", "1", true)},
+			remaining:      []string{"c", "d"},
+		},
+		{
+			name:           "should not replace pods once the partition has been reached",
+			partition:      2,
+			maxUnavailable: "100%",
+			pods:           []*corev1.Pod{pod("a", "1", true), pod("b", "2", true), pod("c", "1", true)},
+			remaining:      []string{"a", "b", "c"},
+		},
+		{
+			name:           "should only replace outdated pods",
+			partition:      1,
+			maxUnavailable: "100%",
+			pods:           []*corev1.Pod{pod("a", "2", true), pod("b", "1", true), pod("c", "2", true), pod("d", "1", true), pod("e", "1", true)},
+			remaining:      []string{"a", "c", "e"},
+		},
+		{
+			name:      "should replace at most maxUnavailable pods at a time",
+			partition: 1,
+			pods:      []*corev1.Pod{pod("a", "1", true), pod("b", "1", true), pod("c", "1", true)},
+			remaining: []string{"b", "c"},
+		},
+		{
+			name:      "should count unready pods as unavailable",
+			partition: 1,
+			pods:      []*corev1.Pod{pod("a", "1", false), pod("b", "1", true), pod("c", "1", true)},
+			remaining: []string{"a", "b", "c"},
+		},
+		{
+			name:           "should not count unready pods towards the partition",
+			partition:      1,
+			maxUnavailable: "2",
+			pods:           []*corev1.Pod{pod("a", "1", false), pod("b", "1", true), pod("c", "1", true)},
+			remaining:      []string{"a", "c"},
+		},
+	} {
+		tc := tc
+		It(tc.name, func() {
+			instance := &v1alpha1.Cache{
+				ObjectMeta: objectMeta,
+				Spec: v1alpha1.CacheSpec{
+					Deployment: &v1alpha1.CacheDeploymentSpec{
+						Type: v1alpha1.CacheDeploymentType_LOCAL,
+						UpdateStrategy: &v1alpha1.UpdateStrategy{
+							Type:           v1alpha1.UpdateStrategyType_ROLLING_UPDATE,
+							MaxUnavailable: tc.maxUnavailable,
+							Partition:      tc.partition,
+						},
+					},
+				},
+			}
+			objs := []runtimeClient.Object{&appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        objectMeta.Name,
+					Namespace:   objectMeta.Namespace,
+					Annotations: map[string]string{"deprecated.daemonset.template.generation": "2"},
+				},
+				Status: appsv1.DaemonSetStatus{DesiredNumberScheduled: int32(len(tc.pods))},
+			}}
+			for _, p := range tc.pods {
+				objs = append(objs, p)
+			}
+			ctx, k8sClient := newContext(instance, objs...)
+
+			cache.PartitionedRollout(instance, ctx)
+			Expect(ctx.Status().Retry).To(BeFalse())
+
+			pods := &corev1.PodList{}
+			Expect(k8sClient.List(context.TODO(), pods, runtimeClient.InNamespace(objectMeta.Namespace))).To(Succeed())
+			var remaining []string
+			for _, p := range pods.Items {
+				remaining = append(remaining, p.Name)
+			}
+			sort.Strings(remaining)
+			Expect(remaining).To(Equal(tc.remaining))
+		})
+	}
+
+	It("should not replace pods of caches without an update partition", func() {
+		instance := &v1alpha1.Cache{
+			ObjectMeta: objectMeta,
+			Spec: v1alpha1.CacheSpec{
+				Deployment: &v1alpha1.CacheDeploymentSpec{Type: v1alpha1.CacheDeploymentType_LOCAL},
+			},
+		}
+		ctx, k8sClient := newContext(instance, pod("a", "1", true))
+
+		cache.PartitionedRollout(instance, ctx)
+		Expect(ctx.Status().Retry).To(BeFalse())
+		Expect(k8sClient.Get(context.TODO(), runtimeClient.ObjectKey{Namespace: objectMeta.Namespace, Name: "a"}, &corev1.Pod{})).To(Succeed())
+	})
+})
@@ -0,0 +1,87 @@
+package cache
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	"github.com/gingersnap-project/operator/pkg/kubernetes/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// AggregateStatus records the Cache endpoints, pod selector and the rules attached to the Cache in its status, so that
+// they can be inspected without querying the individual resources
+func AggregateStatus(c *v1alpha1.Cache, ctx *Context) {
+	eagerRules := &v1alpha1.EagerCacheRuleList{}
+	if err := ctx.Client().List(nil, eagerRules, client.AllNamespaces, client.MatchingFields(c.CacheService().MatchingRules())); err != nil {
+		ctx.Requeue(fmt.Errorf("unable to list attached EagerCacheRules: %w", err))
+		return
+	}
+
+	lazyRules := &v1alpha1.LazyCacheRuleList{}
+	if err := ctx.Client().List(nil, lazyRules, client.AllNamespaces, client.MatchingFields(c.CacheService().MatchingRules())); err != nil {
+		ctx.Requeue(fmt.Errorf("unable to list attached LazyCacheRules: %w", err))
+		return
+	}
+
+	var rules []v1alpha1.AttachedRuleStatus
+	for i := range eagerRules.Items {
+		r := &eagerRules.Items[i]
+		rules = append(rules, v1alpha1.AttachedRuleStatus{
+			Kind:      v1alpha1.KindEagerCacheRule,
+			Namespace: r.Namespace,
+			Name:      r.Name,
+			Ready:     r.Condition(v1alpha1.EagerCacheRuleConditionReady).Status == metav1.ConditionTrue,
+		})
+	}
+	for i := range lazyRules.Items {
+		r := &lazyRules.Items[i]
+		rules = append(rules, v1alpha1.AttachedRuleStatus{
+			Kind:      v1alpha1.KindLazyCacheRule,
+			Namespace: r.Namespace,
+			Name:      r.Name,
+			Ready:     r.Condition(v1alpha1.LazyCacheRuleConditionReady).Status == metav1.ConditionTrue,
+		})
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		if rules[i].Kind != rules[j].Kind {
+			return rules[i].Kind < rules[j].Kind
+		}
+		if rules[i].Namespace != rules[j].Namespace {
+			return rules[i].Namespace < rules[j].Namespace
+		}
+		return rules[i].Name < rules[j].Name
+	})
+
+	var ready int32
+	for _, r := range rules {
+		if r.Ready {
+			ready++
+		}
+	}
+
+	host := c.CacheService().Host()
+	endpoints := &v1alpha1.EndpointsStatus{
+		HotRod: fmt.Sprintf("%s:%d", host, v1alpha1.HotRodPort),
+		Rest:   fmt.Sprintf("http://%s:%d", host, v1alpha1.RestPort),
+	}
+
+	selector := labels.SelectorFromSet(resourceLabels(c)).String()
+
+	status := &c.Status
+	if status.Rules == int32(len(rules)) && status.ReadyRules == ready && status.Selector == selector &&
+		reflect.DeepEqual(status.AttachedRules, rules) && reflect.DeepEqual(status.Endpoints, endpoints) {
+		return
+	}
+
+	status.Rules = int32(len(rules))
+	status.ReadyRules = ready
+	status.AttachedRules = rules
+	status.Endpoints = endpoints
+	status.Selector = selector
+	if err := ctx.Client().UpdateStatus(c); err != nil {
+		ctx.Requeue(fmt.Errorf("unable to update Cache status with attached rules: %w", err))
+	}
+}
@@ -0,0 +1,104 @@
+package cache_test
+
+import (
+	"context"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	"github.com/gingersnap-project/operator/pkg/reconcile/cache"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("AggregateStatus", func() {
+
+	var instance *v1alpha1.Cache
+
+	cacheRef := &v1alpha1.NamespacedObjectReference{Name: "cache", Namespace: "default"}
+
+	eagerRule := func(namespace, name string, ready bool) *v1alpha1.EagerCacheRule {
+		rule := &v1alpha1.EagerCacheRule{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       v1alpha1.EagerCacheRuleSpec{CacheRef: cacheRef},
+		}
+		if ready {
+			rule.SetCondition(v1alpha1.EagerCacheRuleCondition{Type: v1alpha1.EagerCacheRuleConditionReady, Status: metav1.ConditionTrue})
+		}
+		return rule
+	}
+
+	lazyRule := func(namespace, name string, ready bool) *v1alpha1.LazyCacheRule {
+		rule := &v1alpha1.LazyCacheRule{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       v1alpha1.LazyCacheRuleSpec{CacheRef: cacheRef},
+		}
+		if ready {
+			rule.SetCondition(v1alpha1.LazyCacheRuleCondition{Type: v1alpha1.LazyCacheRuleConditionReady, Status: metav1.ConditionTrue})
+		}
+		return rule
+	}
+
+	BeforeEach(func() {
+		instance = &v1alpha1.Cache{
+			ObjectMeta: metav1.ObjectMeta{Name: cacheRef.Name, Namespace: cacheRef.Namespace},
+		}
+	})
+
+	It("should record the attached rules, endpoints and selector", func() {
+		ctx, k8sClient := newContext(instance,
+			lazyRule("default", "a", false),
+			eagerRule("rules", "b", true),
+			eagerRule("default", "a", true),
+		)
+
+		cache.AggregateStatus(instance, ctx)
+		Expect(ctx.Status().Retry).To(BeFalse())
+
+		Expect(k8sClient.Get(context.TODO(), runtimeClient.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		status := instance.Status
+		Expect(status.AttachedRules).To(Equal([]v1alpha1.AttachedRuleStatus{
+			{Kind: v1alpha1.KindEagerCacheRule, Namespace: "default", Name: "a", Ready: true},
+			{Kind: v1alpha1.KindEagerCacheRule, Namespace: "rules", Name: "b", Ready: true},
+			{Kind: v1alpha1.KindLazyCacheRule, Namespace: "default", Name: "a", Ready: false},
+		}))
+		Expect(status.Rules).To(Equal(int32(3)))
+		Expect(status.ReadyRules).To(Equal(int32(2)))
+		Expect(status.Endpoints).To(Equal(&v1alpha1.EndpointsStatus{
+			HotRod: "cache.default.svc:11222",
+			Rest:   "http://cache.default.svc:8080",
+		}))
+		Expect(status.Selector).To(ContainSubstring("app.kubernetes.io/instance=cache"))
+	})
+
+	It("should not update the status if it is unchanged", func() {
+		ctx, k8sClient := newContext(instance, eagerRule("default", "a", true))
+
+		cache.AggregateStatus(instance, ctx)
+		Expect(k8sClient.Get(context.TODO(), runtimeClient.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		resourceVersion := instance.ResourceVersion
+
+		cache.AggregateStatus(instance, ctx)
+		Expect(ctx.Status().Retry).To(BeFalse())
+		Expect(k8sClient.Get(context.TODO(), runtimeClient.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.ResourceVersion).To(Equal(resourceVersion))
+	})
+
+	It("should update the status when the readiness of a rule changes", func() {
+		rule := eagerRule("default", "a", true)
+		ctx, k8sClient := newContext(instance, rule)
+
+		cache.AggregateStatus(instance, ctx)
+		Expect(instance.Status.ReadyRules).To(Equal(int32(1)))
+
+		rule.SetCondition(v1alpha1.EagerCacheRuleCondition{Type: v1alpha1.EagerCacheRuleConditionReady, Status: metav1.ConditionFalse})
+		Expect(k8sClient.Status().Update(context.TODO(), rule)).To(Succeed())
+
+		cache.AggregateStatus(instance, ctx)
+		Expect(k8sClient.Get(context.TODO(), runtimeClient.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Status.ReadyRules).To(BeZero())
+		Expect(instance.Status.AttachedRules).To(ConsistOf(
+			v1alpha1.AttachedRuleStatus{Kind: v1alpha1.KindEagerCacheRule, Namespace: "default", Name: "a", Ready: false},
+		))
+	})
+})
@@ -0,0 +1,48 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	binding "github.com/gingersnap-project/operator/pkg/apis/binding/v1beta1"
+	"github.com/gingersnap-project/operator/pkg/kubernetes/client"
+	"github.com/gingersnap-project/operator/pkg/reconcile/cache"
+	"github.com/gingersnap-project/operator/pkg/reconcile/pipeline"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestCache(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Cache Suite")
+}
+
+// newContext returns a Context for reconciling the Cache backed by a fake client containing the provided objects
+func newContext(c *v1alpha1.Cache, objs ...runtimeClient.Object) (*cache.Context, runtimeClient.Client) {
+	scheme := runtime.NewScheme()
+	Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+	Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+	Expect(binding.AddToScheme(scheme)).To(Succeed())
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(append(objs, c)...).
+		Build()
+	Expect(k8sClient.Get(context.TODO(), runtimeClient.ObjectKeyFromObject(c), c)).To(Succeed())
+
+	kubernetes := &client.Runtime{
+		Client:    k8sClient,
+		Ctx:       context.TODO(),
+		Log:       logr.Discard(),
+		Namespace: c.Namespace,
+		Owner:     c,
+		Scheme:    scheme,
+	}
+	return &cache.Context{Context: pipeline.NewContext(context.TODO(), logr.Discard(), nil, kubernetes)}, k8sClient
+}
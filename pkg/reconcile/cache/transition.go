@@ -0,0 +1,167 @@
+package cache
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	binding "github.com/gingersnap-project/operator/pkg/apis/binding/v1beta1"
+	apiappsv1 "k8s.io/api/apps/v1"
+	apicorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// InitDeploymentType records the CacheDeploymentType and kind of the workload currently serving the Cache, so that a
+// change to spec.deployment can be detected and the Cache transitioned between DaemonSet, Deployment and StatefulSet
+// workloads
+func InitDeploymentType(c *v1alpha1.Cache, ctx *Context) {
+	if c.Status.DeploymentType != nil && c.Status.Workload != "" {
+		return
+	}
+
+	if c.Status.DeploymentType != nil {
+		// Caches whose deployment type was recorded before persistence was supported are never served by a StatefulSet
+		c.Status.Workload = c.Status.DeploymentType.WorkloadKind()
+	} else {
+		active := c.WorkloadKind()
+		// Caches created before the workload was recorded may already have been updated to require a different workload
+		desiredExists, err := workloadExists(c, active, ctx)
+		if err != nil {
+			ctx.Requeue(err)
+			return
+		}
+		if !desiredExists {
+			for _, kind := range workloadKinds {
+				if kind == active {
+					continue
+				}
+				if exists, err := workloadExists(c, kind, ctx); err != nil {
+					ctx.Requeue(err)
+					return
+				} else if exists {
+					active = kind
+					break
+				}
+			}
+		}
+
+		deploymentType := v1alpha1.CacheDeploymentType_CLUSTER
+		if active == v1alpha1.WorkloadDaemonSet {
+			deploymentType = v1alpha1.CacheDeploymentType_LOCAL
+		}
+		c.Status.DeploymentType = &deploymentType
+		c.Status.Workload = active
+	}
+
+	if err := ctx.Client().UpdateStatus(c); err != nil {
+		ctx.Requeue(fmt.Errorf("unable to update Cache deploymentType status: %w", err))
+	}
+}
+
+// DeploymentTransition completes a transition between LOCAL and CLUSTER workloads, or between the Deployment and
+// StatefulSet of a CLUSTER cache when persistence is enabled or disabled. Once the new workload has been rolled out,
+// the data source ServiceBinding is repointed at the new workload and the previous workload is removed.
+func DeploymentTransition(c *v1alpha1.Cache, ctx *Context) {
+	if c.Transitioning() {
+		if c.Status.Rollout == nil || !c.Status.Rollout.Complete {
+			// Wait for the new workload to become available, reconciliation is triggered by workload status updates
+			return
+		}
+
+		ctx.Log().Info(fmt.Sprintf("%s rolled out, completing transition from %s", c.WorkloadKind(), c.ActiveWorkloadKind()))
+		active := c.Spec.Deployment.Type
+		c.Status.DeploymentType = &active
+		c.Status.Workload = c.WorkloadKind()
+		if err := ctx.Client().UpdateStatus(c); err != nil {
+			ctx.Requeue(fmt.Errorf("unable to update Cache deploymentType status: %w", err))
+			return
+		}
+
+		ApplyDataSourceServiceBinding(c, ctx)
+		if ctx.Status().Stop {
+			return
+		}
+	}
+
+	// Remove the resources of a completed, or abandoned, transition
+	for _, obsolete := range workloadKinds {
+		if obsolete == c.WorkloadKind() {
+			continue
+		}
+		if exists, err := workloadExists(c, obsolete, ctx); err != nil {
+			ctx.Requeue(err)
+			return
+		} else if exists {
+			// The PersistentVolumeClaimRetentionPolicy of the StatefulSet is ignored by clusters without the
+			// StatefulSetAutoDeletePVC feature, so its claims are removed explicitly before the StatefulSet itself
+			if obsolete == v1alpha1.WorkloadStatefulSet {
+				if err := removeStatefulSetClaims(c, ctx); err != nil {
+					ctx.Requeue(err)
+					return
+				}
+			}
+			if err := ctx.Client().Delete(c.Name, workload(obsolete)); client.IgnoreNotFound(err) != nil {
+				ctx.Requeue(fmt.Errorf("unable to remove obsolete %s: %w", obsolete, err))
+				return
+			}
+		}
+	}
+
+	sbName := c.CacheService().TransitionServiceBinding()
+	if err := ctx.Client().Load(sbName, &binding.ServiceBinding{}); err == nil {
+		if err := ctx.Client().Delete(sbName, &binding.ServiceBinding{}); client.IgnoreNotFound(err) != nil {
+			ctx.Requeue(fmt.Errorf("unable to remove transition ServiceBinding: %w", err))
+		}
+	} else if !errors.IsNotFound(err) {
+		ctx.Requeue(fmt.Errorf("unable to load transition ServiceBinding: %w", err))
+	}
+}
+
+// removeStatefulSetClaims removes the PersistentVolumeClaims created from the volumeClaimTemplate of the StatefulSet.
+// The generic ephemeral claims of LOCAL cache pods carry the same labels, so claims are matched on the
+// data-<cache>-<ordinal> name the StatefulSet controller gives them
+func removeStatefulSetClaims(c *v1alpha1.Cache, ctx *Context) error {
+	claims := &apicorev1.PersistentVolumeClaimList{}
+	if err := ctx.Client().List(resourceLabels(c), claims); err != nil {
+		return fmt.Errorf("unable to list obsolete PersistentVolumeClaims: %w", err)
+	}
+	prefix := fmt.Sprintf("%s-%s-", persistenceVolumeName, c.Name)
+	for _, claim := range claims.Items {
+		ordinal := strings.TrimPrefix(claim.Name, prefix)
+		if ordinal == claim.Name {
+			continue
+		}
+		if _, err := strconv.ParseUint(ordinal, 10, 32); err != nil {
+			continue
+		}
+		if err := ctx.Client().Delete(claim.Name, &apicorev1.PersistentVolumeClaim{}); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("unable to remove obsolete PersistentVolumeClaim %s: %w", claim.Name, err)
+		}
+	}
+	return nil
+}
+
+// workloadKinds the kinds of the workloads that can serve a Cache
+var workloadKinds = []string{v1alpha1.WorkloadDaemonSet, v1alpha1.WorkloadDeployment, v1alpha1.WorkloadStatefulSet}
+
+func workloadExists(c *v1alpha1.Cache, kind string, ctx *Context) (bool, error) {
+	if err := ctx.Client().Load(c.Name, workload(kind)); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("unable to load %s: %w", kind, err)
+	}
+	return true, nil
+}
+
+func workload(kind string) client.Object {
+	switch kind {
+	case v1alpha1.WorkloadDaemonSet:
+		return &apiappsv1.DaemonSet{}
+	case v1alpha1.WorkloadStatefulSet:
+		return &apiappsv1.StatefulSet{}
+	}
+	return &apiappsv1.Deployment{}
+}
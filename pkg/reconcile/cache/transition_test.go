@@ -0,0 +1,168 @@
+package cache_test
+
+import (
+	"context"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	binding "github.com/gingersnap-project/operator/pkg/apis/binding/v1beta1"
+	"github.com/gingersnap-project/operator/pkg/reconcile/cache"
+	"github.com/gingersnap-project/operator/pkg/reconcile/meta"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("DeploymentTransition", func() {
+
+	var instance *v1alpha1.Cache
+
+	objectMeta := metav1.ObjectMeta{Name: "cache", Namespace: "default"}
+
+	deploymentType := func(t v1alpha1.CacheDeploymentType) *v1alpha1.CacheDeploymentType {
+		return &t
+	}
+
+	exists := func(k8sClient runtimeClient.Client, name string, obj runtimeClient.Object) bool {
+		err := k8sClient.Get(context.TODO(), runtimeClient.ObjectKey{Namespace: objectMeta.Namespace, Name: name}, obj)
+		if errors.IsNotFound(err) {
+			return false
+		}
+		Expect(err).NotTo(HaveOccurred())
+		return true
+	}
+
+	BeforeEach(func() {
+		instance = &v1alpha1.Cache{
+			ObjectMeta: objectMeta,
+			Spec: v1alpha1.CacheSpec{
+				Deployment: &v1alpha1.CacheDeploymentSpec{Type: v1alpha1.CacheDeploymentType_CLUSTER},
+			},
+		}
+	})
+
+	It("should record the workload of Caches created before it was recorded", func() {
+		instance.Spec.Deployment.Persistence = &v1alpha1.CachePersistenceSpec{Size: "1Gi"}
+		ctx, k8sClient := newContext(instance, &appsv1.Deployment{ObjectMeta: objectMeta})
+
+		cache.InitDeploymentType(instance, ctx)
+		Expect(ctx.Status().Retry).To(BeFalse())
+
+		Expect(k8sClient.Get(context.TODO(), runtimeClient.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Status.DeploymentType).To(Equal(deploymentType(v1alpha1.CacheDeploymentType_CLUSTER)))
+		Expect(instance.Status.Workload).To(Equal(v1alpha1.WorkloadDeployment))
+		Expect(instance.Transitioning()).To(BeTrue())
+	})
+
+	It("should record the workload of the deployment type recorded before persistence was supported", func() {
+		instance.Spec.Deployment.Type = v1alpha1.CacheDeploymentType_LOCAL
+		instance.Status.DeploymentType = deploymentType(v1alpha1.CacheDeploymentType_LOCAL)
+		ctx, k8sClient := newContext(instance)
+
+		cache.InitDeploymentType(instance, ctx)
+		Expect(ctx.Status().Retry).To(BeFalse())
+
+		Expect(k8sClient.Get(context.TODO(), runtimeClient.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Status.Workload).To(Equal(v1alpha1.WorkloadDaemonSet))
+		Expect(instance.Transitioning()).To(BeFalse())
+	})
+
+	It("should keep the previous workload until the new workload has been rolled out", func() {
+		instance.Spec.Deployment.Persistence = &v1alpha1.CachePersistenceSpec{Size: "1Gi"}
+		instance.Status.DeploymentType = deploymentType(v1alpha1.CacheDeploymentType_CLUSTER)
+		instance.Status.Workload = v1alpha1.WorkloadDeployment
+		instance.Status.Rollout = &v1alpha1.RolloutStatus{Desired: 1}
+		ctx, k8sClient := newContext(instance,
+			&appsv1.Deployment{ObjectMeta: objectMeta},
+			&appsv1.StatefulSet{ObjectMeta: objectMeta},
+		)
+
+		cache.DeploymentTransition(instance, ctx)
+		Expect(ctx.Status().Retry).To(BeFalse())
+		Expect(exists(k8sClient, objectMeta.Name, &appsv1.Deployment{})).To(BeTrue())
+		Expect(exists(k8sClient, objectMeta.Name, &appsv1.StatefulSet{})).To(BeTrue())
+	})
+
+	It("should remove the StatefulSet and its claims once persistence has been disabled", func() {
+		instance.Status.DeploymentType = deploymentType(v1alpha1.CacheDeploymentType_CLUSTER)
+		instance.Status.Workload = v1alpha1.WorkloadDeployment
+		labels := meta.GingersnapLabels("infinispan", meta.ComponentCache, objectMeta.Name)
+		claim := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "data-cache-0", Namespace: objectMeta.Namespace, Labels: labels},
+		}
+		transitionBinding := &binding.ServiceBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: instance.CacheService().TransitionServiceBinding(), Namespace: objectMeta.Namespace},
+		}
+		ctx, k8sClient := newContext(instance,
+			&appsv1.Deployment{ObjectMeta: objectMeta},
+			&appsv1.StatefulSet{ObjectMeta: objectMeta},
+			claim,
+			transitionBinding,
+		)
+
+		cache.DeploymentTransition(instance, ctx)
+		Expect(ctx.Status().Retry).To(BeFalse())
+		Expect(exists(k8sClient, objectMeta.Name, &appsv1.Deployment{})).To(BeTrue())
+		Expect(exists(k8sClient, objectMeta.Name, &appsv1.StatefulSet{})).To(BeFalse())
+		Expect(exists(k8sClient, claim.Name, &corev1.PersistentVolumeClaim{})).To(BeFalse())
+		Expect(exists(k8sClient, transitionBinding.Name, &binding.ServiceBinding{})).To(BeFalse())
+	})
+
+	It("should retain the ephemeral claims of the DaemonSet pods when removing the StatefulSet", func() {
+		instance.Spec.Deployment.Type = v1alpha1.CacheDeploymentType_LOCAL
+		instance.Spec.Deployment.Persistence = &v1alpha1.CachePersistenceSpec{Size: "1Gi"}
+		instance.Status.DeploymentType = deploymentType(v1alpha1.CacheDeploymentType_LOCAL)
+		instance.Status.Workload = v1alpha1.WorkloadDaemonSet
+		labels := meta.GingersnapLabels("infinispan", meta.ComponentCache, objectMeta.Name)
+		statefulSetClaim := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "data-cache-1", Namespace: objectMeta.Namespace, Labels: labels},
+		}
+		ephemeralClaim := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cache-x7k2p-data",
+				Namespace: objectMeta.Namespace,
+				Labels:    labels,
+				OwnerReferences: []metav1.OwnerReference{
+					{APIVersion: "v1", Kind: "Pod", Name: "cache-x7k2p", UID: "d5a3c1e0-5b8e-4bb7-9e38-1f0c2a6b7d41"},
+				},
+			},
+		}
+		ctx, k8sClient := newContext(instance,
+			&appsv1.DaemonSet{ObjectMeta: objectMeta},
+			&appsv1.StatefulSet{ObjectMeta: objectMeta},
+			statefulSetClaim,
+			ephemeralClaim,
+		)
+
+		cache.DeploymentTransition(instance, ctx)
+		Expect(ctx.Status().Retry).To(BeFalse())
+		Expect(exists(k8sClient, objectMeta.Name, &appsv1.DaemonSet{})).To(BeTrue())
+		Expect(exists(k8sClient, objectMeta.Name, &appsv1.StatefulSet{})).To(BeFalse())
+		Expect(exists(k8sClient, statefulSetClaim.Name, &corev1.PersistentVolumeClaim{})).To(BeFalse())
+		Expect(exists(k8sClient, ephemeralClaim.Name, &corev1.PersistentVolumeClaim{})).To(BeTrue())
+	})
+
+	It("should retain the claims of the StatefulSet serving the Cache", func() {
+		instance.Spec.Deployment.Persistence = &v1alpha1.CachePersistenceSpec{Size: "1Gi"}
+		instance.Status.DeploymentType = deploymentType(v1alpha1.CacheDeploymentType_CLUSTER)
+		instance.Status.Workload = v1alpha1.WorkloadStatefulSet
+		labels := meta.GingersnapLabels("infinispan", meta.ComponentCache, objectMeta.Name)
+		claim := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "data-cache-0", Namespace: objectMeta.Namespace, Labels: labels},
+		}
+		ctx, k8sClient := newContext(instance,
+			&appsv1.Deployment{ObjectMeta: objectMeta},
+			&appsv1.StatefulSet{ObjectMeta: objectMeta},
+			claim,
+		)
+
+		cache.DeploymentTransition(instance, ctx)
+		Expect(ctx.Status().Retry).To(BeFalse())
+		Expect(exists(k8sClient, objectMeta.Name, &appsv1.Deployment{})).To(BeFalse())
+		Expect(exists(k8sClient, objectMeta.Name, &appsv1.StatefulSet{})).To(BeTrue())
+		Expect(exists(k8sClient, claim.Name, &corev1.PersistentVolumeClaim{})).To(BeTrue())
+	})
+})
@@ -0,0 +1,83 @@
+package reconcile
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	binding "github.com/gingersnap-project/operator/pkg/apis/binding/v1beta1"
+	"github.com/gingersnap-project/operator/pkg/kubernetes/client"
+	"github.com/gingersnap-project/operator/pkg/reconcile/meta"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+// DataSourceCredentials the credentials bound to a Cache's data source
+type DataSourceCredentials struct {
+	// Hash of the Secret data, used to trigger rollouts when the credentials change
+	Hash string
+	// Status the credentials status to report on the reconciled resource
+	Status *v1alpha1.CredentialsStatus
+}
+
+// LoadDataSourceCredentials resolves the Secret containing the credentials of the Cache's data source. The provided
+// client must be scoped to the namespace of the Cache. Nil is returned if the Secret cannot be resolved yet, for
+// example when a provisioned service has not exposed its binding Secret.
+func LoadDataSourceCredentials(c *v1alpha1.Cache, k8sClient client.Client) (*DataSourceCredentials, error) {
+	secretName := c.DataSourceSecret()
+	if c.Spec.DataSource.ServiceProviderRef != nil {
+		// The binding Secret of a provisioned service is exposed on the ServiceBinding status once resolved
+		sb := &binding.ServiceBinding{}
+		sbName := c.CacheService().DataSourceServiceBinding()
+		if err := k8sClient.Load(sbName, sb); err != nil {
+			if errors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("unable to load ServiceBinding '%s': %w", sbName, err)
+		}
+		if sb.Status.Binding == nil {
+			return nil, nil
+		}
+		secretName = sb.Status.Binding.Name
+	}
+
+	secret := &corev1.Secret{}
+	if err := k8sClient.Load(secretName, secret); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to load data source credentials Secret '%s': %w", secretName, err)
+	}
+
+	hash := secretDataHash(secret)
+	version := secret.Annotations[meta.AnnotationCredentialsVersion]
+	if version == "" {
+		version = hash[:16]
+	}
+	return &DataSourceCredentials{
+		Hash: hash,
+		Status: &v1alpha1.CredentialsStatus{
+			SecretName: secretName,
+			Version:    version,
+		},
+	}, nil
+}
+
+func secretDataHash(secret *corev1.Secret) string {
+	keys := make([]string, 0, len(secret.Data))
+	for k := range secret.Data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	hash := sha256.New()
+	for _, k := range keys {
+		hash.Write([]byte(k))
+		hash.Write([]byte{0})
+		hash.Write(secret.Data[k])
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
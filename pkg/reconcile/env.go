@@ -0,0 +1,119 @@
+package reconcile
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	corev1 "k8s.io/client-go/applyconfigurations/core/v1"
+)
+
+// ContainerEnv returns the environment of a Quarkus container. The operator's variables are combined with those
+// derived from the logging configuration and the user supplied extraEnv, with later entries overriding earlier entries
+// of the same name. Reserved variables, see v1alpha1.ReservedEnv, can only be set by the operator.
+func ContainerEnv(operatorEnv []*corev1.EnvVarApplyConfiguration, logging *v1alpha1.Logging, extraEnv []*v1alpha1.EnvVar) []*corev1.EnvVarApplyConfiguration {
+	var env []*corev1.EnvVarApplyConfiguration
+	index := make(map[string]int)
+	add := func(e *corev1.EnvVarApplyConfiguration) {
+		if i, exists := index[*e.Name]; exists {
+			env[i] = e
+		} else {
+			index[*e.Name] = len(env)
+			env = append(env, e)
+		}
+	}
+
+	for _, e := range operatorEnv {
+		add(e)
+	}
+
+	for _, e := range loggingEnv(logging) {
+		add(e)
+	}
+
+	for _, e := range extraEnv {
+		if v1alpha1.ReservedEnv(e.Name) {
+			continue
+		}
+		envVar := corev1.EnvVar().WithName(e.Name)
+		if ref := e.ConfigMapKeyRef; ref != nil {
+			envVar.WithValueFrom(
+				corev1.EnvVarSource().WithConfigMapKeyRef(
+					corev1.ConfigMapKeySelector().WithName(ref.Name).WithKey(ref.Key).WithOptional(ref.Optional),
+				),
+			)
+		} else if ref := e.SecretKeyRef; ref != nil {
+			envVar.WithValueFrom(
+				corev1.EnvVarSource().WithSecretKeyRef(
+					corev1.SecretKeySelector().WithName(ref.Name).WithKey(ref.Key).WithOptional(ref.Optional),
+				),
+			)
+		} else {
+			envVar.WithValue(e.Value)
+		}
+		add(envVar)
+	}
+	return env
+}
+
+// ContainerEnvFrom returns the EnvFromSources of a container. Variables defined via env always take precedence over
+// those defined by an EnvFromSource, so the operator's variables cannot be overridden.
+func ContainerEnvFrom(envFrom []*v1alpha1.EnvFromSource) []*corev1.EnvFromSourceApplyConfiguration {
+	sources := make([]*corev1.EnvFromSourceApplyConfiguration, 0, len(envFrom))
+	for _, e := range envFrom {
+		source := corev1.EnvFromSource()
+		if e.Prefix != "" {
+			source.WithPrefix(e.Prefix)
+		}
+		if ref := e.ConfigMapRef; ref != nil {
+			source.WithConfigMapRef(corev1.ConfigMapEnvSource().WithName(ref.Name))
+		} else if ref := e.SecretRef; ref != nil {
+			source.WithSecretRef(corev1.SecretEnvSource().WithName(ref.Name))
+		}
+		sources = append(sources, source)
+	}
+	return sources
+}
+
+// loggingEnv returns the Quarkus logging configuration as environment variables
+func loggingEnv(logging *v1alpha1.Logging) []*corev1.EnvVarApplyConfiguration {
+	if logging == nil {
+		return nil
+	}
+
+	var env []*corev1.EnvVarApplyConfiguration
+	if logging.Level != "" {
+		env = append(env, corev1.EnvVar().WithName("QUARKUS_LOG_LEVEL").WithValue(logging.Level))
+	}
+
+	categories := make([]string, 0, len(logging.Categories))
+	for category := range logging.Categories {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+	for _, category := range categories {
+		// Quoted property segments, such as the category in quarkus.log.category."io.quarkus".level, are delimited by
+		// a double underscore when expressed as an environment variable
+		name := fmt.Sprintf("QUARKUS_LOG_CATEGORY__%s__LEVEL", envName(category))
+		env = append(env, corev1.EnvVar().WithName(name).WithValue(logging.Categories[category]))
+	}
+
+	if logging.Json {
+		env = append(env, corev1.EnvVar().WithName("QUARKUS_LOG_CONSOLE_JSON").WithValue("true"))
+	}
+	return env
+}
+
+// envName converts a configuration property segment to its environment variable form
+func envName(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		}
+		return '_'
+	}, s)
+}
@@ -0,0 +1,118 @@
+package reconcile_test
+
+import (
+	"testing"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	"github.com/gingersnap-project/operator/pkg/reconcile"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/applyconfigurations/core/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestReconcile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Reconcile Suite")
+}
+
+var _ = Describe("Env", func() {
+
+	// values returns the name and value, or referenced key, of every variable in order
+	values := func(env []*v1.EnvVarApplyConfiguration) [][2]string {
+		var values [][2]string
+		for _, e := range env {
+			value := pointer.StringDeref(e.Value, "")
+			if from := e.ValueFrom; from != nil {
+				if ref := from.ConfigMapKeyRef; ref != nil {
+					value = "configmap:" + *ref.Name + "/" + *ref.Key
+				} else if ref := from.SecretKeyRef; ref != nil {
+					value = "secret:" + *ref.Name + "/" + *ref.Key
+				}
+			}
+			values = append(values, [2]string{*e.Name, value})
+		}
+		return values
+	}
+
+	It("should combine operator, logging and extra variables with later entries overriding earlier ones", func() {
+		operatorEnv := []*v1.EnvVarApplyConfiguration{
+			v1.EnvVar().WithName("QUARKUS_LOG_LEVEL").WithValue("INFO"),
+			v1.EnvVar().WithName("JAVA_OPTS").WithValue("-Xmx512m"),
+		}
+		logging := &v1alpha1.Logging{
+			Level: "DEBUG",
+			Categories: map[string]string{
+				"org.hibernate":         "WARN",
+				"io.gingersnap-project": "TRACE",
+			},
+			Json: true,
+		}
+		extraEnv := []*v1alpha1.EnvVar{
+			{Name: "JAVA_OPTS", Value: "-Xmx1g"},
+			{Name: "FROM_CONFIGMAP", ConfigMapKeyRef: &v1alpha1.KeySelector{Name: "config", Key: "key"}},
+			{Name: "FROM_SECRET", SecretKeyRef: &v1alpha1.KeySelector{Name: "secret", Key: "key", Optional: true}},
+		}
+
+		env := reconcile.ContainerEnv(operatorEnv, logging, extraEnv)
+		Expect(values(env)).To(Equal([][2]string{
+			{"QUARKUS_LOG_LEVEL", "DEBUG"},
+			{"JAVA_OPTS", "-Xmx1g"},
+			{"QUARKUS_LOG_CATEGORY__IO_GINGERSNAP_PROJECT__LEVEL", "TRACE"},
+			{"QUARKUS_LOG_CATEGORY__ORG_HIBERNATE__LEVEL", "WARN"},
+			{"QUARKUS_LOG_CONSOLE_JSON", "true"},
+			{"FROM_CONFIGMAP", "configmap:config/key"},
+			{"FROM_SECRET", "secret:secret/key"},
+		}))
+		Expect(*env[6].ValueFrom.SecretKeyRef.Optional).To(BeTrue())
+	})
+
+	It("should not allow extraEnv to override operator owned variables", func() {
+		operatorEnv := []*v1.EnvVarApplyConfiguration{
+			v1.EnvVar().WithName("GINGERSNAP_K8S_NAMESPACE").WithValue("default"),
+			v1.EnvVar().WithName("GINGERSNAP_LEADER_ELECTION_ENABLED").WithValue("true"),
+			v1.EnvVar().WithName("GINGERSNAP_DYNAMIC_MEMBERSHIP").WithValue("true"),
+			v1.EnvVar().WithName("GINGERSNAP_PARTITIONING_ENABLED").WithValue("false"),
+			v1.EnvVar().WithName("GINGERSNAP_PREFLIGHT").WithValue("true"),
+			v1.EnvVar().WithName("SERVICE_BINDING_ROOT").WithValue("/bindings"),
+		}
+		var extraEnv []*v1alpha1.EnvVar
+		for _, e := range operatorEnv {
+			extraEnv = append(extraEnv, &v1alpha1.EnvVar{Name: *e.Name, Value: "overridden"})
+		}
+		extraEnv = append(extraEnv,
+			&v1alpha1.EnvVar{Name: "GINGERSNAP_PREFLIGHT_TABLE", Value: "overridden"},
+			&v1alpha1.EnvVar{Name: "GINGERSNAP_USER", Value: "user"},
+		)
+
+		Expect(values(reconcile.ContainerEnv(operatorEnv, nil, extraEnv))).To(Equal([][2]string{
+			{"GINGERSNAP_K8S_NAMESPACE", "default"},
+			{"GINGERSNAP_LEADER_ELECTION_ENABLED", "true"},
+			{"GINGERSNAP_DYNAMIC_MEMBERSHIP", "true"},
+			{"GINGERSNAP_PARTITIONING_ENABLED", "false"},
+			{"GINGERSNAP_PREFLIGHT", "true"},
+			{"SERVICE_BINDING_ROOT", "/bindings"},
+			{"GINGERSNAP_USER", "user"},
+		}))
+	})
+
+	It("should return nothing without any configuration", func() {
+		Expect(reconcile.ContainerEnv(nil, nil, nil)).To(BeEmpty())
+		Expect(reconcile.ContainerEnv(nil, &v1alpha1.Logging{}, nil)).To(BeEmpty())
+		Expect(reconcile.ContainerEnvFrom(nil)).To(BeEmpty())
+	})
+
+	It("should convert EnvFromSources", func() {
+		sources := reconcile.ContainerEnvFrom([]*v1alpha1.EnvFromSource{
+			{Prefix: "DB_", ConfigMapRef: &v1alpha1.LocalObjectReference{Name: "config"}},
+			{SecretRef: &v1alpha1.LocalObjectReference{Name: "secret"}},
+		})
+		Expect(sources).To(HaveLen(2))
+		Expect(*sources[0].Prefix).To(Equal("DB_"))
+		Expect(*sources[0].ConfigMapRef.Name).To(Equal("config"))
+		Expect(sources[0].SecretRef).To(BeNil())
+		Expect(sources[1].Prefix).To(BeNil())
+		Expect(sources[1].ConfigMapRef).To(BeNil())
+		Expect(*sources[1].SecretRef.Name).To(Equal("secret"))
+	})
+})
@@ -0,0 +1,18 @@
+package meta
+
+import "github.com/gingersnap-project/operator/api/v1alpha1"
+
+const (
+	// AnnotationCredentialsHash is added to pod templates so that a change to the data source credentials triggers a rollout
+	AnnotationCredentialsHash = v1alpha1.Group + "/credentials-hash"
+	// AnnotationCredentialsRefresh records when materialised credentials must next be refreshed
+	AnnotationCredentialsRefresh = v1alpha1.Group + "/credentials-refresh"
+	// AnnotationCredentialsSource records a hash of the provider configuration used to retrieve materialised credentials
+	AnnotationCredentialsSource = v1alpha1.Group + "/credentials-source"
+	// AnnotationCredentialsVersion records the provider specific version of materialised credentials
+	AnnotationCredentialsVersion = v1alpha1.Group + "/credentials-version"
+	// AnnotationCredentialsSuperseded records the lease of replaced dynamic credentials until it has been revoked
+	AnnotationCredentialsSuperseded = v1alpha1.Group + "/credentials-superseded"
+	// AnnotationPreflightHash records a hash of the inputs to a CDC preflight Job so that the check is repeated when they change
+	AnnotationPreflightHash = v1alpha1.Group + "/preflight-hash"
+)
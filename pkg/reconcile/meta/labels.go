@@ -1,10 +1,20 @@
 package meta
 
+import "github.com/gingersnap-project/operator/api/v1alpha1"
+
 const (
 	ComponentCache    = "cache"
 	ComponentDBSyncer = "db-syncer"
 )
 
+const (
+	// LabelRule the name of the rule that a resource created in the Cache namespace belongs to. Such resources cannot be
+	// owned by a rule in another namespace, so they are associated with the rule by label
+	LabelRule = v1alpha1.Group + "/rule"
+	// LabelRuleNamespace the namespace of the rule identified by LabelRule
+	LabelRuleNamespace = LabelRule + "-namespace"
+)
+
 func GingersnapLabels(name, component, instance string) map[string]string {
 	return map[string]string{
 		"app.kubernetes.io/name":       name,
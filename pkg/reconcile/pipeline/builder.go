@@ -1,6 +1,12 @@
 package pipeline
 
-import "github.com/gingersnap-project/operator/pkg/reconcile"
+import (
+	"fmt"
+
+	"github.com/gingersnap-project/operator/pkg/reconcile"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
 
 type Builder impl
 
@@ -9,14 +15,140 @@ func (b *Builder) WithContextProvider(ctxProvider reconcile.ContextProvider) *Bu
 	return b
 }
 
+// WithHandlers appends unconditional stages named after the function implementing each Handler. Handlers whose name
+// is already used by a stage are suffixed with their position in the pipeline
 func (b *Builder) WithHandlers(h ...reconcile.Handler) *Builder {
-	b.handlers = append(b.handlers, h...)
+	for _, handler := range h {
+		name := handlerName(handler)
+		for _, s := range b.stages {
+			if s.Name == name {
+				name = fmt.Sprintf("%s#%d", name, len(b.stages))
+				break
+			}
+		}
+		b.stages = append(b.stages, Stage{
+			Name:    name,
+			Handler: handler,
+		})
+	}
 	return b
 }
 
-func (b *Builder) Build() reconcile.Pipeline {
-	return &impl{
-		handlers:    b.handlers,
-		ctxProvider: b.ctxProvider,
+// WithStages appends the stages to the pipeline in the order provided
+func (b *Builder) WithStages(s ...Stage) *Builder {
+	b.stages = append(b.stages, s...)
+	return b
+}
+
+// WithParallelStages appends a named group of stages that are executed concurrently, each with a forked Context. The
+// FlowStatus of the group is the merge of its stages, so the pipeline stops once all stages of the group have finished
+// if any of them stopped processing. Stages in a group must be independent of one another: they cannot depend on other
+// stages in the group, and must neither populate the Context nor update the reconciled resource.
+func (b *Builder) WithParallelStages(name string, s ...Stage) *Builder {
+	b.stages = append(b.stages, Stage{
+		Name:  name,
+		Group: s,
+	})
+	return b
+}
+
+// WithTracerProvider sets the TracerProvider used to trace pipeline execution. Defaults to the global TracerProvider
+func (b *Builder) WithTracerProvider(tp trace.TracerProvider) *Builder {
+	b.tracerProvider = tp
+	return b
+}
+
+// WithBackoff sets the BackoffPolicy used to determine the delay of requeues requested via
+// reconcile.Context.RequeueWithBackoff. Defaults to reconcile.DefaultBackoff
+func (b *Builder) WithBackoff(policy reconcile.BackoffPolicy) *Builder {
+	b.backoff = &policy
+	return b
+}
+
+// Stages returns the names of the pipeline's stages in execution order. The stages of a parallel group follow the
+// name of the group
+func (b *Builder) Stages() []string {
+	var names []string
+	for _, s := range b.stages {
+		names = append(names, s.Name)
+		for _, g := range s.Group {
+			names = append(names, g.Name)
+		}
+	}
+	return names
+}
+
+// Build validates the configured stages and returns the Pipeline. An error is returned if a stage is unnamed, has no
+// Handler, shares its name with another stage or depends on a stage that does not precede it.
+func (b *Builder) Build() (reconcile.Pipeline, error) {
+	if b.ctxProvider == nil {
+		return nil, fmt.Errorf("pipeline ContextProvider must be configured")
+	}
+
+	declared := make(map[string]bool, len(b.stages))
+	validate := func(i int, s Stage) error {
+		if s.Name == "" {
+			return fmt.Errorf("pipeline stage %d must have a name", i)
+		}
+		if declared[s.Name] {
+			return fmt.Errorf("pipeline stage '%s' is declared more than once", s.Name)
+		}
+		for _, dependency := range s.DependsOn {
+			if !declared[dependency] {
+				return fmt.Errorf("pipeline stage '%s' depends on '%s', which must be declared before it", s.Name, dependency)
+			}
+		}
+		return nil
+	}
+
+	for i, s := range b.stages {
+		if err := validate(i, s); err != nil {
+			return nil, err
+		}
+
+		if s.Group == nil {
+			if s.Handler == nil {
+				return nil, fmt.Errorf("pipeline stage '%s' must have a Handler", s.Name)
+			}
+			declared[s.Name] = true
+			continue
+		}
+
+		if s.Handler != nil {
+			return nil, fmt.Errorf("pipeline stage '%s' must not have a Handler as it is a parallel group", s.Name)
+		}
+		// Stages in a group may only depend on stages declared before the group
+		group := make(map[string]bool, len(s.Group))
+		for j, g := range s.Group {
+			if err := validate(j, g); err != nil {
+				return nil, fmt.Errorf("parallel group '%s': %w", s.Name, err)
+			}
+			if group[g.Name] || g.Name == s.Name {
+				return nil, fmt.Errorf("parallel group '%s': stage '%s' is declared more than once", s.Name, g.Name)
+			}
+			if g.Handler == nil || g.Group != nil {
+				return nil, fmt.Errorf("parallel group '%s': stage '%s' must have a Handler", s.Name, g.Name)
+			}
+			group[g.Name] = true
+		}
+		for name := range group {
+			declared[name] = true
+		}
+		declared[s.Name] = true
 	}
+
+	tp := b.tracerProvider
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	backoff := reconcile.DefaultBackoff
+	if b.backoff != nil {
+		backoff = *b.backoff
+	}
+	return &impl{
+		stages:         b.stages,
+		ctxProvider:    b.ctxProvider,
+		tracerProvider: tp,
+		backoff:        &backoff,
+	}, nil
 }
@@ -33,6 +33,13 @@ func (i *ContextImpl) Ctx() context.Context {
 	return i.ctx
 }
 
+func (i *ContextImpl) SetCtx(ctx context.Context) {
+	i.ctx = ctx
+	if i.client != nil {
+		i.client = i.client.WithContext(ctx)
+	}
+}
+
 func (i *ContextImpl) Client() client.Client {
 	return i.client
 }
@@ -49,3 +56,9 @@ func (i *ContextImpl) Status() reconcile.FlowStatus {
 func (i *ContextImpl) Log() logr.Logger {
 	return i.log
 }
+
+func (i *ContextImpl) Fork() reconcile.Context {
+	fork := *i
+	fork.FlowStatus = reconcile.FlowStatus{}
+	return &fork
+}
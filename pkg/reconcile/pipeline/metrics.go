@@ -0,0 +1,28 @@
+package pipeline
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	stageDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "gingersnap_pipeline_stage_duration_seconds",
+			Help: "Time taken to execute a reconcile pipeline stage",
+		},
+		[]string{"resource", "stage"},
+	)
+
+	stageErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gingersnap_pipeline_stage_errors_total",
+			Help: "Number of reconcile pipeline stage executions that stopped the pipeline with an error",
+		},
+		[]string{"resource", "stage"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(stageDuration, stageErrors)
+}
@@ -1,21 +1,58 @@
 package pipeline
 
 import (
+	"context"
 	"fmt"
+	"reflect"
+	"runtime"
 	"runtime/debug"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/gingersnap-project/operator/pkg/reconcile"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var _ reconcile.Pipeline = &impl{}
 
+// TracerName is the name of the Tracer used to create pipeline spans
+const TracerName = "github.com/gingersnap-project/operator/pkg/reconcile/pipeline"
+
+const (
+	AttributeResourceKind      = attribute.Key("gingersnap.resource.kind")
+	AttributeResourceNamespace = attribute.Key("gingersnap.resource.namespace")
+	AttributeResourceName      = attribute.Key("gingersnap.resource.name")
+	AttributeHandler           = attribute.Key("gingersnap.pipeline.handler")
+	AttributeRequeue           = attribute.Key("gingersnap.pipeline.requeue")
+	AttributeRequeueAfter      = attribute.Key("gingersnap.pipeline.requeue_after")
+	AttributeStop              = attribute.Key("gingersnap.pipeline.stop")
+)
+
 type impl struct {
-	ctxProvider reconcile.ContextProvider
-	handlers    []reconcile.Handler
+	ctxProvider    reconcile.ContextProvider
+	stages         []Stage
+	tracerProvider trace.TracerProvider
+	backoff        *reconcile.BackoffPolicy
 }
 
 func (i *impl) Process(resource interface{}) (retry bool, delay time.Duration, err error) {
+	tracer := i.tracerProvider.Tracer(TracerName)
+	resourceKind := kind(resource)
+	// The reconcile span is started from the Context's reconcile context once it has been provided, a failure to
+	// provide the Context is not traced
+	span := trace.SpanFromContext(context.Background())
+	defer func() {
+		span.SetAttributes(flowAttributes(reconcile.FlowStatus{Retry: retry, Delay: delay, Err: err})...)
+		recordError(span, err)
+		span.End()
+	}()
+
 	defer func() {
 		if perr := recover(); perr != nil {
 			retry = true
@@ -26,26 +63,216 @@ func (i *impl) Process(resource interface{}) (retry bool, delay time.Duration, e
 	if err != nil {
 		return false, 0, err
 	}
+	spanCtx, span := tracer.Start(context.Ctx(), "Reconcile "+resourceKind, trace.WithAttributes(resourceAttributes(resource)...))
+	context.SetCtx(spanCtx)
 
 	var status reconcile.FlowStatus
-	for _, h := range i.handlers {
-		invokeHandler(h, resource, context)
-		status = context.Status()
+	for _, s := range i.stages {
+		if s.Predicate != nil && !s.Predicate.Test(resource, context) {
+			continue
+		}
+		if s.Group != nil {
+			status = invokeGroup(tracer, spanCtx, resourceKind, s, resource, context)
+		} else {
+			status = invokeStage(tracer, spanCtx, resourceKind, s, resource, context)
+		}
 		if status.Stop {
+			if status.Err != nil {
+				status.Err = fmt.Errorf("stage '%s': %w", s.Name, status.Err)
+			}
+			context.Log().V(1).Info("Pipeline stopped", "stage", s.Name, "requeue", status.Retry, "requeueAfter", status.Delay, "backoff", status.Backoff)
 			break
 		}
 	}
+	i.trackRetries(resource, &status, context)
 	return status.Retry, status.Delay, status.Err
 }
 
-func invokeHandler(h reconcile.Handler, i interface{}, ctx reconcile.Context) {
+// trackRetries determines the delay of a requeue with backoff from the number of consecutive attempts recorded by the
+// resource, and resets the attempts once the pipeline completes without requesting a requeue with backoff. Resources
+// that are not a reconcile.RetryTracker, or are being deleted, are requeued with the initial delay of the BackoffPolicy
+func (i *impl) trackRetries(resource interface{}, status *reconcile.FlowStatus, ctx reconcile.Context) {
+	tracker, ok := resource.(reconcile.RetryTracker)
+	obj, isObj := resource.(client.Object)
+	if !ok || !isObj || obj.GetDeletionTimestamp() != nil {
+		if status.Backoff {
+			status.Delay = i.backoff.Delay(1)
+		}
+		return
+	}
+
+	attempts := tracker.RetryAttempts()
+	if status.Backoff {
+		attempts++
+		status.Delay = i.backoff.Delay(attempts)
+		ctx.Log().V(1).Info("Requeue with backoff", "attempt", attempts, "requeueAfter", status.Delay)
+	} else if attempts == 0 {
+		return
+	} else {
+		attempts = 0
+	}
+
+	tracker.SetRetryAttempts(attempts)
+	if err := ctx.Client().UpdateStatus(obj); client.IgnoreNotFound(err) != nil {
+		err = fmt.Errorf("unable to update retry attempts: %w", err)
+		if status.Err != nil {
+			err = utilerrors.NewAggregate([]error{status.Err, err})
+		}
+		status.Retry = true
+		status.Err = err
+	}
+}
+
+func invokeStage(tracer trace.Tracer, spanCtx context.Context, resourceKind string, s Stage, i interface{}, ctx reconcile.Context) (status reconcile.FlowStatus) {
+	start := time.Now()
+	stageCtx, span := tracer.Start(spanCtx, s.Name, trace.WithAttributes(AttributeHandler.String(s.Name)))
+	ctx.SetCtx(stageCtx)
+	defer func() {
+		ctx.SetCtx(spanCtx)
+		status = ctx.Status()
+		span.SetAttributes(flowAttributes(status)...)
+		recordError(span, status.Err)
+		span.End()
+
+		stageDuration.WithLabelValues(resourceKind, s.Name).Observe(time.Since(start).Seconds())
+		if status.Err != nil {
+			stageErrors.WithLabelValues(resourceKind, s.Name).Inc()
+		}
+	}()
+
 	defer func() {
 		if err := recover(); err != nil {
 			e := fmt.Errorf("panic occurred: %v", err)
-			ctx.Log().Error(e, string(debug.Stack()))
+			ctx.Log().Error(e, string(debug.Stack()), "stage", s.Name)
 			ctx.Requeue(e)
 		}
 	}()
-	//fmt.Printf("Handler=%s\n", runtime.FuncForPC(reflect.ValueOf(h).Pointer()).Name())
-	h.Handle(i, ctx)
+	s.Handler.Handle(i, ctx)
+	return
+}
+
+// invokeGroup executes the stages of a parallel group concurrently, each with a forked Context, and merges their
+// FlowStatus into the pipeline's Context once all stages have finished
+func invokeGroup(tracer trace.Tracer, spanCtx context.Context, resourceKind string, group Stage, i interface{}, ctx reconcile.Context) reconcile.FlowStatus {
+	groupCtx, span := tracer.Start(spanCtx, group.Name, trace.WithAttributes(AttributeHandler.String(group.Name)))
+	defer span.End()
+
+	// Predicates are evaluated before any stage is executed so that they observe the Context consistently
+	var stages []Stage
+	for _, s := range group.Group {
+		if s.Predicate == nil || s.Predicate.Test(i, ctx) {
+			stages = append(stages, s)
+		}
+	}
+
+	statuses := make([]reconcile.FlowStatus, len(stages))
+	var wg sync.WaitGroup
+	for idx := range stages {
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			// Panics are recovered by invokeStage and reported as a requeue of the forked Context
+			statuses[idx] = invokeStage(tracer, groupCtx, resourceKind, stages[idx], i, ctx.Fork())
+		}(idx)
+	}
+	wg.Wait()
+
+	merged := mergeStatus(stages, statuses)
+	if merged.Stop {
+		if merged.Backoff {
+			ctx.RequeueWithBackoff(merged.Err)
+		} else if merged.Retry {
+			ctx.RequeueAfter(merged.Delay, merged.Err)
+		} else {
+			ctx.StopProcessing(merged.Err)
+		}
+	}
+
+	status := ctx.Status()
+	span.SetAttributes(flowAttributes(status)...)
+	recordError(span, status.Err)
+	return status
+}
+
+// mergeStatus combines the FlowStatus of concurrently executed stages. Processing stops if any stage stopped it, and
+// is requeued if any stage requested a requeue, using the shortest requested delay. A requeue with backoff is only
+// applied if no stage requested a requeue with an explicit delay. Errors are aggregated and reference the stage that
+// returned them.
+func mergeStatus(stages []Stage, statuses []reconcile.FlowStatus) reconcile.FlowStatus {
+	merged := reconcile.FlowStatus{}
+	var errs []error
+	for idx, status := range statuses {
+		if !status.Stop {
+			continue
+		}
+		if status.Backoff {
+			merged.Backoff = !merged.Retry || merged.Backoff
+			merged.Retry = true
+		} else if status.Retry {
+			if !merged.Retry || merged.Backoff || status.Delay < merged.Delay {
+				merged.Delay = status.Delay
+			}
+			merged.Retry = true
+			merged.Backoff = false
+		}
+		merged.Stop = true
+		if status.Err != nil {
+			errs = append(errs, fmt.Errorf("stage '%s': %w", stages[idx].Name, status.Err))
+		}
+	}
+	if len(errs) == 1 {
+		merged.Err = errs[0]
+	} else if len(errs) > 1 {
+		merged.Err = utilerrors.NewAggregate(errs)
+	}
+	return merged
+}
+
+// handlerName returns the package qualified name of the function implementing a Handler, e.g. eager.LoadCache
+func handlerName(h reconcile.Handler) string {
+	if v := reflect.ValueOf(h); v.Kind() == reflect.Func {
+		if f := runtime.FuncForPC(v.Pointer()); f != nil {
+			name := f.Name()
+			return name[strings.LastIndex(name, "/")+1:]
+		}
+	}
+	return fmt.Sprintf("%T", h)
+}
+
+// kind returns the type name of the reconciled resource
+func kind(resource interface{}) string {
+	t := reflect.TypeOf(resource)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Name() == "" {
+		return "resource"
+	}
+	return t.Name()
+}
+
+func resourceAttributes(resource interface{}) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{AttributeResourceKind.String(kind(resource))}
+	if obj, ok := resource.(metav1.Object); ok {
+		attrs = append(attrs,
+			AttributeResourceNamespace.String(obj.GetNamespace()),
+			AttributeResourceName.String(obj.GetName()),
+		)
+	}
+	return attrs
+}
+
+func flowAttributes(status reconcile.FlowStatus) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		AttributeRequeue.Bool(status.Retry),
+		AttributeRequeueAfter.String(status.Delay.String()),
+		AttributeStop.Bool(status.Stop),
+	}
+}
+
+func recordError(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
 }
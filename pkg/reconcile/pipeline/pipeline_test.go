@@ -1,17 +1,31 @@
 package pipeline_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	"github.com/gingersnap-project/operator/pkg/kubernetes/client"
 	"github.com/gingersnap-project/operator/pkg/reconcile"
 	"github.com/gingersnap-project/operator/pkg/reconcile/pipeline"
 	"github.com/go-logr/logr"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestBuilder(t *testing.T) {
@@ -32,6 +46,9 @@ var _ = Describe("Pipeline", func() {
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		ctx = reconcile.NewMockContext(mockCtrl)
+		ctx.EXPECT().Log().Return(logr.Discard()).AnyTimes()
+		ctx.EXPECT().Ctx().Return(context.TODO()).AnyTimes()
+		ctx.EXPECT().SetCtx(gomock.Any()).AnyTimes()
 	})
 
 	AfterEach(func() {
@@ -44,10 +61,9 @@ var _ = Describe("Pipeline", func() {
 		h2 := defHandler()
 		h2.EXPECT().Handle(resource, ctx)
 		builder := &pipeline.Builder{}
-		p := builder.
+		p := build(builder.
 			WithContextProvider(&ctxProvider{ctx: ctx}).
-			WithHandlers(h1, h2).
-			Build()
+			WithHandlers(h1, h2))
 
 		ctx.EXPECT().Status().Return(reconcile.FlowStatus{}).Times(2)
 
@@ -67,10 +83,9 @@ var _ = Describe("Pipeline", func() {
 		}
 		h3 := defHandler()
 		builder := &pipeline.Builder{}
-		p := builder.
+		p := build(builder.
 			WithContextProvider(&ctxProvider{ctx: ctx}).
-			WithHandlers(h1, reconcile.HandlerFunc(h2), h3).
-			Build()
+			WithHandlers(h1, reconcile.HandlerFunc(h2), h3))
 
 		ctx.EXPECT().Requeue(err)
 		ctx.EXPECT().Status().Return(reconcile.FlowStatus{})
@@ -92,12 +107,10 @@ var _ = Describe("Pipeline", func() {
 		}
 		h3 := defHandler()
 		builder := &pipeline.Builder{}
-		p := builder.
+		p := build(builder.
 			WithContextProvider(&ctxProvider{ctx: ctx}).
-			WithHandlers(h1, reconcile.HandlerFunc(h2), h3).
-			Build()
+			WithHandlers(h1, reconcile.HandlerFunc(h2), h3))
 
-		ctx.EXPECT().Log().Return(logr.Discard())
 		ctx.EXPECT().Requeue(err)
 		ctx.EXPECT().Status().Return(reconcile.FlowStatus{})
 		ctx.EXPECT().Status().Return(reconcile.FlowStatus{Retry: true, Stop: true, Err: err})
@@ -116,10 +129,9 @@ var _ = Describe("Pipeline", func() {
 		}
 		h3 := defHandler()
 		builder := &pipeline.Builder{}
-		p := builder.
+		p := build(builder.
 			WithContextProvider(&ctxProvider{ctx: ctx}).
-			WithHandlers(h1, reconcile.HandlerFunc(h2), h3).
-			Build()
+			WithHandlers(h1, reconcile.HandlerFunc(h2), h3))
 
 		ctx.EXPECT().StopProcessing(nil)
 		ctx.EXPECT().Status().Return(reconcile.FlowStatus{})
@@ -136,10 +148,9 @@ var _ = Describe("Pipeline", func() {
 		provider := reconcile.NewMockContextProvider(mockCtrl)
 		provider.EXPECT().Get(resource).DoAndReturn(func(b interface{}) { panic("foo") })
 		builder := &pipeline.Builder{}
-		p := builder.
+		p := build(builder.
 			WithContextProvider(provider).
-			WithHandlers(h1).
-			Build()
+			WithHandlers(h1))
 
 		retry, delay, err := p.Process(resource)
 		Expect(err).To(Equal(fmt.Errorf("panic occurred: %v", "foo")))
@@ -152,10 +163,9 @@ var _ = Describe("Pipeline", func() {
 			c.RequeueAfter(time.Second, nil)
 		}
 		builder := &pipeline.Builder{}
-		p := builder.
+		p := build(builder.
 			WithContextProvider(&ctxProvider{ctx: ctx}).
-			WithHandlers(reconcile.HandlerFunc(h1)).
-			Build()
+			WithHandlers(reconcile.HandlerFunc(h1)))
 
 		ctx.EXPECT().RequeueAfter(time.Second, nil)
 		ctx.EXPECT().Status().Return(reconcile.FlowStatus{Retry: true, Stop: true, Err: nil, Delay: time.Second})
@@ -165,8 +175,380 @@ var _ = Describe("Pipeline", func() {
 		Expect(delay).To(Equal(time.Second))
 		Expect(retry).To(BeTrue())
 	})
+
+	It("should trace the reconciliation and each handler", func() {
+		exporter := tracetest.NewInMemoryExporter()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+		err := errors.New("foo")
+
+		h1 := defHandler()
+		h1.EXPECT().Handle(gomock.Any(), ctx)
+		builder := &pipeline.Builder{}
+		p := build(builder.
+			WithContextProvider(&ctxProvider{ctx: ctx}).
+			WithHandlers(h1, reconcile.HandlerFunc(requeueHandler)).
+			WithTracerProvider(tp))
+
+		ctx.EXPECT().Requeue(err)
+		ctx.EXPECT().Status().Return(reconcile.FlowStatus{})
+		ctx.EXPECT().Status().Return(reconcile.FlowStatus{Retry: true, Stop: true, Err: err})
+
+		_, _, _ = p.Process(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "namespace"}})
+
+		spans := exporter.GetSpans()
+		Expect(spans).To(HaveLen(3))
+		mockSpan, requeueSpan, reconcileSpan := spans[0], spans[1], spans[2]
+
+		Expect(reconcileSpan.Name).To(Equal("Reconcile ConfigMap"))
+		Expect(reconcileSpan.Attributes).To(ContainElements(
+			pipeline.AttributeResourceKind.String("ConfigMap"),
+			pipeline.AttributeResourceNamespace.String("namespace"),
+			pipeline.AttributeResourceName.String("name"),
+			pipeline.AttributeRequeue.Bool(true),
+		))
+		Expect(reconcileSpan.Status.Code).To(Equal(codes.Error))
+
+		Expect(mockSpan.Name).To(Equal("*reconcile.MockHandler"))
+		Expect(mockSpan.Parent.SpanID()).To(Equal(reconcileSpan.SpanContext.SpanID()))
+		Expect(mockSpan.Attributes).To(ContainElement(pipeline.AttributeRequeue.Bool(false)))
+		Expect(mockSpan.Status.Code).To(Equal(codes.Unset))
+
+		Expect(requeueSpan.Name).To(Equal("pipeline_test.requeueHandler"))
+		Expect(requeueSpan.Parent.SpanID()).To(Equal(reconcileSpan.SpanContext.SpanID()))
+		Expect(requeueSpan.Attributes).To(ContainElements(
+			pipeline.AttributeRequeue.Bool(true),
+			pipeline.AttributeStop.Bool(true),
+		))
+		Expect(requeueSpan.Status.Code).To(Equal(codes.Error))
+		Expect(requeueSpan.Status.Description).To(Equal("foo"))
+	})
+
+	It("should trace the reconciliation as a child of the reconcile context and propagate the handler spans", func() {
+		exporter := tracetest.NewInMemoryExporter()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+		reconcileCtx, parent := tp.Tracer("test").Start(context.Background(), "Controller")
+		defer parent.End()
+
+		var handlerSpan trace.SpanContext
+		var clientCtx context.Context
+		handler := reconcile.HandlerFunc(func(_ interface{}, c reconcile.Context) {
+			handlerSpan = trace.SpanContextFromContext(c.Ctx())
+			clientCtx = c.Client().(*client.Runtime).Ctx
+		})
+		provider := reconcile.ContextProviderFunc(func(interface{}) (reconcile.Context, error) {
+			return pipeline.NewContext(reconcileCtx, logr.Discard(), nil, &client.Runtime{Ctx: reconcileCtx}), nil
+		})
+		p := build((&pipeline.Builder{}).
+			WithContextProvider(provider).
+			WithStages(pipeline.Stage{Name: "Handler", Handler: handler}).
+			WithTracerProvider(tp))
+
+		_, _, err := p.Process(&corev1.ConfigMap{})
+		Expect(err).NotTo(HaveOccurred())
+
+		spans := exporter.GetSpans()
+		Expect(spans).To(HaveLen(2))
+		stageSpan, reconcileSpan := spans[0], spans[1]
+		Expect(reconcileSpan.Parent.SpanID()).To(Equal(parent.SpanContext().SpanID()))
+		Expect(stageSpan.Parent.SpanID()).To(Equal(reconcileSpan.SpanContext.SpanID()))
+		Expect(handlerSpan.SpanID()).To(Equal(stageSpan.SpanContext.SpanID()))
+		Expect(trace.SpanContextFromContext(clientCtx).SpanID()).To(Equal(stageSpan.SpanContext.SpanID()))
+	})
 })
 
+var _ = Describe("Builder", func() {
+	var (
+		mockCtrl *gomock.Controller
+		ctx      *reconcile.MockContext
+		noop     = reconcile.HandlerFunc(func(interface{}, reconcile.Context) {})
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		ctx = reconcile.NewMockContext(mockCtrl)
+		ctx.EXPECT().Log().Return(logr.Discard()).AnyTimes()
+		ctx.EXPECT().Ctx().Return(context.TODO()).AnyTimes()
+		ctx.EXPECT().SetCtx(gomock.Any()).AnyTimes()
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("should name stages added as handlers after their function", func() {
+		builder := &pipeline.Builder{}
+		builder.
+			WithHandlers(reconcile.HandlerFunc(requeueHandler)).
+			WithStages(pipeline.Stage{Name: "Noop", Handler: noop})
+
+		Expect(builder.Stages()).To(Equal([]string{"pipeline_test.requeueHandler", "Noop"}))
+	})
+
+	It("should reject invalid stages", func() {
+		build := func(stages ...pipeline.Stage) error {
+			_, err := (&pipeline.Builder{}).
+				WithContextProvider(&ctxProvider{ctx: ctx}).
+				WithStages(stages...).
+				Build()
+			return err
+		}
+
+		Expect(build(pipeline.Stage{Handler: noop})).To(MatchError("pipeline stage 0 must have a name"))
+		Expect(build(pipeline.Stage{Name: "A"})).To(MatchError("pipeline stage 'A' must have a Handler"))
+		Expect(build(
+			pipeline.Stage{Name: "A", Handler: noop},
+			pipeline.Stage{Name: "A", Handler: noop},
+		)).To(MatchError("pipeline stage 'A' is declared more than once"))
+		Expect(build(
+			pipeline.Stage{Name: "A", Handler: noop, DependsOn: []string{"B"}},
+			pipeline.Stage{Name: "B", Handler: noop},
+		)).To(MatchError("pipeline stage 'A' depends on 'B', which must be declared before it"))
+		Expect(build(
+			pipeline.Stage{Name: "A", Handler: noop},
+			pipeline.Stage{Name: "B", Handler: noop, DependsOn: []string{"A"}},
+		)).To(Succeed())
+	})
+
+	It("should skip stages whose predicate is not satisfied", func() {
+		gvk := corev1.SchemeGroupVersion.WithKind("ConfigMap")
+		skipped := reconcile.NewMockHandler(mockCtrl)
+		invoked := reconcile.NewMockHandler(mockCtrl)
+		invoked.EXPECT().Handle(gomock.Any(), ctx)
+
+		p := build((&pipeline.Builder{}).
+			WithContextProvider(&ctxProvider{ctx: ctx}).
+			WithStages(
+				pipeline.Stage{Name: "Skipped", Handler: skipped, Predicate: pipeline.TypeSupported(gvk)},
+				pipeline.Stage{Name: "Invoked", Handler: invoked},
+			))
+
+		ctx.EXPECT().IsTypeSupported(gvk).Return(false)
+		ctx.EXPECT().Status().Return(reconcile.FlowStatus{})
+
+		retry, _, err := p.Process(struct{}{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(retry).To(BeFalse())
+	})
+
+	It("should reference the stage in returned errors", func() {
+		p := build((&pipeline.Builder{}).
+			WithContextProvider(&ctxProvider{ctx: ctx}).
+			WithStages(pipeline.Stage{Name: "Requeue", Handler: reconcile.HandlerFunc(requeueHandler)}))
+
+		ctx.EXPECT().Requeue(gomock.Any())
+		ctx.EXPECT().Status().Return(reconcile.FlowStatus{Retry: true, Stop: true, Err: errors.New("foo")})
+
+		_, _, err := p.Process(struct{}{})
+		Expect(err).To(MatchError("stage 'Requeue': foo"))
+	})
+})
+
+var _ = Describe("Parallel stages", func() {
+	var ctx reconcile.Context
+
+	BeforeEach(func() {
+		ctx = pipeline.NewContext(context.TODO(), logr.Discard(), nil, nil)
+	})
+
+	// barrier returns a Handler that blocks until n handlers have been invoked, failing if they are not concurrent
+	barrier := func(n int, handler reconcile.HandlerFunc) func() reconcile.Handler {
+		var started sync.WaitGroup
+		started.Add(n)
+		return func() reconcile.Handler {
+			return reconcile.HandlerFunc(func(i interface{}, c reconcile.Context) {
+				started.Done()
+				done := make(chan struct{})
+				go func() {
+					started.Wait()
+					close(done)
+				}()
+				Eventually(done, time.Second).Should(BeClosed())
+				handler(i, c)
+			})
+		}
+	}
+
+	It("should execute stages concurrently and merge their status", func() {
+		var invoked int32
+		next := barrier(3, func(interface{}, reconcile.Context) { atomic.AddInt32(&invoked, 1) })
+		requeue := func(delay time.Duration, err error) reconcile.Handler {
+			h := next()
+			return reconcile.HandlerFunc(func(i interface{}, c reconcile.Context) {
+				h.Handle(i, c)
+				c.RequeueAfter(delay, err)
+			})
+		}
+		after := reconcile.HandlerFunc(func(interface{}, reconcile.Context) {
+			Fail("stage after a stopped group must not be executed")
+		})
+
+		p := build((&pipeline.Builder{}).
+			WithContextProvider(&ctxProvider{ctx: ctx}).
+			WithParallelStages("Group",
+				pipeline.Stage{Name: "A", Handler: next()},
+				pipeline.Stage{Name: "B", Handler: requeue(time.Minute, errors.New("foo"))},
+				pipeline.Stage{Name: "C", Handler: requeue(time.Second, nil)},
+			).
+			WithStages(pipeline.Stage{Name: "After", Handler: after}))
+
+		retry, delay, err := p.Process(struct{}{})
+		Expect(atomic.LoadInt32(&invoked)).To(Equal(int32(3)))
+		Expect(retry).To(BeTrue())
+		Expect(delay).To(Equal(time.Second))
+		Expect(err).To(MatchError("stage 'Group': stage 'B': foo"))
+	})
+
+	It("should continue once all stages have finished without stopping", func() {
+		next := barrier(2, func(interface{}, reconcile.Context) {})
+		var after bool
+
+		p := build((&pipeline.Builder{}).
+			WithContextProvider(&ctxProvider{ctx: ctx}).
+			WithParallelStages("Group",
+				pipeline.Stage{Name: "A", Handler: next()},
+				pipeline.Stage{Name: "B", Handler: next()},
+				pipeline.Stage{Name: "Skipped", Handler: next(), Predicate: pipeline.PredicateFunc(func(interface{}, reconcile.Context) bool {
+					return false
+				})},
+			).
+			WithStages(pipeline.Stage{Name: "After", Handler: reconcile.HandlerFunc(func(interface{}, reconcile.Context) {
+				after = true
+			})}))
+
+		retry, _, err := p.Process(struct{}{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(retry).To(BeFalse())
+		Expect(after).To(BeTrue())
+	})
+
+	It("should isolate panics to the stage that raised them", func() {
+		var completed bool
+		p := build((&pipeline.Builder{}).
+			WithContextProvider(&ctxProvider{ctx: ctx}).
+			WithParallelStages("Group",
+				pipeline.Stage{Name: "Panic", Handler: reconcile.HandlerFunc(func(interface{}, reconcile.Context) {
+					panic("foo")
+				})},
+				pipeline.Stage{Name: "Complete", Handler: reconcile.HandlerFunc(func(interface{}, reconcile.Context) {
+					completed = true
+				})},
+			))
+
+		retry, _, err := p.Process(struct{}{})
+		Expect(completed).To(BeTrue())
+		Expect(retry).To(BeTrue())
+		Expect(err).To(MatchError("stage 'Group': stage 'Panic': panic occurred: foo"))
+	})
+
+	It("should reject dependencies between stages of a group", func() {
+		noop := reconcile.HandlerFunc(func(interface{}, reconcile.Context) {})
+		_, err := (&pipeline.Builder{}).
+			WithContextProvider(&ctxProvider{ctx: ctx}).
+			WithParallelStages("Group",
+				pipeline.Stage{Name: "A", Handler: noop},
+				pipeline.Stage{Name: "B", Handler: noop, DependsOn: []string{"A"}},
+			).
+			Build()
+		Expect(err).To(MatchError("parallel group 'Group': pipeline stage 'B' depends on 'A', which must be declared before it"))
+	})
+})
+
+var _ = Describe("Backoff", func() {
+	var (
+		rule     *v1alpha1.LazyCacheRule
+		provider reconcile.ContextProviderFunc
+		fail     bool
+		backoff  = reconcile.BackoffPolicy{Initial: time.Second, Max: 4 * time.Second, Factor: 2}
+	)
+
+	handler := reconcile.HandlerFunc(func(_ interface{}, c reconcile.Context) {
+		if fail {
+			c.RequeueWithBackoff(nil)
+		}
+	})
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+		rule = &v1alpha1.LazyCacheRule{
+			ObjectMeta: metav1.ObjectMeta{Name: "rule", Namespace: "default"},
+		}
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(rule).Build()
+		Expect(c.Get(context.TODO(), runtimeClient.ObjectKeyFromObject(rule), rule)).To(Succeed())
+		provider = func(interface{}) (reconcile.Context, error) {
+			return pipeline.NewContext(context.TODO(), logr.Discard(), nil, &client.Runtime{
+				Client:    c,
+				Ctx:       context.TODO(),
+				Namespace: rule.Namespace,
+			}), nil
+		}
+		fail = true
+	})
+
+	It("should increase the delay of consecutive attempts up to the cap and reset it on progress", func() {
+		p := build((&pipeline.Builder{}).
+			WithContextProvider(provider).
+			WithBackoff(backoff).
+			WithHandlers(handler))
+
+		for i, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second} {
+			retry, delay, err := p.Process(rule)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retry).To(BeTrue())
+			Expect(delay).To(Equal(expected))
+			Expect(rule.Status.RetryAttempts).To(Equal(int32(i + 1)))
+		}
+
+		fail = false
+		retry, delay, err := p.Process(rule)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(retry).To(BeFalse())
+		Expect(delay).To(Equal(time.Duration(0)))
+		Expect(rule.Status.RetryAttempts).To(Equal(int32(0)))
+	})
+
+	It("should prefer an explicit delay over backoff when merging parallel stages", func() {
+		p := build((&pipeline.Builder{}).
+			WithContextProvider(provider).
+			WithBackoff(backoff).
+			WithParallelStages("Group",
+				pipeline.Stage{Name: "Backoff", Handler: handler},
+				pipeline.Stage{Name: "Delay", Handler: reconcile.HandlerFunc(func(_ interface{}, c reconcile.Context) {
+					c.RequeueAfter(time.Minute, nil)
+				})},
+			))
+
+		retry, delay, err := p.Process(rule)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(retry).To(BeTrue())
+		Expect(delay).To(Equal(time.Minute))
+		Expect(rule.Status.RetryAttempts).To(Equal(int32(0)))
+	})
+
+	It("should add jitter to the delay", func() {
+		policy := reconcile.BackoffPolicy{Initial: time.Second, Max: time.Minute, Factor: 2, Jitter: 0.5}
+		for attempt := int32(1); attempt <= 8; attempt++ {
+			base := time.Second << (attempt - 1)
+			if base > time.Minute {
+				base = time.Minute
+			}
+			delay := policy.Delay(attempt)
+			Expect(delay).To(BeNumerically(">=", base))
+			Expect(delay).To(BeNumerically("<=", base+base/2))
+		}
+	})
+})
+
+func build(b *pipeline.Builder) reconcile.Pipeline {
+	p, err := b.Build()
+	Expect(err).NotTo(HaveOccurred())
+	return p
+}
+
+func requeueHandler(_ interface{}, c reconcile.Context) {
+	c.Requeue(errors.New("foo"))
+}
+
 var _ reconcile.ContextProvider = &ctxProvider{}
 
 type ctxProvider struct {
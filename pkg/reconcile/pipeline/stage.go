@@ -0,0 +1,38 @@
+package pipeline
+
+import (
+	"github.com/gingersnap-project/operator/pkg/reconcile"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Stage a named Handler in the pipeline
+type Stage struct {
+	// Name uniquely identifies the stage within the pipeline. Used in logs, traces, metrics and errors
+	Name string
+	// Handler invoked when the stage is executed
+	Handler reconcile.Handler
+	// Predicate that must be satisfied for the Handler to be invoked. The stage is always executed if nil
+	Predicate Predicate
+	// DependsOn the names of the stages that must precede this stage, e.g. a stage that loads a resource into the context
+	DependsOn []string
+	// Group the stages executed concurrently in place of a Handler. See Builder.WithParallelStages
+	Group []Stage
+}
+
+// Predicate determines whether a Stage should be executed for the current resource
+type Predicate interface {
+	Test(i interface{}, ctx reconcile.Context) bool
+}
+
+type PredicateFunc func(i interface{}, ctx reconcile.Context) bool
+
+func (f PredicateFunc) Test(i interface{}, ctx reconcile.Context) bool {
+	return f(i, ctx)
+}
+
+// TypeSupported returns a Predicate that is satisfied when the GVK is supported on the kubernetes cluster
+func TypeSupported(gvk schema.GroupVersionKind) Predicate {
+	return PredicateFunc(func(_ interface{}, ctx reconcile.Context) bool {
+		return ctx.IsTypeSupported(gvk)
+	})
+}
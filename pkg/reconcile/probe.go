@@ -0,0 +1,23 @@
+package reconcile
+
+import (
+	apicorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	corev1 "k8s.io/client-go/applyconfigurations/core/v1"
+)
+
+// HTTPProbe returns a Probe of the Quarkus SmallRye Health endpoint
+func HTTPProbe(endpoint string, failureThreshold, initialDelay, period, successThreshold, timeout int32, port int) *corev1.ProbeApplyConfiguration {
+	return corev1.Probe().
+		WithHTTPGet(
+			corev1.HTTPGetAction().
+				WithScheme(apicorev1.URISchemeHTTP).
+				WithPath("q/health/" + endpoint).
+				WithPort(intstr.FromInt(port)),
+		).
+		WithFailureThreshold(failureThreshold).
+		WithInitialDelaySeconds(initialDelay).
+		WithPeriodSeconds(period).
+		WithSuccessThreshold(successThreshold).
+		WithTimeoutSeconds(timeout)
+}
@@ -66,6 +66,20 @@ func (mr *MockContextMockRecorder) Ctx() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ctx", reflect.TypeOf((*MockContext)(nil).Ctx))
 }
 
+// Fork mocks base method.
+func (m *MockContext) Fork() Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fork")
+	ret0, _ := ret[0].(Context)
+	return ret0
+}
+
+// Fork indicates an expected call of Fork.
+func (mr *MockContextMockRecorder) Fork() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fork", reflect.TypeOf((*MockContext)(nil).Fork))
+}
+
 // IsTypeSupported mocks base method.
 func (m *MockContext) IsTypeSupported(arg0 schema.GroupVersionKind) bool {
 	m.ctrl.T.Helper()
@@ -118,6 +132,30 @@ func (mr *MockContextMockRecorder) RequeueAfter(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequeueAfter", reflect.TypeOf((*MockContext)(nil).RequeueAfter), arg0, arg1)
 }
 
+// RequeueWithBackoff mocks base method.
+func (m *MockContext) RequeueWithBackoff(arg0 error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RequeueWithBackoff", arg0)
+}
+
+// RequeueWithBackoff indicates an expected call of RequeueWithBackoff.
+func (mr *MockContextMockRecorder) RequeueWithBackoff(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequeueWithBackoff", reflect.TypeOf((*MockContext)(nil).RequeueWithBackoff), arg0)
+}
+
+// SetCtx mocks base method.
+func (m *MockContext) SetCtx(arg0 context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetCtx", arg0)
+}
+
+// SetCtx indicates an expected call of SetCtx.
+func (mr *MockContextMockRecorder) SetCtx(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCtx", reflect.TypeOf((*MockContext)(nil).SetCtx), arg0)
+}
+
 // Status mocks base method.
 func (m *MockContext) Status() FlowStatus {
 	m.ctrl.T.Helper()
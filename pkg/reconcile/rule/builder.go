@@ -1,6 +1,8 @@
 package rule
 
 import (
+	"time"
+
 	"github.com/gingersnap-project/operator/api/v1alpha1"
 	"github.com/gingersnap-project/operator/pkg/reconcile"
 	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -9,6 +11,10 @@ import (
 type Context struct {
 	reconcile.Context
 	Cache *v1alpha1.Cache
+	// Credentials the resolved data source credentials of the Cache, nil if they are not yet available
+	Credentials *reconcile.DataSourceCredentials
+	// PreflightRetry the delay before a failed CDC preflight check is repeated, zero if no check has failed
+	PreflightRetry time.Duration
 }
 
 type CacheRule interface {
@@ -25,6 +31,14 @@ func (f HandlerFunc) Handle(i interface{}, ctx reconcile.Context) {
 	f(i.(CacheRule), ctx.(*Context))
 }
 
+// Fork returns a copy of the Context with an independent FlowStatus. Changes made to the fields of a forked Context are
+// not visible to the pipeline, so Handlers executed concurrently must not populate the Context
+func (c *Context) Fork() reconcile.Context {
+	fork := *c
+	fork.Context = c.Context.Fork()
+	return &fork
+}
+
 func NewContextProvider(ctx reconcile.Context) reconcile.ContextProviderFunc {
 	return func(i interface{}) (reconcile.Context, error) {
 		return &Context{
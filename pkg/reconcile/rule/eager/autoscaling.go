@@ -0,0 +1,168 @@
+package eager
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	kedav1alpha1 "github.com/gingersnap-project/operator/pkg/apis/keda/v1alpha1"
+	kedaapplyv1alpha1 "github.com/gingersnap-project/operator/pkg/applyconfigurations/keda/v1alpha1"
+	"github.com/gingersnap-project/operator/pkg/dbsyncer"
+	"github.com/gingersnap-project/operator/pkg/kubernetes/client"
+	"github.com/gingersnap-project/operator/pkg/reconcile"
+	"github.com/gingersnap-project/operator/pkg/reconcile/meta"
+	"github.com/gingersnap-project/operator/pkg/reconcile/rule"
+	apiappsv1 "k8s.io/api/apps/v1"
+	apiautoscalingv2 "k8s.io/api/autoscaling/v2"
+	apicorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	autoscalingv2 "k8s.io/client-go/applyconfigurations/autoscaling/v2"
+	corev1 "k8s.io/client-go/applyconfigurations/core/v1"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ApplyDBSyncerAutoscaler scales the db-syncer Deployment according to the replication lag reported by db-syncer. A
+// KEDA ScaledObject is applied if KEDA is installed on the cluster, otherwise a HorizontalPodAutoscaler on the
+// dbsyncer.LagMetric custom metric. The autoscaler is removed when autoscaling is disabled on the Cache.
+//
+// The autoscaling resources are shared by all EagerCacheRules of the Cache, so they are owned by the Cache rather than
+// by the rule that last applied them
+func ApplyDBSyncerAutoscaler(_ *v1alpha1.EagerCacheRule, ctx *rule.Context) {
+	cache := ctx.Cache
+	keda := ctx.IsTypeSupported(reconcile.ScaledObjectGVK)
+	if cache.DBSyncerAutoscaling() == nil {
+		if err := removeDBSyncerAutoscaler(cache.CacheService(), keda, ctx); err != nil {
+			ctx.Requeue(err)
+		}
+		return
+	}
+
+	if keda {
+		applyScaledObject(cache, ctx)
+		return
+	}
+	applyHorizontalPodAutoscaler(cache, ctx)
+}
+
+// applyScaledObject scales the db-syncer via a KEDA metrics-api trigger, which polls the cluster wide replication lag
+// from the db-syncer Service
+func applyScaledObject(cache *v1alpha1.Cache, ctx *rule.Context) {
+	labels := meta.GingersnapLabels("db-syncer", meta.ComponentDBSyncer, cache.Name)
+	name := cache.CacheService().DBSyncerName()
+
+	service := corev1.Service(name, cache.Namespace).
+		WithLabels(labels).
+		WithOwnerReferences(client.OwnerReference(cache)).
+		WithSpec(corev1.ServiceSpec().
+			WithSelector(labels).
+			WithPorts(
+				corev1.ServicePort().
+					WithName("http").
+					WithPort(dbsyncer.DefaultPort).
+					WithTargetPort(intstr.FromInt(dbsyncer.DefaultPort)),
+			),
+		)
+	if _, err := ctx.Client().Apply(service, client.DetermineResult); err != nil {
+		ctx.Requeue(fmt.Errorf("unable to apply db-syncer Service: %w", err))
+		return
+	}
+
+	url := fmt.Sprintf("http://%s.%s.svc:%d%s", name, cache.Namespace, dbsyncer.DefaultPort, dbsyncer.LagPath)
+	scaledObject := kedaapplyv1alpha1.ScaledObject(name, cache.Namespace).
+		WithLabels(labels).
+		WithOwnerReferences(client.OwnerReference(cache)).
+		WithSpec(kedaapplyv1alpha1.ScaledObjectSpec().
+			WithScaleTargetRef(kedaapplyv1alpha1.ScaleTarget().
+				WithAPIVersion(apiappsv1.SchemeGroupVersion.String()).
+				WithKind("Deployment").
+				WithName(name),
+			).
+			WithMinReplicaCount(cache.DBSyncerMinReplicas()).
+			WithMaxReplicaCount(cache.DBSyncerAutoscaling().MaxReplicas).
+			WithTriggers(
+				kedaapplyv1alpha1.ScaleTriggers().
+					WithType("metrics-api").
+					WithName("replication-lag").
+					WithMetricType(apiautoscalingv2.AverageValueMetricType).
+					WithMetadata(map[string]string{
+						"url":           url,
+						"valueLocation": dbsyncer.LagValueLocation,
+						"targetValue":   strconv.FormatInt(targetLagSeconds(cache), 10),
+					}),
+			),
+		)
+	if _, err := ctx.Client().Apply(scaledObject, client.DetermineResult); err != nil {
+		ctx.Requeue(fmt.Errorf("unable to apply db-syncer ScaledObject: %w", err))
+		return
+	}
+
+	// Remove the HorizontalPodAutoscaler applied before KEDA was installed, as KEDA manages its own
+	if err := removeIfExists(name, &apiautoscalingv2.HorizontalPodAutoscaler{}, ctx.Client().WithNamespace(cache.Namespace)); err != nil {
+		ctx.Requeue(fmt.Errorf("unable to remove db-syncer HorizontalPodAutoscaler: %w", err))
+	}
+}
+
+// applyHorizontalPodAutoscaler scales the db-syncer on the average of the dbsyncer.LagMetric reported by its pods. The
+// metric must be made available via the custom metrics API, e.g. by the Prometheus Adapter
+func applyHorizontalPodAutoscaler(cache *v1alpha1.Cache, ctx *rule.Context) {
+	labels := meta.GingersnapLabels("db-syncer", meta.ComponentDBSyncer, cache.Name)
+	name := cache.CacheService().DBSyncerName()
+
+	hpa := autoscalingv2.HorizontalPodAutoscaler(name, cache.Namespace).
+		WithLabels(labels).
+		WithOwnerReferences(client.OwnerReference(cache)).
+		WithSpec(autoscalingv2.HorizontalPodAutoscalerSpec().
+			WithScaleTargetRef(autoscalingv2.CrossVersionObjectReference().
+				WithAPIVersion(apiappsv1.SchemeGroupVersion.String()).
+				WithKind("Deployment").
+				WithName(name),
+			).
+			WithMinReplicas(cache.DBSyncerMinReplicas()).
+			WithMaxReplicas(cache.DBSyncerAutoscaling().MaxReplicas).
+			WithMetrics(
+				autoscalingv2.MetricSpec().
+					WithType(apiautoscalingv2.PodsMetricSourceType).
+					WithPods(autoscalingv2.PodsMetricSource().
+						WithMetric(autoscalingv2.MetricIdentifier().WithName(dbsyncer.LagMetric)).
+						WithTarget(autoscalingv2.MetricTarget().
+							WithType(apiautoscalingv2.AverageValueMetricType).
+							WithAverageValue(*resource.NewQuantity(targetLagSeconds(cache), resource.DecimalSI)),
+						),
+					),
+			),
+		)
+	if _, err := ctx.Client().Apply(hpa, client.DetermineResult); err != nil {
+		ctx.Requeue(fmt.Errorf("unable to apply db-syncer HorizontalPodAutoscaler: %w", err))
+	}
+}
+
+// removeDBSyncerAutoscaler removes the resources created to autoscale the db-syncer of the Cache
+func removeDBSyncerAutoscaler(cacheService v1alpha1.CacheService, keda bool, ctx *rule.Context) error {
+	name := cacheService.DBSyncerName()
+	objs := []runtimeClient.Object{&apiautoscalingv2.HorizontalPodAutoscaler{}, &apicorev1.Service{}}
+	if keda {
+		objs = append(objs, &kedav1alpha1.ScaledObject{})
+	}
+	c := ctx.Client().WithNamespace(cacheService.Namespace)
+	for _, obj := range objs {
+		if err := removeIfExists(name, obj, c); err != nil {
+			return fmt.Errorf("unable to remove db-syncer autoscaler %T: %w", obj, err)
+		}
+	}
+	return nil
+}
+
+// removeIfExists deletes the named resource if it's present in the client's cache, so that reconciling a Cache without
+// autoscaling does not issue a DELETE request for each of the autoscaling resources
+func removeIfExists(name string, obj runtimeClient.Object, c client.Client) error {
+	if err := c.Load(name, obj); err != nil {
+		return runtimeClient.IgnoreNotFound(err)
+	}
+	return runtimeClient.IgnoreNotFound(c.Delete(name, obj))
+}
+
+func targetLagSeconds(cache *v1alpha1.Cache) int64 {
+	return int64(math.Ceil(cache.DBSyncerTargetLag().Seconds()))
+}
@@ -15,24 +15,78 @@ func (f HandlerFunc) Handle(i interface{}, ctx reconcile.Context) {
 
 func PipelineBuilder() *pipeline.Builder {
 	builder := &pipeline.Builder{}
-	builder.WithHandlers(
-		HandlerFunc(LoadCache),
-		rule.HandlerFunc(rule.AddFinalizer),
-		rule.HandlerFunc(rule.ApplyRuleConfigMap),
-		HandlerFunc(ApplyDBServiceBinding),
-		HandlerFunc(ApplyCacheServiceBinding),
-		HandlerFunc(ApplyDBSyncer),
-		HandlerFunc(ConditionReady),
-	)
-	return builder
+	return builder.
+		WithStages(
+			pipeline.Stage{Name: "RepairLabels", Handler: rule.HandlerFunc(rule.RepairLabels)},
+			pipeline.Stage{Name: "LoadCache", Handler: HandlerFunc(LoadCache)},
+			pipeline.Stage{Name: "AddFinalizer", Handler: rule.HandlerFunc(rule.AddFinalizer)},
+			pipeline.Stage{
+				Name:      "ApplyRuleConfigMap",
+				Handler:   rule.HandlerFunc(rule.ApplyRuleConfigMap),
+				DependsOn: []string{"LoadCache"},
+			},
+		).
+		WithParallelStages("ApplyServiceBindings",
+			pipeline.Stage{
+				Name:      "ApplyDBServiceBinding",
+				Handler:   HandlerFunc(ApplyDBServiceBinding),
+				DependsOn: []string{"LoadCache"},
+			},
+			pipeline.Stage{
+				Name:      "ApplyCacheServiceBinding",
+				Handler:   HandlerFunc(ApplyCacheServiceBinding),
+				DependsOn: []string{"LoadCache"},
+			},
+		).
+		WithStages(
+			pipeline.Stage{
+				Name:      "DataSourceCredentials",
+				Handler:   HandlerFunc(DataSourceCredentials),
+				DependsOn: []string{"LoadCache"},
+			},
+			pipeline.Stage{
+				Name:      "CDCPreflight",
+				Handler:   HandlerFunc(CDCPreflight),
+				DependsOn: []string{"DataSourceCredentials"},
+			},
+			pipeline.Stage{
+				Name:      "ApplyDBSyncer",
+				Handler:   HandlerFunc(ApplyDBSyncer),
+				DependsOn: []string{"DataSourceCredentials"},
+			},
+			pipeline.Stage{
+				Name:      "ApplyDBSyncerAutoscaler",
+				Handler:   HandlerFunc(ApplyDBSyncerAutoscaler),
+				DependsOn: []string{"ApplyDBSyncer"},
+			},
+			pipeline.Stage{
+				Name:      "ConditionReady",
+				Handler:   HandlerFunc(ConditionReady),
+				DependsOn: []string{"ApplyCacheServiceBinding", "ApplyDBSyncer"},
+			},
+			pipeline.Stage{
+				Name:      "ReplicationStatus",
+				Handler:   HandlerFunc(ReplicationStatus),
+				DependsOn: []string{"ApplyDBSyncer"},
+			},
+			pipeline.Stage{
+				Name:      "ConditionLeaderElected",
+				Handler:   HandlerFunc(ConditionLeaderElected),
+				DependsOn: []string{"ApplyDBSyncer"},
+			},
+		)
 }
 
 func DeletePipelineBuilder() *pipeline.Builder {
 	builder := &pipeline.Builder{}
-	return builder.
-		WithHandlers(
-			HandlerFunc(RemoveDBSyncer),
-			rule.HandlerFunc(rule.RemoveRuleFromConfigMap),
-			rule.HandlerFunc(rule.RemoveFinalizer),
-		)
+	return builder.WithStages(
+		pipeline.Stage{Name: "RemoveDBSyncer", Handler: HandlerFunc(RemoveDBSyncer)},
+		pipeline.Stage{Name: "RemovePreflightJob", Handler: HandlerFunc(RemovePreflightJob)},
+		pipeline.Stage{Name: "RemoveRuleFromConfigMap", Handler: rule.HandlerFunc(rule.RemoveRuleFromConfigMap)},
+		pipeline.Stage{
+			Name:      "RemoveFinalizer",
+			Handler:   rule.HandlerFunc(rule.RemoveFinalizer),
+			DependsOn: []string{"RemoveDBSyncer", "RemovePreflightJob", "RemoveRuleFromConfigMap"},
+		},
+	)
 }
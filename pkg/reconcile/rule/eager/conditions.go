@@ -8,13 +8,16 @@ import (
 	binding "github.com/gingersnap-project/operator/pkg/apis/binding/v1beta1"
 	"github.com/gingersnap-project/operator/pkg/reconcile/rule"
 	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-const conditionWait = time.Second * 2
+// leaseWait the interval at which the leader Lease is checked whilst no db-syncer replica is the leader
+const leaseWait = time.Second * 2
 
 func ConditionReady(r *v1alpha1.EagerCacheRule, ctx *rule.Context) {
 	ruleCondition := v1alpha1.EagerCacheRuleCondition{
@@ -43,7 +46,7 @@ func ConditionReady(r *v1alpha1.EagerCacheRule, ctx *rule.Context) {
 			if errors.IsNotFound(err) {
 				notFound("ServiceBinding", sbName)
 			} else {
-				ctx.RequeueAfter(conditionWait, fmt.Errorf("unable to load ServiceBinding '%s': %w", sbName, err))
+				ctx.RequeueWithBackoff(fmt.Errorf("unable to load ServiceBinding '%s': %w", sbName, err))
 				return
 			}
 		}
@@ -102,6 +105,80 @@ func ConditionReady(r *v1alpha1.EagerCacheRule, ctx *rule.Context) {
 	}
 
 	if ruleCondition.Status == metav1.ConditionFalse {
-		ctx.RequeueAfter(conditionWait, nil)
+		ctx.RequeueWithBackoff(nil)
 	}
 }
+
+// ConditionLeaderElected reports whether a db-syncer replica currently holds the leader Lease. The condition is False
+// whilst the Lease is missing or has expired, i.e. before the first election and during failover to a standby replica.
+func ConditionLeaderElected(r *v1alpha1.EagerCacheRule, ctx *rule.Context) {
+	ruleCondition := v1alpha1.EagerCacheRuleCondition{
+		Type:   v1alpha1.EagerCacheRuleConditionLeaderElected,
+		Status: metav1.ConditionFalse,
+	}
+
+	l, err := loadLeader(ctx)
+	if err != nil {
+		ctx.Requeue(err)
+		return
+	}
+
+	// Lease expiry is not observable via watch events, so the Lease is re-checked once it is due to expire
+	requeue := leaseWait
+	if l == nil || l.Identity == "" {
+		ruleCondition.Message = "Waiting for a db-syncer replica to be elected leader"
+	} else if remaining := time.Until(l.Expiry); remaining <= 0 {
+		ruleCondition.Message = fmt.Sprintf("db-syncer leader '%s' Lease expired, failover in progress", l.Identity)
+	} else {
+		ruleCondition.Status = metav1.ConditionTrue
+		ruleCondition.Message = fmt.Sprintf("db-syncer replica '%s' is the leader", l.Identity)
+		requeue = remaining
+	}
+
+	if r.SetCondition(ruleCondition) {
+		if err := ctx.Client().UpdateStatus(r); err != nil {
+			ctx.Requeue(fmt.Errorf("unable to update LeaderElected condition: %w", err))
+			return
+		}
+	}
+	// A failed CDC preflight check is repeated even if no other event triggers a reconciliation
+	if ctx.PreflightRetry > 0 && ctx.PreflightRetry < requeue {
+		requeue = ctx.PreflightRetry
+	}
+	ctx.RequeueAfter(requeue, nil)
+}
+
+// leader describes the db-syncer replica holding the leader Lease
+type leader struct {
+	// Identity of the Lease holder, the db-syncer pod name. Empty if the Lease is not held
+	Identity string
+	// Expiry of the Lease unless it is renewed
+	Expiry time.Time
+}
+
+// Valid returns true if the Lease is held and has not expired
+func (l *leader) Valid() bool {
+	return l != nil && l.Identity != "" && time.Now().Before(l.Expiry)
+}
+
+// loadLeader returns the current db-syncer leader or nil if the Lease has not been created
+func loadLeader(ctx *rule.Context) (*leader, error) {
+	cache := ctx.Cache.CacheService()
+	leaseName := cache.DBSyncerLease()
+	lease := &coordinationv1.Lease{}
+	if err := ctx.Client().Get(types.NamespacedName{Namespace: cache.Namespace, Name: leaseName}, lease); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to load db-syncer Lease '%s': %w", leaseName, err)
+	}
+
+	l := &leader{}
+	if lease.Spec.HolderIdentity != nil {
+		l.Identity = *lease.Spec.HolderIdentity
+	}
+	if lease.Spec.RenewTime != nil && lease.Spec.LeaseDurationSeconds != nil {
+		l.Expiry = lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second)
+	}
+	return l, nil
+}
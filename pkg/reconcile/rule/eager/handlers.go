@@ -2,16 +2,19 @@ package eager
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/gingersnap-project/operator/api/v1alpha1"
 	bindingv1 "github.com/gingersnap-project/operator/pkg/applyconfigurations/servicebinding/v1beta1"
 	"github.com/gingersnap-project/operator/pkg/images"
 	"github.com/gingersnap-project/operator/pkg/kubernetes/client"
+	"github.com/gingersnap-project/operator/pkg/reconcile"
 	"github.com/gingersnap-project/operator/pkg/reconcile/meta"
 	"github.com/gingersnap-project/operator/pkg/reconcile/rule"
 	apiappsv1 "k8s.io/api/apps/v1"
 	apicorev1 "k8s.io/api/core/v1"
 	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	appsv1 "k8s.io/client-go/applyconfigurations/apps/v1"
 	corev1 "k8s.io/client-go/applyconfigurations/core/v1"
 	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
@@ -21,9 +24,7 @@ import (
 func LoadCache(r *v1alpha1.EagerCacheRule, ctx *rule.Context) {
 	cacheRef := r.CacheService()
 	cache := &v1alpha1.Cache{}
-	err := ctx.Client().
-		WithNamespace(cacheRef.Namespace).
-		Load(cacheRef.Name, cache)
+	err := ctx.Client().Get(types.NamespacedName{Namespace: cacheRef.Namespace, Name: cacheRef.Name}, cache)
 
 	if err != nil {
 		msg := fmt.Sprintf("unable to load Cache CR '%s'", cacheRef)
@@ -39,8 +40,37 @@ func LoadCache(r *v1alpha1.EagerCacheRule, ctx *rule.Context) {
 			return
 		}
 		ctx.Requeue(fmt.Errorf("%s: %w", msg, err))
+		return
 	}
 	ctx.Cache = cache
+
+	allowed, err := rule.CacheAccessAllowed(r, ctx)
+	if err != nil {
+		ctx.Requeue(err)
+		return
+	}
+
+	if !allowed {
+		// Ensure that the rule is no longer served by the Cache if access has been revoked
+		rule.RemoveRuleFromConfigMap(r, ctx)
+		if ctx.Status().Stop {
+			return
+		}
+
+		r.SetCondition(
+			v1alpha1.EagerCacheRuleCondition{
+				Type:    v1alpha1.EagerCacheRuleConditionReady,
+				Status:  apimetav1.ConditionFalse,
+				Message: cache.RuleNamespaceForbiddenMsg(r.Namespace),
+			},
+		)
+		if err := ctx.Client().UpdateStatus(r); err != nil {
+			ctx.Requeue(fmt.Errorf("unable to update Ready condition on Cache access denied: %w", err))
+			return
+		}
+		// Reconciliation is triggered again when the Cache or the rule's Namespace is updated
+		ctx.StopProcessing(nil)
+	}
 }
 
 func ApplyDBServiceBinding(_ *v1alpha1.EagerCacheRule, ctx *rule.Context) {
@@ -58,7 +88,7 @@ func ApplyDBServiceBinding(_ *v1alpha1.EagerCacheRule, ctx *rule.Context) {
 		serviceRef = bindingv1.ServiceBindingServiceReference().
 			WithAPIVersion(apicorev1.SchemeGroupVersion.String()).
 			WithKind("Secret").
-			WithName(ds.SecretRef.Name)
+			WithName(cache.DataSourceSecret())
 	}
 
 	sb := bindingv1.ServiceBinding(cache.CacheService().DBSyncerDataServiceBinding(), cache.Namespace).
@@ -80,7 +110,7 @@ func ApplyDBServiceBinding(_ *v1alpha1.EagerCacheRule, ctx *rule.Context) {
 				),
 		)
 
-	if err := ctx.Client().Apply(sb); err != nil {
+	if _, err := ctx.Client().Apply(sb, client.DetermineResult); err != nil {
 		ctx.Requeue(fmt.Errorf("unable to apply DB ServiceBinding: %w", err))
 	}
 }
@@ -113,15 +143,47 @@ func ApplyCacheServiceBinding(_ *v1alpha1.EagerCacheRule, ctx *rule.Context) {
 				),
 		)
 
-	if err := ctx.Client().Apply(sb); err != nil {
+	if _, err := ctx.Client().Apply(sb, client.DetermineResult); err != nil {
 		ctx.Requeue(fmt.Errorf("unable to apply Cache ServiceBinding: %w", err))
 	}
 }
 
+// DataSourceCredentials resolves the credentials bound to the Cache's data source so that the db-syncer is rolled out
+// whenever they change, and reports the active credentials version on the EagerCacheRule status
+func DataSourceCredentials(r *v1alpha1.EagerCacheRule, ctx *rule.Context) {
+	cache := ctx.Cache
+	creds, err := reconcile.LoadDataSourceCredentials(cache, ctx.Client().WithNamespace(cache.Namespace))
+	if err != nil {
+		ctx.Requeue(err)
+		return
+	}
+	if creds == nil {
+		return
+	}
+	ctx.Credentials = creds
+
+	if r.Status.Credentials == nil || *r.Status.Credentials != *creds.Status {
+		r.Status.Credentials = creds.Status
+		if err := ctx.Client().UpdateStatus(r); err != nil {
+			ctx.Requeue(fmt.Errorf("unable to update EagerCacheRule credentials status: %w", err))
+		}
+	}
+}
+
 func ApplyDBSyncer(r *v1alpha1.EagerCacheRule, ctx *rule.Context) {
 	cache := ctx.Cache
 	labels := meta.GingersnapLabels("db-syncer", meta.ComponentDBSyncer, cache.Name)
 
+	var annotations map[string]string
+	if ctx.Credentials != nil {
+		// Roll out the db-syncer when the data source credentials change
+		annotations = map[string]string{
+			meta.AnnotationCredentialsHash: ctx.Credentials.Hash,
+		}
+	}
+
+	// An autoscaled db-syncer partitions the replicated tables across all replicas
+	autoscaled := cache.DBSyncerAutoscaling() != nil
 	cacheService := cache.CacheService()
 	name := cacheService.DBSyncerName()
 	deployment := appsv1.Deployment(name, cache.Namespace).
@@ -134,23 +196,49 @@ func ApplyDBSyncer(r *v1alpha1.EagerCacheRule, ctx *rule.Context) {
 			WithTemplate(corev1.PodTemplateSpec().
 				WithName("db-syncer").
 				WithLabels(labels).
+				WithAnnotations(annotations).
 				WithSpec(corev1.PodSpec().
 					WithServiceAccountName(cache.Name).
 					WithContainers(
 						corev1.Container().
 							WithName("db-syncer").
-							WithImage(images.DBSyncer).
+							WithImage(images.DBSyncer()).
 							WithEnv(
-								corev1.EnvVar().WithName("GINGERSNAP_DYNAMIC_MEMBERSHIP").WithValue("true"),
-								corev1.EnvVar().WithName("GINGERSNAP_K8S_NAMESPACE").WithValue(cacheService.Namespace),
-								corev1.EnvVar().WithName("GINGERSNAP_K8S_RULE_CONFIG_MAP").WithValue(cacheService.EagerCacheConfigMap()),
-								corev1.EnvVar().WithName("QUARKUS_LOG_CATEGORY__IO_QUARKUS_KUBERNETES_SERVICE_BINDING__LEVEL").WithValue("DEBUG"),
+								dbSyncerEnv(
+									cache,
+									corev1.EnvVar().WithName("GINGERSNAP_DYNAMIC_MEMBERSHIP").WithValue("true"),
+									corev1.EnvVar().WithName("GINGERSNAP_K8S_NAMESPACE").WithValue(cacheService.Namespace),
+									corev1.EnvVar().WithName("GINGERSNAP_K8S_RULE_CONFIG_MAP").WithValue(cacheService.EagerCacheConfigMap()),
+									corev1.EnvVar().WithName("GINGERSNAP_K8S_POD_NAME").WithValueFrom(
+										corev1.EnvVarSource().WithFieldRef(
+											corev1.ObjectFieldSelector().WithFieldPath("metadata.name"),
+										),
+									),
+									corev1.EnvVar().WithName("GINGERSNAP_LEADER_ELECTION_ENABLED").WithValue("true"),
+									corev1.EnvVar().WithName("GINGERSNAP_LEADER_ELECTION_LEASE_NAME").WithValue(cacheService.DBSyncerLease()),
+									corev1.EnvVar().WithName("GINGERSNAP_PARTITIONING_ENABLED").WithValue(strconv.FormatBool(autoscaled)),
+								)...,
+							).
+							WithEnvFrom(
+								dbSyncerEnvFrom(cache)...,
 							).
 							WithResources(
 								corev1.ResourceRequirements().
 									WithLimits(cache.DBSyncerLimits()).
 									WithRequests(cache.DBSyncerRequests()),
 							).
+							WithPorts(
+								corev1.ContainerPort().WithContainerPort(8080),
+							).
+							WithLivenessProbe(
+								reconcile.HTTPProbe("live", 5, 0, 10, 1, 80, 8080),
+							).
+							WithReadinessProbe(
+								reconcile.HTTPProbe("ready", 5, 0, 10, 1, 80, 8080),
+							).
+							WithStartupProbe(
+								reconcile.HTTPProbe("started", 600, 1, 1, 1, 80, 8080),
+							).
 							WithVolumeMounts(
 								corev1.VolumeMount().WithName("eager-rules").WithMountPath("/rules/eager").WithReadOnly(true),
 							),
@@ -166,24 +254,48 @@ func ApplyDBSyncer(r *v1alpha1.EagerCacheRule, ctx *rule.Context) {
 			),
 		)
 
-	if err := ctx.Client().Apply(deployment); err != nil {
+	if !autoscaled {
+		// The replicas of an autoscaled db-syncer are owned by the autoscaler
+		deployment.Spec.WithReplicas(cache.DBSyncerReplicas())
+	}
+
+	if _, err := ctx.Client().Apply(deployment, client.DetermineResult); err != nil {
 		ctx.Requeue(fmt.Errorf("unable to apply DB-Syncer Deployment: %w", err))
 	}
 }
 
+// dbSyncerEnv returns the environment of a db-syncer container, combining the operator's variables with the
+// Cache's db-syncer logging configuration and extraEnv
+func dbSyncerEnv(cache *v1alpha1.Cache, operatorEnv ...*corev1.EnvVarApplyConfiguration) []*corev1.EnvVarApplyConfiguration {
+	if spec := cache.Spec.DbSyncer; spec != nil {
+		return reconcile.ContainerEnv(operatorEnv, spec.Logging, spec.ExtraEnv)
+	}
+	return reconcile.ContainerEnv(operatorEnv, nil, nil)
+}
+
+func dbSyncerEnvFrom(cache *v1alpha1.Cache) []*corev1.EnvFromSourceApplyConfiguration {
+	if spec := cache.Spec.DbSyncer; spec != nil {
+		return reconcile.ContainerEnvFrom(spec.EnvFrom)
+	}
+	return nil
+}
+
 func RemoveDBSyncer(r *v1alpha1.EagerCacheRule, ctx *rule.Context) {
 	cacheService := r.CacheService()
-	labels := cacheService.LabelSelector()
 	eagerCaches := &v1alpha1.EagerCacheRuleList{}
-	if err := ctx.Client().List(labels, eagerCaches, client.ClusterScoped); err != nil {
+	if err := ctx.Client().List(nil, eagerCaches, client.AllNamespaces, client.MatchingFields(cacheService.MatchingRules())); err != nil {
 		ctx.Requeue(fmt.Errorf("unable to list all EagerCacheRules to determine db-syncer lifecycle: %w", err))
 		return
 	}
 
 	if len(eagerCaches.Items) == 1 && eagerCaches.Items[0].UID == r.UID {
 		// Remove the db-syncer deployment as no other dependent EagerCacheRules exist
-		if err := ctx.Client().Delete(cacheService.DBSyncerName(), &apiappsv1.Deployment{}); runtimeClient.IgnoreNotFound(err) != nil {
+		if err := ctx.Client().WithNamespace(cacheService.Namespace).Delete(cacheService.DBSyncerName(), &apiappsv1.Deployment{}); runtimeClient.IgnoreNotFound(err) != nil {
 			ctx.Requeue(fmt.Errorf("unable to remove db-syncer: %w", err))
+			return
+		}
+		if err := removeDBSyncerAutoscaler(cacheService, ctx.IsTypeSupported(reconcile.ScaledObjectGVK), ctx); err != nil {
+			ctx.Requeue(err)
 		}
 	}
 }
@@ -0,0 +1,210 @@
+package eager
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	"github.com/gingersnap-project/operator/pkg/dbsyncer"
+	"github.com/gingersnap-project/operator/pkg/images"
+	"github.com/gingersnap-project/operator/pkg/kubernetes/client"
+	"github.com/gingersnap-project/operator/pkg/reconcile/meta"
+	"github.com/gingersnap-project/operator/pkg/reconcile/rule"
+	apibatchv1 "k8s.io/api/batch/v1"
+	apicorev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	batchv1 "k8s.io/client-go/applyconfigurations/batch/v1"
+	corev1 "k8s.io/client-go/applyconfigurations/core/v1"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// preflightRetry is the delay before a failed CDC preflight check is repeated, allowing the database to be reconfigured
+const preflightRetry = 5 * time.Minute
+
+// CDCPreflight verifies that the Cache's database meets the change data capture prerequisites of db-syncer and reports
+// the outcome in the CDCReady condition. The checks are executed by a db-syncer Job in preflight mode, bound to the
+// data source credentials, which is repeated whenever the credentials or the rule's table change and preflightRetry
+// after it failed. The outcome is informational only and does not prevent the db-syncer from being deployed, so the
+// retry is scheduled via ctx.PreflightRetry rather than stopping the pipeline. The Job is created in the Cache
+// namespace, so it is owned by the Cache rather than the rule, associated with the rule by label and removed
+// explicitly once the rule is deleted.
+func CDCPreflight(r *v1alpha1.EagerCacheRule, ctx *rule.Context) {
+	condition := v1alpha1.EagerCacheRuleCondition{
+		Type:   v1alpha1.EagerCacheRuleConditionCDCReady,
+		Status: metav1.ConditionUnknown,
+	}
+
+	if ctx.Credentials == nil {
+		condition.Message = "Waiting for data source credentials"
+		setCDCReadyCondition(r, condition, ctx)
+		return
+	}
+
+	cache := ctx.Cache
+	c := ctx.Client().WithNamespace(cache.Namespace)
+	name := r.PreflightJob()
+	hash := preflightHash(r, ctx)
+
+	job := &apibatchv1.Job{}
+	if err := c.Load(name, job); runtimeClient.IgnoreNotFound(err) != nil {
+		ctx.Requeue(fmt.Errorf("unable to load CDC preflight Job '%s': %w", name, err))
+		return
+	} else if err == nil {
+		failedAt := jobConditionTime(job, apibatchv1.JobFailed)
+		if job.Annotations[meta.AnnotationPreflightHash] != hash || (failedAt != nil && time.Since(failedAt.Time) > preflightRetry) {
+			if err := c.Delete(name, job, client.BackgroundDeletion); runtimeClient.IgnoreNotFound(err) != nil {
+				ctx.Requeue(fmt.Errorf("unable to remove outdated CDC preflight Job '%s': %w", name, err))
+				return
+			}
+			job = nil
+		}
+	} else {
+		job = nil
+	}
+
+	if job == nil {
+		var err error
+		if job, _, err = client.Apply[apibatchv1.Job](c, preflightJob(r, ctx, hash), client.DetermineResult); err != nil {
+			ctx.Requeue(fmt.Errorf("unable to apply CDC preflight Job '%s': %w", name, err))
+			return
+		}
+	}
+
+	if jobConditionTime(job, apibatchv1.JobComplete) != nil {
+		condition.Status = metav1.ConditionTrue
+		condition.Message = fmt.Sprintf("%s CDC prerequisites satisfied", cache.Spec.DataSource.DbType)
+	} else if jobConditionTime(job, apibatchv1.JobFailed) != nil {
+		result, err := preflightResult(name, c)
+		if err != nil {
+			ctx.Requeue(err)
+			return
+		}
+		condition.Status = metav1.ConditionFalse
+		condition.Message = fmt.Sprintf("CDC preflight check failed: %s. Remediation: %s", result, cache.Spec.DataSource.DbType.CDCRemediation())
+		ctx.PreflightRetry = preflightRetry - time.Since(jobConditionTime(job, apibatchv1.JobFailed).Time)
+		if ctx.PreflightRetry < time.Second {
+			ctx.PreflightRetry = time.Second
+		}
+	} else {
+		condition.Message = "CDC preflight check in progress"
+	}
+	setCDCReadyCondition(r, condition, ctx)
+}
+
+// RemovePreflightJob removes the rule's CDC preflight Job from the Cache namespace
+func RemovePreflightJob(r *v1alpha1.EagerCacheRule, ctx *rule.Context) {
+	c := ctx.Client().WithNamespace(r.CacheService().Namespace)
+	if err := c.Delete(r.PreflightJob(), &apibatchv1.Job{}, client.BackgroundDeletion); runtimeClient.IgnoreNotFound(err) != nil {
+		ctx.Requeue(fmt.Errorf("unable to remove CDC preflight Job: %w", err))
+	}
+}
+
+func setCDCReadyCondition(r *v1alpha1.EagerCacheRule, condition v1alpha1.EagerCacheRuleCondition, ctx *rule.Context) {
+	if r.SetCondition(condition) {
+		if err := ctx.Client().UpdateStatus(r); err != nil {
+			ctx.Requeue(fmt.Errorf("unable to update CDCReady condition: %w", err))
+		}
+	}
+}
+
+// preflightHash returns a hash of the inputs to the CDC preflight check
+func preflightHash(r *v1alpha1.EagerCacheRule, ctx *rule.Context) string {
+	hash := sha256.New()
+	for _, s := range []string{ctx.Cache.Spec.DataSource.DbType.String(), ctx.Credentials.Hash, r.Spec.TableName} {
+		hash.Write([]byte(s))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func preflightJob(r *v1alpha1.EagerCacheRule, ctx *rule.Context, hash string) *batchv1.JobApplyConfiguration {
+	cache := ctx.Cache
+	labels := meta.GingersnapLabels("db-syncer-preflight", meta.ComponentDBSyncer, cache.Name)
+	jobLabels := map[string]string{
+		meta.LabelRule:          r.Name,
+		meta.LabelRuleNamespace: r.Namespace,
+	}
+	for k, v := range labels {
+		jobLabels[k] = v
+	}
+	return batchv1.Job(r.PreflightJob(), cache.Namespace).
+		WithLabels(jobLabels).
+		WithAnnotations(map[string]string{
+			meta.AnnotationPreflightHash: hash,
+		}).
+		WithOwnerReferences(client.OwnerReference(cache)).
+		WithSpec(batchv1.JobSpec().
+			WithBackoffLimit(0).
+			WithTemplate(corev1.PodTemplateSpec().
+				WithLabels(labels).
+				WithSpec(corev1.PodSpec().
+					WithRestartPolicy(apicorev1.RestartPolicyNever).
+					WithServiceAccountName(cache.Name).
+					WithContainers(
+						corev1.Container().
+							WithName("preflight").
+							WithImage(images.DBSyncer()).
+							WithEnv(
+								dbSyncerEnv(
+									cache,
+									corev1.EnvVar().WithName(dbsyncer.PreflightEnvName).WithValue("true"),
+									corev1.EnvVar().WithName(dbsyncer.PreflightTableEnvName).WithValue(r.Spec.TableName),
+									corev1.EnvVar().WithName("SERVICE_BINDING_ROOT").WithValue("/bindings"),
+								)...,
+							).
+							WithEnvFrom(
+								dbSyncerEnvFrom(cache)...,
+							).
+							WithTerminationMessagePolicy(apicorev1.TerminationMessageFallbackToLogsOnError).
+							WithVolumeMounts(
+								corev1.VolumeMount().WithName("datasource").WithMountPath("/bindings/datasource").WithReadOnly(true),
+							),
+					).
+					WithVolumes(
+						corev1.Volume().
+							WithName("datasource").
+							WithSecret(
+								corev1.SecretVolumeSource().WithSecretName(ctx.Credentials.Status.SecretName),
+							),
+					),
+				),
+			),
+		)
+}
+
+// preflightResult returns the outcome reported by the terminated pod of a failed CDC preflight Job
+func preflightResult(job string, c client.Client) (*dbsyncer.PreflightResult, error) {
+	pods := &apicorev1.PodList{}
+	if err := c.List(map[string]string{"job-name": job}, pods); err != nil {
+		return nil, fmt.Errorf("unable to list pods of CDC preflight Job '%s': %w", job, err)
+	}
+
+	for _, pod := range pods.Items {
+		for _, status := range pod.Status.ContainerStatuses {
+			if terminated := status.State.Terminated; terminated != nil && terminated.Message != "" {
+				if result, err := dbsyncer.ParsePreflightResult(terminated.Message); err == nil && len(result.Failed()) > 0 {
+					return result, nil
+				}
+				// The container failed before writing a result, e.g. the database is unreachable
+				return &dbsyncer.PreflightResult{
+					Checks: []dbsyncer.PreflightCheck{{Name: "connection", Message: terminated.Message}},
+				}, nil
+			}
+		}
+	}
+	return &dbsyncer.PreflightResult{
+		Checks: []dbsyncer.PreflightCheck{{Name: "preflight", Message: "no result reported"}},
+	}, nil
+}
+
+// jobConditionTime returns the transition time of the Job condition if it is True, otherwise nil
+func jobConditionTime(job *apibatchv1.Job, conditionType apibatchv1.JobConditionType) *metav1.Time {
+	for _, c := range job.Status.Conditions {
+		if c.Type == conditionType && c.Status == apicorev1.ConditionTrue {
+			return &c.LastTransitionTime
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package eager
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gingersnap-project/operator/api/v1alpha1"
+	"github.com/gingersnap-project/operator/pkg/dbsyncer"
+	"github.com/gingersnap-project/operator/pkg/reconcile/rule"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var dbSyncerClient = &dbsyncer.Client{}
+
+// ReplicationStatus polls the db-syncer leader for the replication progress of the rule, reporting it on the rule's
+// status and setting the Lagging condition if the replication lag exceeds the rule's threshold. Polling is driven by
+// the periodic requeue of ConditionLeaderElected.
+func ReplicationStatus(r *v1alpha1.EagerCacheRule, ctx *rule.Context) {
+	status := r.Status.DeepCopy()
+	condition := v1alpha1.EagerCacheRuleCondition{
+		Type:   v1alpha1.EagerCacheRuleConditionLagging,
+		Status: metav1.ConditionUnknown,
+	}
+
+	ruleStatus, msg, err := pollRuleStatus(r, ctx)
+	if err != nil {
+		ctx.Requeue(err)
+		return
+	}
+
+	if ruleStatus == nil {
+		condition.Message = msg
+	} else {
+		lag := ruleStatus.Lag().Round(time.Second)
+		status.Replication = &v1alpha1.ReplicationStatus{
+			Lag: &metav1.Duration{Duration: lag},
+		}
+		if ruleStatus.LastProcessedChange != nil {
+			status.Replication.LastProcessedChange = &metav1.Time{Time: ruleStatus.LastProcessedChange.Truncate(time.Second)}
+		}
+		if s := ruleStatus.Snapshot; s != nil {
+			status.Replication.Snapshot = &v1alpha1.SnapshotStatus{
+				State:            v1alpha1.SnapshotState(s.State),
+				ProcessedRows:    s.ProcessedRows,
+				TotalRows:        s.TotalRows,
+				ResyncGeneration: s.ResyncGeneration,
+			}
+		}
+
+		threshold := r.LagThreshold()
+		if lag > threshold {
+			condition.Status = metav1.ConditionTrue
+			condition.Message = fmt.Sprintf("Replication lag exceeds threshold '%s'", threshold)
+		} else {
+			condition.Status = metav1.ConditionFalse
+			condition.Message = fmt.Sprintf("Replication lag within threshold '%s'", threshold)
+		}
+	}
+
+	conditionUpdated := r.SetCondition(condition)
+	if conditionUpdated || !equality.Semantic.DeepEqual(status.Replication, r.Status.Replication) {
+		r.Status.Replication = status.Replication
+		if err := ctx.Client().UpdateStatus(r); err != nil {
+			ctx.Requeue(fmt.Errorf("unable to update EagerCacheRule replication status: %w", err))
+		}
+	}
+}
+
+// pollRuleStatus retrieves the rule's replication status from the db-syncer leader. If the status cannot be retrieved,
+// a nil RuleStatus is returned with a message describing why.
+func pollRuleStatus(r *v1alpha1.EagerCacheRule, ctx *rule.Context) (*dbsyncer.RuleStatus, string, error) {
+	l, err := loadLeader(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	if !l.Valid() {
+		return nil, "Replication status unavailable, no db-syncer leader elected", nil
+	}
+
+	pod := &corev1.Pod{}
+	if err := ctx.Client().Get(types.NamespacedName{Namespace: ctx.Cache.Namespace, Name: l.Identity}, pod); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, fmt.Sprintf("Replication status unavailable, db-syncer leader pod '%s' not found", l.Identity), nil
+		}
+		return nil, "", fmt.Errorf("unable to load db-syncer leader pod '%s': %w", l.Identity, err)
+	}
+	if pod.Status.PodIP == "" {
+		return nil, fmt.Sprintf("Replication status unavailable, db-syncer leader pod '%s' has no IP", l.Identity), nil
+	}
+
+	statuses, err := dbSyncerClient.RuleStatuses(ctx.Ctx(), pod.Status.PodIP)
+	if err != nil {
+		ctx.Log().Error(err, "unable to poll db-syncer replication status", "pod", l.Identity)
+		return nil, fmt.Sprintf("Replication status unavailable: %s", err), nil
+	}
+
+	ruleStatus, ok := statuses[r.CacheService().RuleKey(r.Namespace, r.Name)]
+	if !ok {
+		return nil, "db-syncer has not reported replication status for the rule", nil
+	}
+	return &ruleStatus, "", nil
+}
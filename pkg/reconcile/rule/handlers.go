@@ -8,11 +8,43 @@ import (
 	"github.com/gingersnap-project/operator/pkg/reconcile"
 	apicorev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 	corev1 "k8s.io/client-go/applyconfigurations/core/v1"
 	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
+// RepairLabels restores the Cache labels of a rule. The labels are applied by the mutating webhook, so they are missing
+// if the rule was created whilst the webhook was unavailable, and are incorrect if they have been edited by hand
+func RepairLabels(r CacheRule, ctx *Context) {
+	expected := r.CacheService().LabelSelector()
+	labels := r.GetLabels()
+	repaired := false
+	for k, v := range expected {
+		if labels[k] != v {
+			repaired = true
+			break
+		}
+	}
+	if !repaired {
+		return
+	}
+
+	patch := runtimeClient.MergeFrom(r.DeepCopyObject().(runtimeClient.Object))
+	if labels == nil {
+		labels = make(map[string]string, len(expected))
+	}
+	for k, v := range expected {
+		labels[k] = v
+	}
+	r.SetLabels(labels)
+	if err := ctx.Client().Patch(r, patch); err != nil {
+		ctx.Requeue(fmt.Errorf("unable to repair Cache labels: %w", err))
+		return
+	}
+	ctx.Log().Info("Repaired Cache labels", "labels", expected)
+}
+
 func AddFinalizer(r CacheRule, ctx *Context) {
 	if controllerutil.AddFinalizer(r, r.Finalizer()) {
 		if err := ctx.Client().Update(r); err != nil {
@@ -49,7 +81,7 @@ func ApplyRuleConfigMap(rule CacheRule, ctx *Context) {
 		ctx.Requeue(fmt.Errorf("unable to marshall rule: %w", err))
 		return
 	}
-	data[rule.GetName()] = string(bytes[:])
+	data[cache.RuleKey(rule.GetNamespace(), rule.GetName())] = string(bytes[:])
 
 	labels := configMapLabels(cache)
 	cm := corev1.
@@ -58,7 +90,7 @@ func ApplyRuleConfigMap(rule CacheRule, ctx *Context) {
 		WithOwnerReferences(client.OwnerReference(ctx.Cache)).
 		WithData(data)
 
-	if err := ctx.Client().Apply(cm); err != nil {
+	if _, err := ctx.Client().Apply(cm, client.DetermineResult); err != nil {
 		ctx.Requeue(fmt.Errorf("unable to apply '%s' ConfigMap: %w", rule.ConfigMap(), err))
 	}
 }
@@ -72,13 +104,22 @@ func RemoveRuleFromConfigMap(rule CacheRule, ctx *Context) {
 	}
 
 	if existingConfigMap != nil {
-		delete(existingConfigMap.Data, rule.GetName())
+		delete(existingConfigMap.Data, cache.RuleKey(rule.GetNamespace(), rule.GetName()))
 		if err := ctx.Client().Update(existingConfigMap); runtimeClient.IgnoreNotFound(err) != nil {
 			ctx.Requeue(fmt.Errorf("unable to remove '%s' from ConfigMap: %w", rule.GetName(), err))
 		}
 	}
 }
 
+// CacheAccessAllowed returns true if the rule's namespace has been granted access to ctx.Cache
+func CacheAccessAllowed(rule CacheRule, ctx *Context) (bool, error) {
+	namespace := &apicorev1.Namespace{}
+	if err := ctx.Client().Load(rule.GetNamespace(), namespace, client.ClusterScoped); err != nil {
+		return false, fmt.Errorf("unable to load Namespace '%s': %w", rule.GetNamespace(), err)
+	}
+	return ctx.Cache.AllowsRuleNamespace(namespace), nil
+}
+
 func configMapLabels(cacheService v1alpha1.CacheService) map[string]string {
 	labels := map[string]string{
 		"app.kubernetes.io/name":       "gingersnap",
@@ -92,9 +133,7 @@ func configMapLabels(cacheService v1alpha1.CacheService) map[string]string {
 func loadConfigMap(name, namespace string, ctx reconcile.Context) (*apicorev1.ConfigMap, error) {
 	existingConfigMap := &apicorev1.ConfigMap{}
 
-	err := ctx.Client().
-		WithNamespace(namespace).
-		Load(name, existingConfigMap)
+	err := ctx.Client().Get(types.NamespacedName{Namespace: namespace, Name: name}, existingConfigMap)
 
 	if runtimeClient.IgnoreNotFound(err) != nil {
 		return nil, fmt.Errorf("unable to load ConfigMap '%s': %w", name, err)
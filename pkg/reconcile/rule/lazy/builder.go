@@ -15,20 +15,31 @@ func (f HandlerFunc) Handle(i interface{}, ctx reconcile.Context) {
 
 func PipelineBuilder() *pipeline.Builder {
 	builder := &pipeline.Builder{}
-	builder.WithHandlers(
-		HandlerFunc(LoadCache),
-		rule.HandlerFunc(rule.AddFinalizer),
-		rule.HandlerFunc(rule.ApplyRuleConfigMap),
-		HandlerFunc(ConditionReady),
+	return builder.WithStages(
+		pipeline.Stage{Name: "RepairLabels", Handler: rule.HandlerFunc(rule.RepairLabels)},
+		pipeline.Stage{Name: "LoadCache", Handler: HandlerFunc(LoadCache)},
+		pipeline.Stage{Name: "AddFinalizer", Handler: rule.HandlerFunc(rule.AddFinalizer)},
+		pipeline.Stage{
+			Name:      "ApplyRuleConfigMap",
+			Handler:   rule.HandlerFunc(rule.ApplyRuleConfigMap),
+			DependsOn: []string{"LoadCache"},
+		},
+		pipeline.Stage{
+			Name:      "ConditionReady",
+			Handler:   HandlerFunc(ConditionReady),
+			DependsOn: []string{"ApplyRuleConfigMap"},
+		},
 	)
-	return builder
 }
 
 func DeletePipelineBuilder() *pipeline.Builder {
 	builder := &pipeline.Builder{}
-	return builder.
-		WithHandlers(
-			rule.HandlerFunc(rule.RemoveRuleFromConfigMap),
-			rule.HandlerFunc(rule.RemoveFinalizer),
-		)
+	return builder.WithStages(
+		pipeline.Stage{Name: "RemoveRuleFromConfigMap", Handler: rule.HandlerFunc(rule.RemoveRuleFromConfigMap)},
+		pipeline.Stage{
+			Name:      "RemoveFinalizer",
+			Handler:   rule.HandlerFunc(rule.RemoveFinalizer),
+			DependsOn: []string{"RemoveRuleFromConfigMap"},
+		},
+	)
 }
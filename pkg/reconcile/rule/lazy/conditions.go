@@ -2,15 +2,12 @@ package lazy
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/gingersnap-project/operator/api/v1alpha1"
 	"github.com/gingersnap-project/operator/pkg/reconcile/rule"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-const conditionWait = time.Second * 2
-
 func ConditionReady(r *v1alpha1.LazyCacheRule, ctx *rule.Context) {
 	ruleCondition := v1alpha1.LazyCacheRuleCondition{
 		Type:   v1alpha1.LazyCacheRuleConditionReady,
@@ -32,6 +29,6 @@ func ConditionReady(r *v1alpha1.LazyCacheRule, ctx *rule.Context) {
 	}
 
 	if ruleCondition.Status == metav1.ConditionFalse {
-		ctx.RequeueAfter(conditionWait, nil)
+		ctx.RequeueWithBackoff(nil)
 	}
 }
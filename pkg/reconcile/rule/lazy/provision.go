@@ -6,14 +6,13 @@ import (
 	"github.com/gingersnap-project/operator/api/v1alpha1"
 	"github.com/gingersnap-project/operator/pkg/reconcile/rule"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func LoadCache(r *v1alpha1.LazyCacheRule, ctx *rule.Context) {
 	cacheRef := r.CacheService()
 	cache := &v1alpha1.Cache{}
-	err := ctx.Client().
-		WithNamespace(cacheRef.Namespace).
-		Load(cacheRef.Name, cache)
+	err := ctx.Client().Get(types.NamespacedName{Namespace: cacheRef.Namespace, Name: cacheRef.Name}, cache)
 
 	if err != nil {
 		msg := fmt.Sprintf("unable to load Cache CR '%s'", cacheRef)
@@ -29,6 +28,35 @@ func LoadCache(r *v1alpha1.LazyCacheRule, ctx *rule.Context) {
 			return
 		}
 		ctx.Requeue(fmt.Errorf("%s: %w", msg, err))
+		return
 	}
 	ctx.Cache = cache
+
+	allowed, err := rule.CacheAccessAllowed(r, ctx)
+	if err != nil {
+		ctx.Requeue(err)
+		return
+	}
+
+	if !allowed {
+		// Ensure that the rule is no longer served by the Cache if access has been revoked
+		rule.RemoveRuleFromConfigMap(r, ctx)
+		if ctx.Status().Stop {
+			return
+		}
+
+		r.SetCondition(
+			v1alpha1.LazyCacheRuleCondition{
+				Type:    v1alpha1.LazyCacheRuleConditionReady,
+				Status:  metav1.ConditionFalse,
+				Message: cache.RuleNamespaceForbiddenMsg(r.Namespace),
+			},
+		)
+		if err := ctx.Client().UpdateStatus(r); err != nil {
+			ctx.Requeue(fmt.Errorf("unable to update Ready condition on Cache access denied: %w", err))
+			return
+		}
+		// Reconciliation is triggered again when the Cache or the rule's Namespace is updated
+		ctx.StopProcessing(nil)
+	}
 }
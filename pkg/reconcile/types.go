@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	kedav1alpha1 "github.com/gingersnap-project/operator/pkg/apis/keda/v1alpha1"
 	"github.com/gingersnap-project/operator/pkg/kubernetes/client"
 	"github.com/go-logr/logr"
 	_ "github.com/golang/mock/mockgen/model"
@@ -38,6 +39,10 @@ type Context interface {
 	// Ctx the Pipeline's context.Context that should be passed to any functions requiring a context
 	Ctx() context.Context
 
+	// SetCtx replaces the context.Context of the Context and its Client. The Pipeline sets the context of the span
+	// of the Handler being executed, so that the operations of the Handler are traced as its children
+	SetCtx(ctx context.Context)
+
 	// Client provides the client that should be used for all k8s resource CRUD operations
 	Client() client.Client
 
@@ -55,11 +60,20 @@ type Context interface {
 	// reconciliation should be requeued after delay time
 	RequeueAfter(delay time.Duration, reason error)
 
+	// RequeueWithBackoff indicates that the pipeline should stop once the current Handler has finished execution and
+	// reconciliation should be requeued after a delay that grows exponentially whilst the resource is not making
+	// progress. The delay is reset once the pipeline completes without requesting a requeue with backoff
+	RequeueWithBackoff(reason error)
+
 	// Status the current status of a pipeline execution
 	Status() FlowStatus
 
 	// StopProcessing indicates that the pipeline should stop once the current Handler has finished execution
 	StopProcessing(err error)
+
+	// Fork returns a copy of the Context with an independent FlowStatus, used to execute a Handler concurrently with
+	// other Handlers. Implementations that wrap a Context must return a copy of themselves wrapping the forked Context
+	Fork() Context
 }
 
 // ContextProvider returns a Context implementation for a given resource type
@@ -79,10 +93,12 @@ type FlowStatus struct {
 	Stop  bool
 	Err   error
 	Delay time.Duration
+	// Backoff true if the Delay should be determined by the pipeline's BackoffPolicy
+	Backoff bool
 }
 
 func (f *FlowStatus) String() string {
-	return fmt.Sprintf("Requeue=%t, Stop=%t, Err=%s, Delay=%dms", f.Retry, f.Stop, f.Err.Error(), f.Delay.Milliseconds())
+	return fmt.Sprintf("Requeue=%t, Stop=%t, Err=%s, Delay=%dms, Backoff=%t", f.Retry, f.Stop, f.Err.Error(), f.Delay.Milliseconds(), f.Backoff)
 }
 
 func (f *FlowStatus) Requeue(err error) {
@@ -92,14 +108,21 @@ func (f *FlowStatus) Requeue(err error) {
 func (f *FlowStatus) RequeueAfter(delay time.Duration, err error) {
 	f.Retry = true
 	f.Delay = delay
+	f.Backoff = false
 	f.StopProcessing(err)
 }
 
+func (f *FlowStatus) RequeueWithBackoff(err error) {
+	f.RequeueAfter(0, err)
+	f.Backoff = true
+}
+
 func (f *FlowStatus) StopProcessing(err error) {
 	f.Stop = true
 	f.Err = err
 }
 
 var (
+	ScaledObjectGVK   = kedav1alpha1.SchemeGroupVersion.WithKind(kedav1alpha1.ScaledObjectsKind)
 	ServiceMonitorGVK = monitoringv1.SchemeGroupVersion.WithKind(monitoringv1.ServiceMonitorsKind)
 )
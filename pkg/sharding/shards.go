@@ -0,0 +1,408 @@
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	// KeyNamespace partitions Caches by namespace, so that all the Caches of a namespace are owned by the same shard
+	KeyNamespace = "namespace"
+	// KeyName partitions Caches by their namespaced name
+	KeyName = "name"
+)
+
+const (
+	// LeasePrefix the prefix of the names of the Leases that coordinate the ownership of each shard
+	LeasePrefix = "gingersnap-operator-shard-"
+	// MemberPrefix the prefix of the names of the Leases renewed by each replica to advertise that it is a member of the
+	// shard assignment
+	MemberPrefix = "gingersnap-operator-member-"
+	// LabelMember the label of the member Leases
+	LabelMember = "gingersnap-project.io/shard-member"
+)
+
+var _ manager.Runnable = &Shards{}
+var _ manager.LeaderElectionRunnable = &Shards{}
+
+// Shards partitions the Caches, and the rules attached to them, across the replicas of the operator. Each replica
+// renews a member Lease and the shards are assigned round-robin to the live members ordered by their Identity, so that
+// every replica computes the same assignment. A replica contests the Leases of its assigned shards and releases the
+// Leases of shards that are reassigned, so that the shards are rebalanced whenever a replica joins or leaves. The
+// shard Leases guarantee that a shard is owned by at most one replica while members disagree on the assignment. A nil
+// Shards owns every Cache
+type Shards struct {
+	// Count the number of shards, sharding is disabled if less than 2
+	Count int
+	// Key the part of the Cache identity that is hashed to determine its shard, KeyNamespace or KeyName
+	Key string
+	// Namespace the namespace of the shard and member Leases
+	Namespace string
+	// Identity of the replica, recorded as the holder of the shard and member Leases
+	Identity string
+	// LeaseDuration, RenewDeadline and RetryPeriod configure the shard Leases as in leaderelection.LeaderElectionConfig.
+	// A member is considered to have left once its member Lease has not been renewed for the LeaseDuration, the member
+	// Leases are renewed, and the assignment recomputed, every RetryPeriod
+	LeaseDuration time.Duration
+	RenewDeadline time.Duration
+	RetryPeriod   time.Duration
+
+	client      kubernetes.Interface
+	log         logr.Logger
+	mu          sync.RWMutex
+	owned       map[int]struct{}
+	subscribers []chan event.GenericEvent
+}
+
+// New returns the Shards of the replica with the default Lease configuration, the Identity is the hostname of the
+// replica suffixed with a unique id
+func New(config *rest.Config, count int, key, namespace string) (*Shards, error) {
+	if key != KeyNamespace && key != KeyName {
+		return nil, fmt.Errorf("unsupported shard key '%s', must be one of '%s' or '%s'", key, KeyNamespace, KeyName)
+	}
+	if count > 1 && namespace == "" {
+		return nil, fmt.Errorf("the namespace of the shard Leases must be configured")
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create shard Lease client: %w", err)
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, fmt.Errorf("unable to determine shard identity: %w", err)
+	}
+	return &Shards{
+		Count:         count,
+		Key:           key,
+		Namespace:     namespace,
+		Identity:      fmt.Sprintf("%s_%s", hostname, uuid.NewUUID()),
+		LeaseDuration: 15 * time.Second,
+		RenewDeadline: 10 * time.Second,
+		RetryPeriod:   2 * time.Second,
+		client:        client,
+		log:           ctrl.Log.WithName("shards"),
+	}, nil
+}
+
+// Enabled returns true if the Caches are partitioned across several shards
+func (s *Shards) Enabled() bool {
+	return s != nil && s.Count > 1
+}
+
+// Of returns the shard of the Cache with the given namespace and name
+func (s *Shards) Of(namespace, name string) int {
+	key := namespace
+	if s.Key == KeyName {
+		key = fmt.Sprintf("%s/%s", namespace, name)
+	}
+	return hash(key, s.Count)
+}
+
+// OwnsCache returns true if the replica owns the shard of the Cache with the given namespace and name. Rules must be
+// checked with the namespace and name of the Cache they reference, so that they are reconciled by the same replica
+func (s *Shards) OwnsCache(namespace, name string) bool {
+	if !s.Enabled() {
+		return true
+	}
+	return s.owns(s.Of(namespace, name))
+}
+
+// Coordinator returns true if the replica performs the cluster-wide work that is not partitioned, such as recording
+// the status of GingersnapConfigs. The coordinator is the owner of the first shard, so that exactly one replica is the
+// coordinator without requiring leader election. Always true if sharding is disabled
+func (s *Shards) Coordinator() bool {
+	if !s.Enabled() {
+		return true
+	}
+	return s.owns(0)
+}
+
+// WaitForCoordinator blocks until the replica is the Coordinator or the context is cancelled
+func (s *Shards) WaitForCoordinator(ctx context.Context) error {
+	if !s.Enabled() {
+		return nil
+	}
+	return wait.PollImmediateUntilWithContext(ctx, s.RetryPeriod, func(context.Context) (bool, error) {
+		return s.Coordinator(), nil
+	})
+}
+
+func (s *Shards) owns(shard int) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, ok := s.owned[shard]
+	return ok
+}
+
+// Subscribe returns a channel that receives an event, whose object is the shard Lease, whenever the replica acquires
+// a shard, so that the resources of the shard can be reconciled. Must be called before the Shards are started
+func (s *Shards) Subscribe() <-chan event.GenericEvent {
+	events := make(chan event.GenericEvent, s.Count)
+	s.subscribers = append(s.subscribers, events)
+	return events
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, as every replica owns shards
+func (s *Shards) NeedLeaderElection() bool {
+	return false
+}
+
+// contest tracks the elector of a shard Lease, done is closed once the Lease has been released
+type contest struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// Start renews the member Lease of the replica and contests the Leases of the shards assigned to it until the context
+// is cancelled
+func (s *Shards) Start(ctx context.Context) error {
+	if !s.Enabled() {
+		return nil
+	}
+	s.mu.Lock()
+	s.owned = make(map[int]struct{}, s.Count)
+	s.mu.Unlock()
+
+	// Validate the Lease configuration before contesting any shard
+	if _, err := s.elector(0); err != nil {
+		return err
+	}
+	s.log.Info("Joining shard members", "identity", s.Identity, "count", s.Count)
+
+	contests := make(map[int]*contest, s.Count)
+	defer func() {
+		for _, c := range contests {
+			c.cancel()
+			<-c.done
+		}
+		s.leave()
+	}()
+
+	ticker := time.NewTicker(s.RetryPeriod)
+	defer ticker.Stop()
+	for {
+		members, err := s.renew(ctx)
+		if err != nil {
+			s.log.Error(err, "Unable to renew shard membership")
+		} else {
+			assigned := assign(s.Count, members, s.Identity)
+			for shard := 0; shard < s.Count; shard++ {
+				c, contested := contests[shard]
+				if contested {
+					select {
+					case <-c.done:
+						// The previous elector has released the Lease, a new elector may contest it
+						delete(contests, shard)
+						contested = false
+					default:
+					}
+				}
+				_, ok := assigned[shard]
+				switch {
+				case ok && !contested:
+					contests[shard] = s.contest(ctx, shard)
+				case !ok && contested:
+					s.log.Info("Releasing reassigned shard", "shard", shard, "members", len(members))
+					c.cancel()
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// contest runs the elector of the shard Lease until the returned contest is cancelled, releasing the Lease if held
+func (s *Shards) contest(ctx context.Context, shard int) *contest {
+	ctx, cancel := context.WithCancel(ctx)
+	c := &contest{cancel: cancel, done: make(chan struct{})}
+	go func() {
+		defer close(c.done)
+		for ctx.Err() == nil {
+			elector, err := s.elector(shard)
+			if err != nil {
+				s.log.Error(err, "Unable to contest shard", "shard", shard)
+				return
+			}
+			// Run returns once the Lease is lost or the context is cancelled
+			elector.Run(ctx)
+		}
+	}()
+	return c
+}
+
+// renew creates or renews the member Lease of the replica and returns the Identity of every live member, including
+// the replica itself
+func (s *Shards) renew(ctx context.Context) ([]string, error) {
+	leases := s.client.CoordinationV1().Leases(s.Namespace)
+	now := metav1.NewMicroTime(time.Now())
+	duration := int32(s.LeaseDuration.Seconds())
+
+	name := s.memberLease()
+	lease, err := leases.Get(ctx, name, metav1.GetOptions{})
+	switch {
+	case errors.IsNotFound(err):
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: s.Namespace,
+				Labels:    map[string]string{LabelMember: "true"},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &s.Identity,
+				LeaseDurationSeconds: &duration,
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		}
+		if _, err := leases.Create(ctx, lease, metav1.CreateOptions{}); err != nil {
+			return nil, fmt.Errorf("unable to create member Lease: %w", err)
+		}
+	case err != nil:
+		return nil, fmt.Errorf("unable to retrieve member Lease: %w", err)
+	default:
+		lease.Spec.HolderIdentity = &s.Identity
+		lease.Spec.LeaseDurationSeconds = &duration
+		lease.Spec.RenewTime = &now
+		if _, err := leases.Update(ctx, lease, metav1.UpdateOptions{}); err != nil {
+			return nil, fmt.Errorf("unable to renew member Lease: %w", err)
+		}
+	}
+
+	list, err := leases.List(ctx, metav1.ListOptions{LabelSelector: LabelMember})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list member Leases: %w", err)
+	}
+	members := []string{s.Identity}
+	for i := range list.Items {
+		spec := &list.Items[i].Spec
+		if spec.HolderIdentity == nil || *spec.HolderIdentity == s.Identity || spec.RenewTime == nil {
+			continue
+		}
+		if spec.RenewTime.Add(s.LeaseDuration).After(now.Time) {
+			members = append(members, *spec.HolderIdentity)
+		}
+	}
+	return members, nil
+}
+
+// leave deletes the member Lease of the replica, so that the remaining members take over its shards without waiting
+// for the Lease to expire
+func (s *Shards) leave() {
+	ctx, cancel := context.WithTimeout(context.Background(), s.RenewDeadline)
+	defer cancel()
+	if err := s.client.CoordinationV1().Leases(s.Namespace).Delete(ctx, s.memberLease(), metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+		s.log.Error(err, "Unable to delete member Lease")
+	}
+}
+
+func (s *Shards) memberLease() string {
+	return fmt.Sprintf("%s%08x", MemberPrefix, fnv32(s.Identity))
+}
+
+// assign returns the shards assigned to the member with the given identity. The shards are assigned round-robin to the
+// members ordered by identity, so that every member computes the same assignment from the same members
+func assign(count int, members []string, identity string) map[int]struct{} {
+	sorted := append([]string(nil), members...)
+	sort.Strings(sorted)
+	index := sort.SearchStrings(sorted, identity)
+	assigned := map[int]struct{}{}
+	if index == len(sorted) || sorted[index] != identity {
+		return assigned
+	}
+	for shard := index; shard < count; shard += len(sorted) {
+		assigned[shard] = struct{}{}
+	}
+	return assigned
+}
+
+func (s *Shards) elector(shard int) (*leaderelection.LeaderElector, error) {
+	lock, err := resourcelock.New(
+		resourcelock.LeasesResourceLock,
+		s.Namespace,
+		fmt.Sprintf("%s%d", LeasePrefix, shard),
+		s.client.CoreV1(),
+		s.client.CoordinationV1(),
+		resourcelock.ResourceLockConfig{Identity: s.Identity},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create Lease of shard %d: %w", shard, err)
+	}
+	return leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   s.LeaseDuration,
+		RenewDeadline:   s.RenewDeadline,
+		RetryPeriod:     s.RetryPeriod,
+		ReleaseOnCancel: true,
+		Name:            lock.Describe(),
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				s.acquired(ctx, shard)
+			},
+			OnStoppedLeading: func() {
+				s.released(shard)
+			},
+		},
+	})
+}
+
+func (s *Shards) acquired(ctx context.Context, shard int) {
+	s.log.Info("Acquired shard", "shard", shard)
+	s.mu.Lock()
+	s.owned[shard] = struct{}{}
+	s.mu.Unlock()
+
+	lease := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s%d", LeasePrefix, shard),
+			Namespace: s.Namespace,
+		},
+	}
+	for _, subscriber := range s.subscribers {
+		select {
+		case subscriber <- event.GenericEvent{Object: lease}:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Shards) released(shard int) {
+	s.log.Info("Released shard", "shard", shard)
+	s.mu.Lock()
+	delete(s.owned, shard)
+	s.mu.Unlock()
+}
+
+func hash(key string, count int) int {
+	return int(fnv32(key) % uint32(count))
+}
+
+func fnv32(key string) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return h.Sum32()
+}
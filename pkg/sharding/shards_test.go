@@ -0,0 +1,164 @@
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	"sigs.k8s.io/controller-runtime/pkg/envtest/printer"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+var (
+	cfg     *rest.Config
+	testEnv *envtest.Environment
+)
+
+func TestShards(t *testing.T) {
+	RegisterFailHandler(Fail)
+
+	RunSpecsWithDefaultAndCustomReporters(t,
+		"Shards",
+		[]Reporter{printer.NewlineReporter{}})
+}
+
+var _ = BeforeSuite(func() {
+	logf.SetLogger(zap.New(zap.WriteTo(GinkgoWriter), zap.UseDevMode(true)))
+})
+
+var _ = AfterSuite(func() {
+	if testEnv != nil {
+		By("tearing down the test environment")
+		Expect(testEnv.Stop()).To(Succeed())
+	}
+})
+
+var _ = Describe("Shards", func() {
+
+	It("should reject unsupported shard keys", func() {
+		_, err := New(&rest.Config{}, 2, "uid", "default")
+		Expect(err).To(MatchError(ContainSubstring("unsupported shard key 'uid'")))
+	})
+
+	It("should own every Cache if sharding is disabled", func() {
+		var shards *Shards
+		Expect(shards.Enabled()).To(BeFalse())
+		Expect(shards.OwnsCache("namespace", "cache")).To(BeTrue())
+		Expect(shards.Coordinator()).To(BeTrue())
+
+		shards = &Shards{Count: 1, Key: KeyNamespace}
+		Expect(shards.Enabled()).To(BeFalse())
+		Expect(shards.OwnsCache("namespace", "cache")).To(BeTrue())
+	})
+
+	It("should partition Caches by the shard key", func() {
+		shards := &Shards{Count: 16, Key: KeyNamespace}
+		// Caches of a namespace are owned by the same shard
+		for i := 0; i < 10; i++ {
+			Expect(shards.Of("namespace", fmt.Sprintf("cache-%d", i))).To(Equal(shards.Of("namespace", "cache")))
+		}
+
+		shards.Key = KeyName
+		distinct := map[int]struct{}{}
+		for i := 0; i < 10; i++ {
+			distinct[shards.Of("namespace", fmt.Sprintf("cache-%d", i))] = struct{}{}
+		}
+		Expect(len(distinct)).To(BeNumerically(">", 1))
+	})
+
+	It("should assign every shard to exactly one member", func() {
+		members := []string{"replica-c", "replica-a", "replica-b"}
+		for count := 1; count < 8; count++ {
+			owners := map[int]string{}
+			for _, member := range members {
+				for shard := range assign(count, members, member) {
+					Expect(owners).NotTo(HaveKey(shard))
+					owners[shard] = member
+				}
+				// A member is assigned at most one shard more than any other member
+				Expect(len(assign(count, members, member))).To(BeNumerically("~", count/len(members), 1))
+			}
+			Expect(owners).To(HaveLen(count))
+		}
+		// The assignment does not depend on the order in which members are observed
+		Expect(assign(4, members, "replica-b")).To(Equal(assign(4, []string{"replica-a", "replica-b", "replica-c"}, "replica-b")))
+		Expect(assign(4, members, "replica-d")).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Shard Leases", func() {
+
+	BeforeEach(func() {
+		if testEnv == nil {
+			By("bootstrapping test environment")
+			testEnv = &envtest.Environment{}
+			var err error
+			cfg, err = testEnv.Start()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg).NotTo(BeNil())
+		}
+	}, 60)
+
+	newShards := func(identity string) *Shards {
+		shards, err := New(cfg, 2, KeyNamespace, "default")
+		Expect(err).NotTo(HaveOccurred())
+		shards.Identity = identity
+		shards.LeaseDuration = 2 * time.Second
+		shards.RenewDeadline = time.Second
+		shards.RetryPeriod = 200 * time.Millisecond
+		return shards
+	}
+
+	owned := func(shards *Shards) func() []int {
+		return func() []int {
+			var owned []int
+			for shard := 0; shard < shards.Count; shard++ {
+				if shards.owns(shard) {
+					owned = append(owned, shard)
+				}
+			}
+			return owned
+		}
+	}
+
+	start := func(ctx context.Context, shards *Shards) {
+		go func() {
+			defer GinkgoRecover()
+			Expect(shards.Start(ctx)).To(Succeed())
+		}()
+	}
+
+	It("should distribute shards across replicas and rebalance them when replicas leave and join", func() {
+		first := newShards("replica-0")
+		second := newShards("replica-1")
+		firstEvents := first.Subscribe()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		start(ctx, first)
+
+		// A single replica owns every shard
+		Eventually(owned(first), 10*time.Second).Should(ConsistOf(0, 1))
+		Eventually(firstEvents).Should(Receive())
+		Expect(first.Coordinator()).To(BeTrue())
+
+		// The shards are rebalanced once another replica joins
+		secondCtx, secondCancel := context.WithCancel(ctx)
+		start(secondCtx, second)
+		Eventually(owned(first), 10*time.Second).Should(ConsistOf(0))
+		Eventually(owned(second), 10*time.Second).Should(ConsistOf(1))
+		Consistently(owned(first), 2*time.Second).Should(ConsistOf(0))
+		Expect(second.Coordinator()).To(BeFalse())
+
+		// The member and shard Leases are released when a replica stops, so that its shards are taken over
+		secondCancel()
+		Eventually(owned(first), 10*time.Second).Should(ConsistOf(0, 1))
+		Eventually(firstEvents).Should(Receive())
+	})
+})
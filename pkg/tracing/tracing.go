@@ -0,0 +1,68 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+)
+
+const (
+	// ExporterNone disables the export of traces
+	ExporterNone = "none"
+	// ExporterOTLP exports traces to an OpenTelemetry collector via OTLP gRPC
+	ExporterOTLP = "otlp"
+
+	serviceName = "gingersnap-operator"
+)
+
+// Options configures how traces are exported
+type Options struct {
+	// Exporter the type of exporter, one of ExporterNone or ExporterOTLP
+	Exporter string
+	// Endpoint the address of the OTLP collector, e.g. otel-collector:4317
+	Endpoint string
+	// Insecure disables TLS when connecting to the collector
+	Insecure bool
+	// SampleRatio the fraction of reconciliations that are traced
+	SampleRatio float64
+	// Version of the operator reported as the service.version resource attribute
+	Version string
+}
+
+// Setup registers the global TracerProvider configured by the provided Options. The returned function flushes
+// pending spans and must be called on shutdown. With ExporterNone the global no-op TracerProvider is retained.
+func Setup(ctx context.Context, opts Options) (func(context.Context) error, error) {
+	var exporter sdktrace.SpanExporter
+	switch opts.Exporter {
+	case "", ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+		clientOpts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(opts.Endpoint)}
+		if opts.Insecure {
+			clientOpts = append(clientOpts, otlptracegrpc.WithInsecure())
+		}
+		var err error
+		if exporter, err = otlptracegrpc.New(ctx, clientOpts...); err != nil {
+			return nil, fmt.Errorf("unable to create OTLP trace exporter: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported trace exporter '%s', expected one of [%s, %s]", opts.Exporter, ExporterNone, ExporterOTLP)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceNameKey.String(serviceName),
+			semconv.ServiceVersionKey.String(opts.Version),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
@@ -15,6 +15,8 @@ var (
 	Namespace            = EnvWithDefault("TEST_NAMESPACE", DefaultNamespace)
 	CleanupTestNamespace = EnvWithDefaultBool("TEST_NAMESPACE_DELETE", true)
 	OutputDir            = EnvWithDefault("TEST_OUTPUT_DIR", os.TempDir()+"/gingersnap-operator")
+	// CachePersistence whether the operator under test was started with --enable-cache-persistence
+	CachePersistence = EnvWithDefaultBool("TEST_CACHE_PERSISTENCE", false)
 
 	MultiNamespace = Namespace != OperatorNamespace
 )
@@ -41,6 +41,8 @@ var _ = Describe("E2E", func() {
 		Expect(k8sClient.DeleteAllForeground(nil, &v1alpha1.LazyCacheRule{})).Should(Succeed())
 		Expect(k8sClient.DeleteAllForeground(nil, &v1alpha1.EagerCacheRule{})).Should(Succeed())
 		Expect(k8sClient.DeleteAllOf(meta.Labels, &corev1.Pod{})).Should(Succeed())
+		// The PersistentVolumeClaims of persistent CLUSTER caches are retained when the Cache is deleted
+		Expect(k8sClient.DeleteAllOf(nil, &corev1.PersistentVolumeClaim{})).Should(Succeed())
 
 		By("Expecting delete to finish")
 		Eventually(func() int {
@@ -288,6 +290,79 @@ var _ = Describe("E2E", func() {
 		})
 	})
 
+	Context("Persistent Cluster Cache", func() {
+		It("Deployment should be migrated to a StatefulSet when persistence is enabled", func() {
+			if !CachePersistence {
+				Skip("the operator was not started with --enable-cache-persistence")
+			}
+			cache := &v1alpha1.Cache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cache",
+					Namespace: Namespace,
+				},
+				Spec: v1alpha1.CacheSpec{
+					DataSource: &v1alpha1.DataSourceSpec{
+						DbType: v1alpha1.DBType_MYSQL_8.Enum(),
+						SecretRef: &v1alpha1.LocalObjectReference{
+							Name: MysqlConnectionSecret.Name,
+						},
+					},
+					Deployment: &v1alpha1.CacheDeploymentSpec{
+						Type:     v1alpha1.CacheDeploymentType_CLUSTER,
+						Replicas: 2,
+					},
+				},
+			}
+			Expect(k8sClient.Create(cache)).Should(Succeed())
+			Eventually(func() bool {
+				Expect(k8sClient.Load(cache.Name, cache)).Should(Succeed())
+				return cache.Condition(v1alpha1.CacheConditionReady).Status == metav1.ConditionTrue
+			}, Timeout, Interval).Should(BeTrue())
+			Expect(cache.Status.Workload).Should(Equal(v1alpha1.WorkloadDeployment))
+
+			// Enable persistence
+			Eventually(func() error {
+				Expect(k8sClient.Load(cache.Name, cache)).Should(Succeed())
+				cache.Spec.Deployment.Persistence = &v1alpha1.CachePersistenceSpec{
+					Size: "100Mi",
+				}
+				return k8sClient.Update(cache)
+			}, Timeout, Interval).Should(Succeed())
+
+			// Wait for the transition to the StatefulSet to complete
+			Eventually(func() bool {
+				Expect(k8sClient.Load(cache.Name, cache)).Should(Succeed())
+				return cache.Status.Workload == v1alpha1.WorkloadStatefulSet &&
+					cache.Condition(v1alpha1.CacheConditionReady).Status == metav1.ConditionTrue
+			}, Timeout, Interval).Should(BeTrue())
+
+			Eventually(func() bool {
+				return errors.IsNotFound(k8sClient.Load(cache.Name, &appsv1.Deployment{}))
+			}, Timeout, Interval).Should(BeTrue())
+
+			expectServiceBinding(
+				cache.CacheService().DataSourceServiceBinding(),
+				"mysql",
+				cache.Spec.DataSource.SecretRef.Name,
+				"StatefulSet",
+				"infinispan",
+			)
+
+			statefulSet := &appsv1.StatefulSet{}
+			Expect(k8sClient.Load(cache.Name, statefulSet)).Should(Succeed())
+			Expect(statefulSet.Status.ReadyReplicas).Should(Equal(int32(2)))
+			Expect(statefulSet.Spec.VolumeClaimTemplates).Should(HaveLen(1))
+			Expect(statefulSet.Spec.VolumeClaimTemplates[0].Spec.Resources.Requests.Storage().String()).Should(Equal("100Mi"))
+
+			pvcs := &corev1.PersistentVolumeClaimList{}
+			Expect(k8sClient.List(nil, pvcs)).Should(Succeed())
+			Expect(pvcs.Items).Should(HaveLen(2))
+			for _, pvc := range pvcs.Items {
+				Expect(pvc.Status.Phase).Should(Equal(corev1.ClaimBound))
+			}
+		})
+	})
+
 	Context("LazyCacheRule", func() {
 
 		It("Ready Status should be false if Cache does not exist", func() {